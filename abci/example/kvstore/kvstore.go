@@ -194,6 +194,13 @@ func (app *Application) ProcessProposal(ctx context.Context, req *types.RequestP
 // updates and are cached in memory and will be persisted once Commit is called.
 // ConsensusParams are never changed.
 func (app *Application) FinalizeBlock(_ context.Context, req *types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
+	// FinalizeBlock is called again for a height before Commit when the node
+	// executes blocks optimistically and another block is decided: discard the
+	// state changes of the previous call.
+	if app.state.Height == req.Height {
+		app.state = loadState(app.state.db)
+	}
+
 	// reset valset changes
 	app.valUpdates = make([]types.ValidatorUpdate, 0)
 	app.stagedTxs = make([][]byte, 0)
@@ -103,6 +103,44 @@ func TestPersistentKVStoreKV(t *testing.T) {
 	testKVStore(ctx, t, kvstore, NewTx(key, value), key, value)
 }
 
+func TestKVStoreFinalizeBlockAgain(t *testing.T) {
+	ctx := t.Context()
+	kvstore := NewInMemoryApplication()
+
+	// a block which isn't decided
+	resp, err := kvstore.FinalizeBlock(ctx, &types.RequestFinalizeBlock{
+		Height: 1,
+		Txs:    [][]byte{NewTx("a", "1"), NewTx("b", "2")},
+	})
+	require.NoError(t, err)
+	discardedAppHash := resp.AppHash
+
+	// the decided block
+	decided := &types.RequestFinalizeBlock{
+		Height: 1,
+		Txs:    [][]byte{NewTx("c", "3")},
+	}
+	resp, err = kvstore.FinalizeBlock(ctx, decided)
+	require.NoError(t, err)
+	require.NotEqual(t, discardedAppHash, resp.AppHash)
+	expected, err := NewInMemoryApplication().FinalizeBlock(ctx, decided)
+	require.NoError(t, err)
+	require.Equal(t, expected.AppHash, resp.AppHash)
+	_, err = kvstore.Commit(ctx, &types.RequestCommit{})
+	require.NoError(t, err)
+
+	info, err := kvstore.Info(ctx, &types.RequestInfo{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, info.LastBlockHeight)
+	require.Equal(t, resp.AppHash, info.LastBlockAppHash)
+
+	for key, value := range map[string]string{"a": "", "b": "", "c": "3"} {
+		resQuery, err := kvstore.Query(ctx, &types.RequestQuery{Path: "/store", Data: []byte(key)})
+		require.NoError(t, err)
+		require.Equal(t, value, string(resQuery.Value))
+	}
+}
+
 func TestPersistentKVStoreInfo(t *testing.T) {
 	ctx := t.Context()
 
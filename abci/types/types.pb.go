@@ -2984,6 +2984,9 @@ type ResponseFinalizeBlock struct {
 	ConsensusParamUpdates *types1.ConsensusParams `protobuf:"bytes,4,opt,name=consensus_param_updates,json=consensusParamUpdates,proto3" json:"consensus_param_updates,omitempty"`
 	// app_hash is the hash of the applications' state which is used to confirm that execution of the transactions was deterministic. It is up to the application to decide which algorithm to use.
 	AppHash []byte `protobuf:"bytes,5,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	// upgrade, if set, halts consensus once the block is committed, until the
+	// software of the application or CometBFT changes.
+	Upgrade *Upgrade `protobuf:"bytes,7,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
 }
 
 func (m *ResponseFinalizeBlock) Reset()         { *m = ResponseFinalizeBlock{} }
@@ -3054,6 +3057,69 @@ func (m *ResponseFinalizeBlock) GetAppHash() []byte {
 	return nil
 }
 
+func (m *ResponseFinalizeBlock) GetUpgrade() *Upgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return nil
+}
+
+// Upgrade is an upgrade of the software required by the application after a
+// block, to be performed by the operators of the nodes.
+type Upgrade struct {
+	// the name of the upgrade, e.g. the version of the software to run.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// arbitrary information about the upgrade, e.g. where to get the software.
+	Info string `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *Upgrade) Reset()         { *m = Upgrade{} }
+func (m *Upgrade) String() string { return proto.CompactTextString(m) }
+func (*Upgrade) ProtoMessage()    {}
+func (*Upgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252557cfdd89a31a, []int{39}
+}
+func (m *Upgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Upgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Upgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Upgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Upgrade.Merge(m, src)
+}
+func (m *Upgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Upgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Upgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Upgrade proto.InternalMessageInfo
+
+func (m *Upgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Upgrade) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
+}
+
 type CommitInfo struct {
 	Round int32      `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
 	Votes []VoteInfo `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
@@ -3063,7 +3129,7 @@ func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
 func (m *CommitInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()    {}
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{39}
+	return fileDescriptor_252557cfdd89a31a, []int{40}
 }
 func (m *CommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3121,7 +3187,7 @@ func (m *ExtendedCommitInfo) Reset()         { *m = ExtendedCommitInfo{} }
 func (m *ExtendedCommitInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtendedCommitInfo) ProtoMessage()    {}
 func (*ExtendedCommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{40}
+	return fileDescriptor_252557cfdd89a31a, []int{41}
 }
 func (m *ExtendedCommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3176,7 +3242,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{41}
+	return fileDescriptor_252557cfdd89a31a, []int{42}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3230,7 +3296,7 @@ func (m *EventAttribute) Reset()         { *m = EventAttribute{} }
 func (m *EventAttribute) String() string { return proto.CompactTextString(m) }
 func (*EventAttribute) ProtoMessage()    {}
 func (*EventAttribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{42}
+	return fileDescriptor_252557cfdd89a31a, []int{43}
 }
 func (m *EventAttribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3298,7 +3364,7 @@ func (m *ExecTxResult) Reset()         { *m = ExecTxResult{} }
 func (m *ExecTxResult) String() string { return proto.CompactTextString(m) }
 func (*ExecTxResult) ProtoMessage()    {}
 func (*ExecTxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{43}
+	return fileDescriptor_252557cfdd89a31a, []int{44}
 }
 func (m *ExecTxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3397,7 +3463,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{44}
+	return fileDescriptor_252557cfdd89a31a, []int{45}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3464,7 +3530,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{45}
+	return fileDescriptor_252557cfdd89a31a, []int{46}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3582,7 @@ func (m *ValidatorUpdate) Reset()         { *m = ValidatorUpdate{} }
 func (m *ValidatorUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdate) ProtoMessage()    {}
 func (*ValidatorUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{46}
+	return fileDescriptor_252557cfdd89a31a, []int{47}
 }
 func (m *ValidatorUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3568,7 +3634,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{47}
+	return fileDescriptor_252557cfdd89a31a, []int{48}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3626,7 +3692,7 @@ func (m *ExtendedVoteInfo) Reset()         { *m = ExtendedVoteInfo{} }
 func (m *ExtendedVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtendedVoteInfo) ProtoMessage()    {}
 func (*ExtendedVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{48}
+	return fileDescriptor_252557cfdd89a31a, []int{49}
 }
 func (m *ExtendedVoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3701,7 +3767,7 @@ func (m *Misbehavior) Reset()         { *m = Misbehavior{} }
 func (m *Misbehavior) String() string { return proto.CompactTextString(m) }
 func (*Misbehavior) ProtoMessage()    {}
 func (*Misbehavior) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{49}
+	return fileDescriptor_252557cfdd89a31a, []int{50}
 }
 func (m *Misbehavior) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3777,7 +3843,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{50}
+	return fileDescriptor_252557cfdd89a31a, []int{51}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3887,6 +3953,7 @@ func init() {
 	proto.RegisterType((*ResponseExtendVote)(nil), "tendermint.abci.ResponseExtendVote")
 	proto.RegisterType((*ResponseVerifyVoteExtension)(nil), "tendermint.abci.ResponseVerifyVoteExtension")
 	proto.RegisterType((*ResponseFinalizeBlock)(nil), "tendermint.abci.ResponseFinalizeBlock")
+	proto.RegisterType((*Upgrade)(nil), "tendermint.abci.Upgrade")
 	proto.RegisterType((*CommitInfo)(nil), "tendermint.abci.CommitInfo")
 	proto.RegisterType((*ExtendedCommitInfo)(nil), "tendermint.abci.ExtendedCommitInfo")
 	proto.RegisterType((*Event)(nil), "tendermint.abci.Event")
@@ -3904,215 +3971,217 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xc9, 0x73, 0x1b, 0xc7,
-	0xd5, 0xc7, 0x60, 0xc7, 0xc3, 0xc2, 0x61, 0x93, 0xa2, 0x20, 0x48, 0x26, 0xa9, 0x51, 0xd9, 0x96,
-	0x65, 0x9b, 0xf4, 0x27, 0x7d, 0xf2, 0x52, 0xb2, 0xbf, 0x2f, 0x20, 0x04, 0x1a, 0xa4, 0x64, 0x92,
-	0x1e, 0x42, 0x72, 0x39, 0x8b, 0xc7, 0x43, 0xa0, 0x41, 0x8c, 0x05, 0x60, 0xc6, 0x33, 0x03, 0x1a,
-	0xf4, 0x29, 0x15, 0x27, 0x55, 0x29, 0x9f, 0x5c, 0x95, 0x4a, 0x95, 0x0f, 0xf1, 0x21, 0x87, 0x5c,
-	0xf2, 0x17, 0xe4, 0x90, 0xf2, 0x29, 0x07, 0x1f, 0x72, 0xf0, 0x31, 0x27, 0x27, 0x65, 0xdf, 0x7c,
-	0xcd, 0x21, 0xd7, 0x54, 0x2f, 0xb3, 0x01, 0x33, 0x58, 0x64, 0xe7, 0x90, 0x4a, 0x6e, 0xbd, 0xbc,
-	0xf7, 0xba, 0xfb, 0x75, 0xf7, 0x5b, 0x7e, 0xdd, 0x70, 0xd9, 0xc6, 0x83, 0x36, 0x36, 0xfb, 0xda,
-	0xc0, 0xde, 0x56, 0x4f, 0x5a, 0xda, 0xb6, 0x7d, 0x6e, 0x60, 0x6b, 0xcb, 0x30, 0x75, 0x5b, 0x47,
-	0x4b, 0x5e, 0xe7, 0x16, 0xe9, 0xac, 0xac, 0x9e, 0xea, 0xa7, 0x3a, 0xed, 0xdb, 0x26, 0x25, 0x46,
-	0x56, 0xd9, 0x38, 0xd5, 0xf5, 0xd3, 0x1e, 0xde, 0xa6, 0xb5, 0x93, 0x61, 0x67, 0xdb, 0xd6, 0xfa,
-	0xd8, 0xb2, 0xd5, 0xbe, 0xc1, 0x09, 0xae, 0xf8, 0x06, 0x69, 0x99, 0xe7, 0x86, 0xad, 0x6f, 0x3f,
-	0xc2, 0xe7, 0x7c, 0x94, 0xca, 0x13, 0x93, 0xbd, 0x86, 0xa9, 0xeb, 0x9d, 0x90, 0x6e, 0x3a, 0xb9,
-	0x6d, 0x43, 0x35, 0xd5, 0xbe, 0xc3, 0xbd, 0x39, 0xd1, 0x7d, 0xa6, 0xf6, 0xb4, 0xb6, 0x6a, 0xeb,
-	0x26, 0xa3, 0x90, 0x3e, 0x07, 0xc8, 0xc8, 0xf8, 0xfd, 0x21, 0xb6, 0x6c, 0x74, 0x13, 0x92, 0xb8,
-	0xd5, 0xd5, 0xcb, 0xc2, 0xa6, 0x70, 0x3d, 0x7f, 0xf3, 0xca, 0xd6, 0xd8, 0x02, 0xb7, 0x38, 0x5d,
-	0xbd, 0xd5, 0xd5, 0x1b, 0x31, 0x99, 0xd2, 0xa2, 0xdb, 0x90, 0xea, 0xf4, 0x86, 0x56, 0xb7, 0x1c,
-	0xa7, 0x4c, 0x4f, 0x44, 0x31, 0xed, 0x12, 0xa2, 0x46, 0x4c, 0x66, 0xd4, 0x64, 0x28, 0x6d, 0xd0,
-	0xd1, 0xcb, 0x89, 0xe9, 0x43, 0xed, 0x0d, 0x3a, 0x74, 0x28, 0x42, 0x8b, 0x76, 0x00, 0xb4, 0x81,
-	0x66, 0x2b, 0xad, 0xae, 0xaa, 0x0d, 0xca, 0x29, 0xca, 0x79, 0x35, 0x9a, 0x53, 0xb3, 0x6b, 0x84,
-	0xb0, 0x11, 0x93, 0x73, 0x9a, 0x53, 0x21, 0xd3, 0x7d, 0x7f, 0x88, 0xcd, 0xf3, 0x72, 0x7a, 0xfa,
-	0x74, 0xdf, 0x24, 0x44, 0x64, 0xba, 0x94, 0x1a, 0xbd, 0x0a, 0xd9, 0x56, 0x17, 0xb7, 0x1e, 0x29,
-	0xf6, 0xa8, 0x9c, 0xa5, 0x9c, 0x1b, 0x51, 0x9c, 0x35, 0x42, 0xd7, 0x1c, 0x35, 0x62, 0x72, 0xa6,
-	0xc5, 0x8a, 0xe8, 0x65, 0x48, 0xb7, 0xf4, 0x7e, 0x5f, 0xb3, 0xcb, 0x79, 0xca, 0xbb, 0x1e, 0xc9,
-	0x4b, 0xa9, 0x1a, 0x31, 0x99, 0xd3, 0xa3, 0x03, 0x28, 0xf5, 0x34, 0xcb, 0x56, 0xac, 0x81, 0x6a,
-	0x58, 0x5d, 0xdd, 0xb6, 0xca, 0x05, 0x2a, 0xe1, 0xc9, 0x28, 0x09, 0xf7, 0x35, 0xcb, 0x3e, 0x76,
-	0x88, 0x1b, 0x31, 0xb9, 0xd8, 0xf3, 0x37, 0x10, 0x79, 0x7a, 0xa7, 0x83, 0x4d, 0x57, 0x60, 0xb9,
-	0x38, 0x5d, 0xde, 0x21, 0xa1, 0x76, 0xf8, 0x89, 0x3c, 0xdd, 0xdf, 0x80, 0x7e, 0x04, 0x2b, 0x3d,
-	0x5d, 0x6d, 0xbb, 0xe2, 0x94, 0x56, 0x77, 0x38, 0x78, 0x54, 0x2e, 0x51, 0xa1, 0xcf, 0x44, 0x4e,
-	0x52, 0x57, 0xdb, 0x8e, 0x88, 0x1a, 0x61, 0x68, 0xc4, 0xe4, 0xe5, 0xde, 0x78, 0x23, 0x7a, 0x07,
-	0x56, 0x55, 0xc3, 0xe8, 0x9d, 0x8f, 0x4b, 0x5f, 0xa2, 0xd2, 0x6f, 0x44, 0x49, 0xaf, 0x12, 0x9e,
-	0x71, 0xf1, 0x48, 0x9d, 0x68, 0x45, 0x4d, 0x10, 0x0d, 0x13, 0x1b, 0xaa, 0x89, 0x15, 0xc3, 0xd4,
-	0x0d, 0xdd, 0x52, 0x7b, 0x65, 0x91, 0xca, 0x7e, 0x3a, 0x4a, 0xf6, 0x11, 0xa3, 0x3f, 0xe2, 0xe4,
-	0x8d, 0x98, 0xbc, 0x64, 0x04, 0x9b, 0x98, 0x54, 0xbd, 0x85, 0x2d, 0xcb, 0x93, 0xba, 0x3c, 0x4b,
-	0x2a, 0xa5, 0x0f, 0x4a, 0x0d, 0x34, 0xa1, 0x3a, 0xe4, 0xf1, 0x88, 0xb0, 0x2b, 0x67, 0xba, 0x8d,
-	0xcb, 0x88, 0x0a, 0x94, 0x22, 0x6f, 0x28, 0x25, 0x7d, 0xa8, 0xdb, 0xb8, 0x11, 0x93, 0x01, 0xbb,
-	0x35, 0xa4, 0xc2, 0x85, 0x33, 0x6c, 0x6a, 0x9d, 0x73, 0x2a, 0x46, 0xa1, 0x3d, 0x96, 0xa6, 0x0f,
-	0xca, 0x2b, 0x54, 0xe0, 0xb3, 0x51, 0x02, 0x1f, 0x52, 0x26, 0x22, 0xa2, 0xee, 0xb0, 0x34, 0x62,
-	0xf2, 0xca, 0xd9, 0x64, 0x33, 0x39, 0x62, 0x1d, 0x6d, 0xa0, 0xf6, 0xb4, 0x0f, 0xb1, 0x72, 0xd2,
-	0xd3, 0x5b, 0x8f, 0xca, 0xab, 0xd3, 0x8f, 0xd8, 0x2e, 0xa7, 0xde, 0x21, 0xc4, 0xe4, 0x88, 0x75,
-	0xfc, 0x0d, 0xe8, 0xff, 0x21, 0xa7, 0x0d, 0x2c, 0x6c, 0xda, 0xe4, 0xee, 0x5d, 0xa0, 0xa2, 0x36,
-	0xa3, 0x2f, 0x3d, 0x21, 0xa4, 0x97, 0x2f, 0xab, 0xf1, 0x32, 0xb9, 0xbb, 0x26, 0x56, 0x0d, 0xc5,
-	0x1e, 0x59, 0xe5, 0xb5, 0xe9, 0x77, 0x57, 0xc6, 0xaa, 0xd1, 0x1c, 0x91, 0x7b, 0x93, 0x31, 0x59,
-	0x71, 0x27, 0x03, 0xa9, 0x33, 0xb5, 0x37, 0xc4, 0xfb, 0xc9, 0x6c, 0x52, 0x4c, 0xed, 0x27, 0xb3,
-	0x19, 0x31, 0xbb, 0x9f, 0xcc, 0xe6, 0x44, 0xd8, 0x4f, 0x66, 0x41, 0xcc, 0x4b, 0x4f, 0x43, 0xde,
-	0x67, 0x17, 0x51, 0x19, 0x32, 0x7d, 0x6c, 0x59, 0xea, 0x29, 0xa6, 0x66, 0x34, 0x27, 0x3b, 0x55,
-	0xa9, 0x04, 0x05, 0xbf, 0x2d, 0x94, 0x3e, 0x11, 0x5c, 0x4e, 0x62, 0xe6, 0x08, 0xe7, 0x19, 0x36,
-	0xe9, 0x6e, 0x70, 0x4e, 0x5e, 0x45, 0xd7, 0xa0, 0x48, 0x35, 0xa9, 0x38, 0xfd, 0xc4, 0xd6, 0x26,
-	0xe5, 0x02, 0x6d, 0x7c, 0xc8, 0x89, 0x36, 0x20, 0x6f, 0xdc, 0x34, 0x5c, 0x92, 0x04, 0x25, 0x01,
-	0xe3, 0xa6, 0xe1, 0x10, 0x5c, 0x85, 0x02, 0x59, 0xab, 0x4b, 0x91, 0xa4, 0x83, 0xe4, 0x49, 0x1b,
-	0x27, 0x91, 0xfe, 0x1c, 0x07, 0x71, 0xdc, 0x7e, 0xa2, 0x97, 0x21, 0x49, 0x5c, 0x16, 0xf7, 0x0a,
-	0x95, 0x2d, 0xe6, 0xcf, 0xb6, 0x1c, 0x7f, 0xb6, 0xd5, 0x74, 0xfc, 0xd9, 0x4e, 0xf6, 0x8b, 0xaf,
-	0x36, 0x62, 0x9f, 0xfc, 0x75, 0x43, 0x90, 0x29, 0x07, 0xba, 0x44, 0xac, 0xa6, 0xaa, 0x0d, 0x14,
-	0xad, 0x4d, 0xa7, 0x9c, 0x23, 0x26, 0x51, 0xd5, 0x06, 0x7b, 0x6d, 0x74, 0x1f, 0xc4, 0x96, 0x3e,
-	0xb0, 0xf0, 0xc0, 0x1a, 0x5a, 0x0a, 0x73, 0x59, 0xdc, 0x17, 0x04, 0x2c, 0x3a, 0xf3, 0xb7, 0x35,
-	0x87, 0xf2, 0x88, 0x12, 0xca, 0x4b, 0xad, 0x60, 0x03, 0xda, 0x05, 0x70, 0xfd, 0x9a, 0x55, 0x4e,
-	0x6e, 0x26, 0x42, 0x0f, 0xc9, 0x43, 0x87, 0xe4, 0x81, 0xd1, 0x56, 0x6d, 0xbc, 0x93, 0x24, 0xd3,
-	0x95, 0x7d, 0x9c, 0xe8, 0x29, 0x58, 0x52, 0x0d, 0x43, 0xb1, 0x6c, 0xd5, 0xc6, 0xca, 0xc9, 0xb9,
-	0x8d, 0x2d, 0xea, 0x66, 0x0a, 0x72, 0x51, 0x35, 0x8c, 0x63, 0xd2, 0xba, 0x43, 0x1a, 0xd1, 0x93,
-	0x50, 0x22, 0x2e, 0x45, 0x53, 0x7b, 0x4a, 0x17, 0x6b, 0xa7, 0x5d, 0x9b, 0xba, 0x93, 0x84, 0x5c,
-	0xe4, 0xad, 0x0d, 0xda, 0x28, 0xb5, 0xdd, 0x1d, 0xa7, 0xee, 0x04, 0x21, 0x48, 0xb6, 0x55, 0x5b,
-	0xa5, 0x9a, 0x2c, 0xc8, 0xb4, 0x4c, 0xda, 0x0c, 0xd5, 0xee, 0x72, 0xfd, 0xd0, 0x32, 0x5a, 0x83,
-	0x34, 0x17, 0x9b, 0xa0, 0x62, 0x79, 0x0d, 0xad, 0x42, 0xca, 0x30, 0xf5, 0x33, 0x4c, 0xb7, 0x2e,
-	0x2b, 0xb3, 0x8a, 0x24, 0x43, 0x29, 0xe8, 0x7a, 0x50, 0x09, 0xe2, 0xf6, 0x88, 0x8f, 0x12, 0xb7,
-	0x47, 0xe8, 0x05, 0x48, 0x12, 0x45, 0xd2, 0x31, 0x4a, 0x21, 0xce, 0x96, 0xf3, 0x35, 0xcf, 0x0d,
-	0x2c, 0x53, 0x4a, 0xe9, 0x2a, 0x2c, 0x8d, 0x5d, 0xa9, 0x71, 0xa1, 0xd2, 0xae, 0x3b, 0x2c, 0xbf,
-	0x35, 0xe8, 0x32, 0xe4, 0xfa, 0xea, 0x88, 0xeb, 0x4d, 0xa0, 0xe7, 0x2f, 0xdb, 0x57, 0x47, 0x4c,
-	0x65, 0x17, 0x21, 0x43, 0x3a, 0x4f, 0x55, 0x8b, 0x9f, 0xde, 0x74, 0x5f, 0x1d, 0xbd, 0xae, 0x5a,
-	0xd2, 0x12, 0x14, 0x03, 0xde, 0x4f, 0x5a, 0x83, 0xd5, 0x30, 0x67, 0x26, 0x75, 0xdd, 0xf6, 0x80,
-	0x53, 0x42, 0xb7, 0x21, 0xeb, 0x7a, 0x33, 0x76, 0x46, 0x2f, 0x4d, 0xac, 0xd0, 0x21, 0x96, 0x5d,
-	0x52, 0x72, 0x38, 0xc9, 0x5e, 0x77, 0x55, 0x1e, 0xbb, 0x14, 0xe4, 0x8c, 0x6a, 0x18, 0x0d, 0xd5,
-	0xea, 0x4a, 0xef, 0x42, 0x39, 0xca, 0x53, 0xf9, 0xf6, 0x86, 0xad, 0xd0, 0xd9, 0x9b, 0x35, 0x48,
-	0x77, 0x74, 0xb3, 0xaf, 0xda, 0x54, 0x58, 0x51, 0xe6, 0x35, 0xb2, 0x67, 0xcc, 0x6b, 0x25, 0x68,
-	0x33, 0xab, 0x48, 0x0a, 0x5c, 0x8a, 0xf4, 0x56, 0x84, 0x45, 0x1b, 0xb4, 0x31, 0x53, 0x76, 0x51,
-	0x66, 0x15, 0x4f, 0x10, 0x9b, 0x2c, 0xab, 0x90, 0x61, 0x2d, 0xba, 0x56, 0x2a, 0x3f, 0x27, 0xf3,
-	0x9a, 0xf4, 0x69, 0x02, 0xd6, 0xc2, 0x7d, 0x16, 0xda, 0x84, 0x02, 0xd9, 0x09, 0xdb, 0xbf, 0x53,
-	0x09, 0x19, 0xfa, 0xea, 0xa8, 0xc9, 0xf7, 0x4a, 0x84, 0x04, 0x31, 0x96, 0xf1, 0xcd, 0xc4, 0xf5,
-	0x82, 0x4c, 0x8a, 0xe8, 0x01, 0x2c, 0xf7, 0xf4, 0x96, 0xda, 0x53, 0x7a, 0xaa, 0x65, 0x2b, 0x3c,
-	0x98, 0x61, 0xf7, 0xf5, 0xda, 0x84, 0xb2, 0x99, 0xf7, 0xc1, 0x6d, 0xb6, 0x9f, 0xc4, 0xb6, 0xf1,
-	0xab, 0xb6, 0x44, 0x65, 0xdc, 0x57, 0x9d, 0xad, 0x46, 0x77, 0x21, 0xdf, 0xd7, 0xac, 0x13, 0xdc,
-	0x55, 0xcf, 0x34, 0xdd, 0xe4, 0x17, 0x77, 0xf2, 0x7c, 0xbe, 0xe1, 0xd1, 0x70, 0x49, 0x7e, 0x36,
-	0xdf, 0x96, 0xa4, 0x02, 0xd7, 0xc5, 0x31, 0x5c, 0xe9, 0x85, 0x0d, 0xd7, 0x0b, 0xb0, 0x3a, 0xc0,
-	0x23, 0x5b, 0xf1, 0x4c, 0x03, 0x3b, 0x27, 0x19, 0xaa, 0x7a, 0x44, 0xfa, 0x5c, 0x63, 0x62, 0x91,
-	0x23, 0x83, 0x9e, 0xa1, 0x5e, 0xdf, 0xd0, 0x2d, 0x6c, 0x2a, 0x6a, 0xbb, 0x6d, 0x62, 0xcb, 0xa2,
-	0x81, 0x62, 0x81, 0xba, 0x72, 0xda, 0x5e, 0x65, 0xcd, 0xd2, 0x2f, 0xfd, 0x5b, 0x13, 0xf4, 0xf2,
-	0x5c, 0xf1, 0x82, 0xa7, 0xf8, 0x63, 0x58, 0xe5, 0xfc, 0xed, 0x80, 0xee, 0x59, 0xb4, 0x7d, 0x79,
-	0xf2, 0x2a, 0x8f, 0xeb, 0x1c, 0x39, 0xec, 0xd1, 0x6a, 0x4f, 0x3c, 0x9e, 0xda, 0x11, 0x24, 0xa9,
-	0x52, 0x92, 0xcc, 0x9a, 0x91, 0xf2, 0xbf, 0xdb, 0x56, 0x7c, 0x94, 0x80, 0xe5, 0x89, 0x90, 0xc9,
-	0x5d, 0x98, 0x10, 0xba, 0xb0, 0x78, 0xe8, 0xc2, 0x12, 0x0b, 0x2f, 0x8c, 0xef, 0x75, 0x72, 0xf6,
-	0x5e, 0xa7, 0xbe, 0xc7, 0xbd, 0x4e, 0x3f, 0xde, 0x5e, 0xff, 0x4b, 0x77, 0xe1, 0x37, 0x02, 0x54,
-	0xa2, 0xe3, 0xcc, 0xd0, 0xed, 0x78, 0x16, 0x96, 0xdd, 0xa9, 0xb8, 0xe2, 0x99, 0x61, 0x14, 0xdd,
-	0x0e, 0x2e, 0x3f, 0xd2, 0x9d, 0x3e, 0x09, 0xa5, 0xb1, 0x28, 0x98, 0x1d, 0xe5, 0xe2, 0x99, 0x7f,
-	0x7c, 0xe9, 0xe7, 0x09, 0xd7, 0xf1, 0x04, 0x42, 0xd5, 0x90, 0xdb, 0xfa, 0x26, 0xac, 0xb4, 0x71,
-	0x4b, 0x6b, 0x3f, 0xee, 0x65, 0x5d, 0xe6, 0xdc, 0xff, 0xbd, 0xab, 0x93, 0xa7, 0xe4, 0xd7, 0x79,
-	0xc8, 0xca, 0xd8, 0x32, 0x48, 0xe8, 0x87, 0x76, 0x20, 0x87, 0x47, 0x2d, 0x6c, 0xd8, 0x4e, 0xb4,
-	0x1c, 0x9e, 0x0c, 0x31, 0xea, 0xba, 0x43, 0xd9, 0x88, 0xc9, 0x1e, 0x1b, 0xba, 0xc5, 0xd1, 0x8e,
-	0x68, 0xe0, 0x82, 0xb3, 0xfb, 0xe1, 0x8e, 0x17, 0x1d, 0xb8, 0x23, 0x11, 0x99, 0xc9, 0x33, 0xae,
-	0x31, 0xbc, 0xe3, 0x16, 0xc7, 0x3b, 0x92, 0x33, 0x06, 0x0b, 0x00, 0x1e, 0xb5, 0x00, 0xe0, 0x91,
-	0x9e, 0xb1, 0xcc, 0x08, 0xc4, 0xe3, 0x45, 0x07, 0xf1, 0xc8, 0xcc, 0x98, 0xf1, 0x18, 0xe4, 0xf1,
-	0x9a, 0x0f, 0xf2, 0xc8, 0x45, 0xa6, 0x5d, 0x8c, 0x35, 0x04, 0xf3, 0x78, 0xc5, 0xc5, 0x3c, 0x0a,
-	0x91, 0x39, 0x17, 0x67, 0x1e, 0x07, 0x3d, 0x0e, 0x27, 0x40, 0x0f, 0x06, 0x52, 0x3c, 0x15, 0x29,
-	0x62, 0x06, 0xea, 0x71, 0x38, 0x81, 0x7a, 0x94, 0x66, 0x08, 0x9c, 0x01, 0x7b, 0xfc, 0x38, 0x1c,
-	0xf6, 0x88, 0x06, 0x26, 0xf8, 0x34, 0xe7, 0xc3, 0x3d, 0x94, 0x08, 0xdc, 0x43, 0x8c, 0xcc, 0xd1,
-	0x99, 0xf8, 0xb9, 0x81, 0x8f, 0x07, 0x21, 0xc0, 0x07, 0x83, 0x28, 0xae, 0x47, 0x0a, 0x9f, 0x03,
-	0xf9, 0x78, 0x10, 0x82, 0x7c, 0xa0, 0x99, 0x62, 0x67, 0x42, 0x1f, 0xbb, 0x41, 0xe8, 0x63, 0x25,
-	0x22, 0xea, 0xf4, 0x6e, 0x7b, 0x04, 0xf6, 0x71, 0x12, 0x85, 0x7d, 0x30, 0x7c, 0xe2, 0xb9, 0x48,
-	0x89, 0x0b, 0x80, 0x1f, 0x87, 0x13, 0xe0, 0xc7, 0x85, 0x19, 0x27, 0x6d, 0x06, 0xfa, 0xf1, 0x03,
-	0x3f, 0xfa, 0xb1, 0x16, 0x09, 0x79, 0x3a, 0x16, 0x20, 0x04, 0xfe, 0x78, 0xcd, 0x07, 0x7f, 0x5c,
-	0x9c, 0x71, 0x8f, 0xa7, 0xe3, 0x1f, 0x29, 0x31, 0xbd, 0x9f, 0xcc, 0x66, 0xc5, 0x1c, 0x43, 0x3e,
-	0xf6, 0x93, 0xd9, 0xbc, 0x58, 0x90, 0x9e, 0x21, 0x21, 0xd4, 0x98, 0xa1, 0x25, 0xc9, 0x0a, 0x36,
-	0x4d, 0xdd, 0xe4, 0x48, 0x06, 0xab, 0x48, 0xd7, 0x49, 0x3e, 0xec, 0x19, 0xd5, 0x29, 0x58, 0x09,
-	0x4d, 0x0a, 0x7d, 0x86, 0x54, 0xfa, 0x83, 0xe0, 0xf1, 0x52, 0xb4, 0xc4, 0x9f, 0x4b, 0xe7, 0x78,
-	0x2e, 0xed, 0x43, 0x50, 0xe2, 0x41, 0x04, 0x65, 0x03, 0xf2, 0x24, 0xd9, 0x1b, 0x03, 0x47, 0x54,
-	0xc3, 0x05, 0x47, 0x6e, 0xc0, 0x32, 0xf5, 0xd8, 0x0c, 0x67, 0xe1, 0x7e, 0x31, 0x49, 0xfd, 0xe2,
-	0x12, 0xe9, 0x60, 0xdb, 0xc3, 0x1c, 0xe4, 0xf3, 0xb0, 0xe2, 0xa3, 0x75, 0x93, 0x48, 0x86, 0x14,
-	0x88, 0x2e, 0x75, 0x95, 0x67, 0x93, 0x7f, 0x12, 0x3c, 0x0d, 0x79, 0xa8, 0x4a, 0x18, 0x00, 0x22,
-	0x7c, 0x4f, 0x00, 0x48, 0xfc, 0xb1, 0x01, 0x10, 0x7f, 0x52, 0x9c, 0x08, 0x26, 0xc5, 0xff, 0x10,
-	0xbc, 0x3d, 0x71, 0xe1, 0x8c, 0x96, 0xde, 0xc6, 0x3c, 0x4d, 0xa5, 0x65, 0x12, 0x13, 0xf5, 0xf4,
-	0x53, 0x9e, 0x8c, 0x92, 0x22, 0xa1, 0x72, 0x3d, 0x5f, 0x8e, 0x3b, 0x36, 0x37, 0xc3, 0x65, 0x91,
-	0x07, 0xcf, 0x70, 0x45, 0x48, 0x3c, 0xc2, 0x0c, 0x99, 0x2f, 0xc8, 0xa4, 0x48, 0xe8, 0xe8, 0xe1,
-	0xe3, 0x11, 0x04, 0xab, 0xa0, 0x97, 0x21, 0x47, 0x9f, 0x40, 0x14, 0xdd, 0xb0, 0x38, 0x1a, 0x1f,
-	0x88, 0xad, 0xd8, 0x33, 0xc9, 0xd6, 0x11, 0xa1, 0x39, 0x34, 0x2c, 0x39, 0x6b, 0xf0, 0x92, 0x2f,
-	0xe4, 0xc9, 0x05, 0x42, 0x9e, 0x2b, 0x90, 0x23, 0xb3, 0xb7, 0x0c, 0xb5, 0x85, 0xcb, 0x40, 0x27,
-	0xea, 0x35, 0x48, 0xbf, 0x8f, 0xc3, 0xd2, 0x98, 0xa7, 0x0b, 0x5d, 0xbb, 0x73, 0x24, 0xe3, 0x3e,
-	0x78, 0x67, 0x3e, 0x7d, 0xac, 0x03, 0x9c, 0xaa, 0x96, 0xf2, 0x81, 0x3a, 0xb0, 0x71, 0x9b, 0x2b,
-	0xc5, 0xd7, 0x82, 0x2a, 0x90, 0x25, 0xb5, 0xa1, 0x85, 0xdb, 0x1c, 0x69, 0x72, 0xeb, 0xa8, 0x01,
-	0x69, 0x7c, 0x86, 0x07, 0xb6, 0x55, 0xce, 0xd0, 0x6d, 0x5f, 0x9b, 0xcc, 0xc7, 0x49, 0xf7, 0x4e,
-	0x99, 0x6c, 0xf6, 0xb7, 0x5f, 0x6d, 0x88, 0x8c, 0xfa, 0x39, 0xbd, 0xaf, 0xd9, 0xb8, 0x6f, 0xd8,
-	0xe7, 0x32, 0xe7, 0x0f, 0x6a, 0x21, 0x3b, 0xa6, 0x05, 0x8a, 0x79, 0x16, 0x1c, 0x7c, 0x81, 0xe8,
-	0x54, 0xd3, 0x4d, 0xcd, 0x3e, 0x97, 0x8b, 0x7d, 0xdc, 0x37, 0x74, 0xbd, 0xa7, 0xb0, 0x3b, 0xfe,
-	0x14, 0x88, 0xe3, 0xe6, 0x28, 0x4c, 0x59, 0xd2, 0x35, 0x4f, 0xa7, 0x0e, 0x7e, 0x34, 0x11, 0x4f,
-	0x4b, 0x55, 0x28, 0x05, 0xa3, 0x04, 0x74, 0x0d, 0x8a, 0x26, 0xb6, 0x55, 0x6d, 0xa0, 0x04, 0x42,
-	0xfa, 0x02, 0x6b, 0x64, 0x17, 0x74, 0x3f, 0x99, 0x15, 0xc4, 0xf8, 0x7e, 0x32, 0x1b, 0x17, 0x13,
-	0xd2, 0x11, 0x5c, 0x08, 0x8d, 0x12, 0xd0, 0x4b, 0x90, 0xf3, 0x02, 0x0c, 0x81, 0xaa, 0x6e, 0x0a,
-	0x6e, 0xe4, 0xd1, 0x4a, 0x9f, 0x0b, 0x9e, 0xc8, 0x20, 0x12, 0x55, 0x87, 0xb4, 0x89, 0xad, 0x61,
-	0x8f, 0x61, 0x43, 0xa5, 0x9b, 0xcf, 0xcf, 0x17, 0x5f, 0x90, 0xd6, 0x61, 0xcf, 0x96, 0x39, 0xb3,
-	0xf4, 0x0e, 0xa4, 0x59, 0x0b, 0xca, 0x43, 0xe6, 0xc1, 0xc1, 0xbd, 0x83, 0xc3, 0xb7, 0x0e, 0xc4,
-	0x18, 0x02, 0x48, 0x57, 0x6b, 0xb5, 0xfa, 0x51, 0x53, 0x14, 0x50, 0x0e, 0x52, 0xd5, 0x9d, 0x43,
-	0xb9, 0x29, 0xc6, 0x49, 0xb3, 0x5c, 0xdf, 0xaf, 0xd7, 0x9a, 0x62, 0x02, 0x2d, 0x43, 0x91, 0x95,
-	0x95, 0xdd, 0x43, 0xf9, 0x8d, 0x6a, 0x53, 0x4c, 0xfa, 0x9a, 0x8e, 0xeb, 0x07, 0x77, 0xeb, 0xb2,
-	0x98, 0x92, 0xfe, 0x07, 0x2e, 0x45, 0x46, 0x24, 0x1e, 0xcc, 0x24, 0xf8, 0x60, 0x26, 0xe9, 0xd3,
-	0x38, 0x49, 0xd1, 0xa2, 0xc2, 0x0c, 0xb4, 0x3f, 0xb6, 0xf0, 0x9b, 0x0b, 0xc4, 0x28, 0x63, 0xab,
-	0x27, 0x59, 0x99, 0x89, 0x3b, 0xd8, 0x6e, 0x75, 0x59, 0xd8, 0xc3, 0xcc, 0x59, 0x51, 0x2e, 0xf2,
-	0x56, 0xca, 0x64, 0x31, 0xb2, 0xf7, 0x70, 0xcb, 0x56, 0xd8, 0x89, 0xb4, 0x68, 0x6a, 0x94, 0x23,
-	0x64, 0xa4, 0xf5, 0x98, 0x35, 0x4a, 0xef, 0x2e, 0xa4, 0xcb, 0x1c, 0xa4, 0xe4, 0x7a, 0x53, 0x7e,
-	0x5b, 0x4c, 0x20, 0x04, 0x25, 0x5a, 0x54, 0x8e, 0x0f, 0xaa, 0x47, 0xc7, 0x8d, 0x43, 0xa2, 0xcb,
-	0x15, 0x58, 0x72, 0x74, 0xe9, 0x34, 0xa6, 0xa4, 0x67, 0xe1, 0x62, 0x44, 0x8c, 0x14, 0x72, 0xa0,
-	0x7f, 0x2b, 0xf8, 0xa9, 0x83, 0x71, 0xce, 0x21, 0xa4, 0x2d, 0x5b, 0xb5, 0x87, 0x16, 0x57, 0xe2,
-	0x4b, 0xf3, 0x06, 0x4d, 0x5b, 0x4e, 0xe1, 0x98, 0xb2, 0xcb, 0x5c, 0x8c, 0x74, 0x1b, 0x4a, 0xc1,
-	0x9e, 0x68, 0x1d, 0x78, 0x87, 0x28, 0x2e, 0xdd, 0x01, 0x34, 0x19, 0x4b, 0x85, 0x24, 0xcb, 0x42,
-	0x58, 0xb2, 0xfc, 0x3b, 0x01, 0x2e, 0x4f, 0x89, 0x9b, 0xd0, 0x9b, 0x63, 0x8b, 0x7c, 0x65, 0x91,
-	0xa8, 0x6b, 0x8b, 0xb5, 0x8d, 0x2d, 0xf3, 0x16, 0x14, 0xfc, 0xed, 0xf3, 0x2d, 0xf2, 0xdb, 0xb8,
-	0x77, 0x89, 0x83, 0x59, 0xbd, 0x67, 0x4f, 0x85, 0xef, 0x68, 0x4f, 0x5f, 0x05, 0xb0, 0x47, 0x0a,
-	0x3b, 0xd6, 0x8e, 0x53, 0x7e, 0x22, 0x04, 0x2d, 0xc5, 0xad, 0xe6, 0x88, 0x5f, 0x82, 0x9c, 0xcd,
-	0x4b, 0x16, 0x3a, 0xf6, 0x43, 0x1c, 0x43, 0xea, 0xb0, 0x2d, 0x9e, 0xfe, 0xcf, 0xeb, 0xd9, 0x3d,
-	0x28, 0x84, 0x35, 0x5b, 0xe8, 0x6d, 0xb8, 0x38, 0x16, 0x75, 0xb8, 0xa2, 0x93, 0xf3, 0x06, 0x1f,
-	0x17, 0x82, 0xc1, 0x87, 0x23, 0xda, 0x1f, 0x3a, 0xa4, 0x82, 0xa1, 0xc3, 0xdb, 0x00, 0x1e, 0xd4,
-	0x41, 0x2c, 0x8c, 0xa9, 0x0f, 0x07, 0x6d, 0x7a, 0x02, 0x52, 0x32, 0xab, 0xa0, 0xdb, 0x90, 0x22,
-	0x27, 0xc9, 0xd1, 0xd3, 0xa4, 0x29, 0x26, 0x27, 0xc1, 0x07, 0x95, 0x30, 0x6a, 0x49, 0x03, 0x34,
-	0x09, 0x37, 0x47, 0x0c, 0xf1, 0x5a, 0x70, 0x88, 0xab, 0x91, 0xc0, 0x75, 0xf8, 0x50, 0x1f, 0x42,
-	0x8a, 0xee, 0x3c, 0x71, 0x67, 0xf4, 0x39, 0x85, 0x87, 0x9e, 0xa4, 0x8c, 0x7e, 0x02, 0xa0, 0xda,
-	0xb6, 0xa9, 0x9d, 0x0c, 0xbd, 0x01, 0x36, 0xc2, 0x4f, 0x4e, 0xd5, 0xa1, 0xdb, 0xb9, 0xc2, 0x8f,
-	0xd0, 0xaa, 0xc7, 0xea, 0x3b, 0x46, 0x3e, 0x81, 0xd2, 0x01, 0x94, 0x82, 0xbc, 0x4e, 0xb0, 0xc4,
-	0xe6, 0x10, 0x0c, 0x96, 0x58, 0xec, 0xcb, 0x83, 0x25, 0x37, 0xd4, 0x4a, 0xb0, 0x37, 0x23, 0x5a,
-	0x91, 0x7e, 0x1a, 0x87, 0x82, 0xff, 0xe0, 0xfd, 0xe7, 0xc5, 0x33, 0xd2, 0x2f, 0x04, 0xc8, 0xba,
-	0xcb, 0x0f, 0xbe, 0xea, 0x04, 0x5e, 0xdc, 0x98, 0xf6, 0xe2, 0xfe, 0xa7, 0x18, 0xf6, 0x14, 0x96,
-	0x70, 0xdf, 0xd7, 0xee, 0xb8, 0xee, 0x2f, 0x0a, 0xde, 0xf1, 0xeb, 0x9a, 0x9f, 0x2a, 0xc7, 0xdb,
-	0xdf, 0x81, 0x9c, 0x7b, 0x7b, 0x49, 0x06, 0xe3, 0xc0, 0x60, 0x02, 0xbf, 0x43, 0x1c, 0xc4, 0x5c,
-	0x85, 0x94, 0xa1, 0x7f, 0xc0, 0xdf, 0x79, 0x12, 0x32, 0xab, 0x48, 0x6d, 0x58, 0x1a, 0xbb, 0xfa,
-	0xe8, 0x0e, 0x64, 0x8c, 0xe1, 0x89, 0xe2, 0x1c, 0x8e, 0x31, 0xb0, 0xd0, 0x89, 0x8d, 0x87, 0x27,
-	0x3d, 0xad, 0x75, 0x0f, 0x9f, 0x3b, 0x93, 0x31, 0x86, 0x27, 0xf7, 0xd8, 0x19, 0x62, 0xa3, 0xc4,
-	0xfd, 0xa3, 0xfc, 0x4a, 0x80, 0xac, 0x73, 0x27, 0xd0, 0xff, 0x41, 0xce, 0x35, 0x2b, 0xee, 0x9b,
-	0x70, 0xa4, 0x3d, 0xe2, 0xf2, 0x3d, 0x16, 0x54, 0x75, 0x1e, 0xb3, 0xb5, 0xb6, 0xd2, 0xe9, 0xa9,
-	0xec, 0x2c, 0x95, 0x82, 0x3a, 0x63, 0x86, 0x87, 0xda, 0xe3, 0xbd, 0xbb, 0xbb, 0x3d, 0xf5, 0x54,
-	0xce, 0x53, 0x9e, 0xbd, 0x36, 0xa9, 0xf0, 0xc8, 0xee, 0xef, 0x02, 0x88, 0xe3, 0x37, 0xf6, 0x3b,
-	0xcf, 0x6e, 0xd2, 0xcd, 0x25, 0x42, 0xdc, 0x1c, 0xda, 0x86, 0x15, 0x97, 0x42, 0xb1, 0xb4, 0xd3,
-	0x81, 0x6a, 0x0f, 0x4d, 0xcc, 0xe1, 0x55, 0xe4, 0x76, 0x1d, 0x3b, 0x3d, 0x93, 0xab, 0x4e, 0x3d,
-	0xe6, 0xaa, 0x3f, 0x8a, 0x43, 0xde, 0x07, 0xf6, 0xa2, 0xff, 0xf5, 0x19, 0xa3, 0x52, 0x88, 0x67,
-	0xf0, 0xd1, 0x7a, 0xef, 0xbb, 0x41, 0x35, 0xc5, 0x17, 0x57, 0x53, 0x14, 0xa4, 0xee, 0x60, 0xc7,
-	0xc9, 0x85, 0xb1, 0xe3, 0xe7, 0x00, 0xd9, 0xba, 0xad, 0xf6, 0x94, 0x33, 0xdd, 0xd6, 0x06, 0xa7,
-	0x0a, 0x3b, 0x86, 0xcc, 0x74, 0x88, 0xb4, 0xe7, 0x21, 0xed, 0x38, 0xa2, 0x27, 0xf2, 0x67, 0x02,
-	0x64, 0xdd, 0xb0, 0x7b, 0xd1, 0x27, 0xd9, 0x35, 0x48, 0xf3, 0xc8, 0x92, 0xbd, 0xc9, 0xf2, 0x5a,
-	0x28, 0x48, 0x5e, 0x81, 0x6c, 0x1f, 0xdb, 0x2a, 0xb5, 0x83, 0xcc, 0xab, 0xb9, 0xf5, 0x1b, 0xaf,
-	0x40, 0xde, 0xf7, 0x72, 0x4e, 0x4c, 0xe3, 0x41, 0xfd, 0x2d, 0x31, 0x56, 0xc9, 0x7c, 0xfc, 0xd9,
-	0x66, 0xe2, 0x00, 0x7f, 0x40, 0x6e, 0xb3, 0x5c, 0xaf, 0x35, 0xea, 0xb5, 0x7b, 0xa2, 0x50, 0xc9,
-	0x7f, 0xfc, 0xd9, 0x66, 0x46, 0xc6, 0x14, 0x1f, 0xbd, 0x71, 0x0f, 0x96, 0xc6, 0x36, 0x26, 0x18,
-	0xb6, 0x20, 0x28, 0xdd, 0x7d, 0x70, 0x74, 0x7f, 0xaf, 0x56, 0x6d, 0xd6, 0x95, 0x87, 0x87, 0xcd,
-	0xba, 0x28, 0xa0, 0x8b, 0xb0, 0x72, 0x7f, 0xef, 0xf5, 0x46, 0x53, 0xa9, 0xdd, 0xdf, 0xab, 0x1f,
-	0x34, 0x95, 0x6a, 0xb3, 0x59, 0xad, 0xdd, 0x13, 0xe3, 0x37, 0xff, 0x58, 0x80, 0x64, 0x75, 0xa7,
-	0xb6, 0x87, 0x6a, 0x90, 0xa4, 0xb8, 0xca, 0xd4, 0x9f, 0x7b, 0x95, 0xe9, 0x48, 0x37, 0xda, 0x85,
-	0x14, 0x85, 0x5c, 0xd0, 0xf4, 0xaf, 0x7c, 0x95, 0x19, 0xd0, 0x37, 0x99, 0x0c, 0xbd, 0x91, 0x53,
-	0xff, 0xf6, 0x55, 0xa6, 0x23, 0xe1, 0xe8, 0x3e, 0x64, 0x9c, 0x8c, 0x7b, 0xd6, 0x87, 0xbb, 0xca,
-	0x4c, 0x78, 0x1a, 0x1d, 0x42, 0xd6, 0xcd, 0x49, 0x67, 0xfe, 0x21, 0xaa, 0xcc, 0xc6, 0xd9, 0xc8,
-	0xf4, 0x9c, 0xe4, 0x75, 0xd6, 0x9f, 0xa2, 0xca, 0x4c, 0xd4, 0x8d, 0x68, 0x9e, 0x01, 0x2b, 0xd3,
-	0x7f, 0x25, 0x56, 0x66, 0x40, 0xf8, 0x68, 0x0f, 0xd2, 0x3c, 0x5b, 0x9e, 0xf1, 0xd1, 0xb0, 0x32,
-	0x0b, 0x94, 0x47, 0x32, 0xe4, 0x3c, 0xc8, 0x6a, 0xf6, 0x5f, 0xcb, 0xca, 0x1c, 0xaf, 0x13, 0xe8,
-	0x1d, 0x28, 0x06, 0x33, 0xf1, 0xf9, 0x3e, 0x33, 0x56, 0xe6, 0x84, 0xff, 0x89, 0xfc, 0x60, 0x5a,
-	0x3e, 0xdf, 0xe7, 0xc6, 0xca, 0x9c, 0xaf, 0x01, 0xe8, 0x3d, 0x58, 0x9e, 0x4c, 0x9b, 0xe7, 0xff,
-	0xeb, 0x58, 0x59, 0xe0, 0x7d, 0x00, 0xf5, 0x01, 0x85, 0xa4, 0xdb, 0x0b, 0x7c, 0x7d, 0xac, 0x2c,
-	0xf2, 0x5c, 0x80, 0xda, 0xb0, 0x34, 0x9e, 0xc3, 0xce, 0xfb, 0x15, 0xb2, 0x32, 0xf7, 0xd3, 0x01,
-	0x1b, 0x25, 0x98, 0xfb, 0xce, 0xfb, 0x35, 0xb2, 0x32, 0xf7, 0x4b, 0x02, 0x7a, 0x00, 0xe0, 0x4b,
-	0x5f, 0xe7, 0xf8, 0x2a, 0x59, 0x99, 0xe7, 0x4d, 0x01, 0x19, 0xb0, 0x12, 0x96, 0xd7, 0x2e, 0xf2,
-	0x73, 0xb2, 0xb2, 0xd0, 0x53, 0x03, 0x39, 0xcf, 0xc1, 0x0c, 0x75, 0xbe, 0x9f, 0x94, 0x95, 0x39,
-	0xdf, 0x1c, 0x76, 0xaa, 0x5f, 0x7c, 0xbd, 0x2e, 0x7c, 0xf9, 0xf5, 0xba, 0xf0, 0xb7, 0xaf, 0xd7,
-	0x85, 0x4f, 0xbe, 0x59, 0x8f, 0x7d, 0xf9, 0xcd, 0x7a, 0xec, 0x2f, 0xdf, 0xac, 0xc7, 0x7e, 0xf8,
-	0xf4, 0xa9, 0x66, 0x77, 0x87, 0x27, 0x5b, 0x2d, 0xbd, 0xbf, 0xdd, 0xd2, 0xfb, 0xd8, 0x3e, 0xe9,
-	0xd8, 0x5e, 0xc1, 0xfb, 0x10, 0x7f, 0x92, 0xa6, 0x0e, 0xfe, 0xd6, 0x3f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x83, 0x0c, 0x75, 0x30, 0x2f, 0x00, 0x00,
+	// 3357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xb9, 0x73, 0x23, 0xc7,
+	0xd5, 0xc7, 0xe0, 0xc6, 0xc3, 0x35, 0x6c, 0x72, 0x77, 0xb1, 0xd8, 0x15, 0xc9, 0x9d, 0x2d, 0x49,
+	0xab, 0x95, 0x44, 0x4a, 0xdc, 0x4f, 0x57, 0xad, 0xf4, 0xd9, 0x20, 0x16, 0x14, 0xc8, 0xa5, 0x48,
+	0x6a, 0x08, 0xae, 0x4a, 0x3e, 0x34, 0x1a, 0x02, 0x4d, 0x60, 0xb4, 0x00, 0x66, 0x34, 0x33, 0xa0,
+	0x40, 0x45, 0x2e, 0xcb, 0xae, 0x72, 0x29, 0x52, 0x95, 0xcb, 0x55, 0x0a, 0xac, 0xc0, 0x55, 0x76,
+	0xe2, 0xbf, 0xc0, 0x81, 0x4b, 0x91, 0x03, 0x05, 0x0e, 0x14, 0x3a, 0x92, 0x5d, 0x52, 0xe6, 0xd4,
+	0x81, 0x53, 0x57, 0x1f, 0x33, 0x98, 0x01, 0x66, 0x70, 0xac, 0xe4, 0xc0, 0x65, 0x67, 0x7d, 0xbc,
+	0xf7, 0xba, 0xfb, 0x75, 0xf7, 0x3b, 0x7e, 0xdd, 0x70, 0xcd, 0xc6, 0xfd, 0x16, 0x36, 0x7b, 0x5a,
+	0xdf, 0xde, 0x54, 0x4f, 0x9b, 0xda, 0xa6, 0x7d, 0x61, 0x60, 0x6b, 0xc3, 0x30, 0x75, 0x5b, 0x47,
+	0xc5, 0x51, 0xe7, 0x06, 0xe9, 0x2c, 0xaf, 0xb4, 0xf5, 0xb6, 0x4e, 0xfb, 0x36, 0x49, 0x89, 0x91,
+	0x95, 0xd7, 0xda, 0xba, 0xde, 0xee, 0xe2, 0x4d, 0x5a, 0x3b, 0x1d, 0x9c, 0x6d, 0xda, 0x5a, 0x0f,
+	0x5b, 0xb6, 0xda, 0x33, 0x38, 0xc1, 0x75, 0xcf, 0x20, 0x4d, 0xf3, 0xc2, 0xb0, 0xf5, 0xcd, 0x87,
+	0xf8, 0x82, 0x8f, 0x52, 0x7e, 0x6c, 0xb2, 0xd7, 0x30, 0x75, 0xfd, 0x2c, 0xa0, 0x9b, 0x4e, 0x6e,
+	0xd3, 0x50, 0x4d, 0xb5, 0xe7, 0x70, 0xaf, 0x4f, 0x74, 0x9f, 0xab, 0x5d, 0xad, 0xa5, 0xda, 0xba,
+	0xc9, 0x28, 0xa4, 0xcf, 0x01, 0x52, 0x32, 0x7e, 0x7f, 0x80, 0x2d, 0x1b, 0x6d, 0x41, 0x1c, 0x37,
+	0x3b, 0x7a, 0x49, 0x58, 0x17, 0x6e, 0x65, 0xb7, 0xae, 0x6f, 0x8c, 0x2d, 0x70, 0x83, 0xd3, 0xd5,
+	0x9a, 0x1d, 0xbd, 0x1e, 0x91, 0x29, 0x2d, 0x7a, 0x01, 0x12, 0x67, 0xdd, 0x81, 0xd5, 0x29, 0x45,
+	0x29, 0xd3, 0x63, 0x61, 0x4c, 0x3b, 0x84, 0xa8, 0x1e, 0x91, 0x19, 0x35, 0x19, 0x4a, 0xeb, 0x9f,
+	0xe9, 0xa5, 0xd8, 0xf4, 0xa1, 0x76, 0xfb, 0x67, 0x74, 0x28, 0x42, 0x8b, 0xb6, 0x01, 0xb4, 0xbe,
+	0x66, 0x2b, 0xcd, 0x8e, 0xaa, 0xf5, 0x4b, 0x09, 0xca, 0x79, 0x23, 0x9c, 0x53, 0xb3, 0xab, 0x84,
+	0xb0, 0x1e, 0x91, 0x33, 0x9a, 0x53, 0x21, 0xd3, 0x7d, 0x7f, 0x80, 0xcd, 0x8b, 0x52, 0x72, 0xfa,
+	0x74, 0xdf, 0x24, 0x44, 0x64, 0xba, 0x94, 0x1a, 0xbd, 0x0a, 0xe9, 0x66, 0x07, 0x37, 0x1f, 0x2a,
+	0xf6, 0xb0, 0x94, 0xa6, 0x9c, 0x6b, 0x61, 0x9c, 0x55, 0x42, 0xd7, 0x18, 0xd6, 0x23, 0x72, 0xaa,
+	0xc9, 0x8a, 0xe8, 0x65, 0x48, 0x36, 0xf5, 0x5e, 0x4f, 0xb3, 0x4b, 0x59, 0xca, 0xbb, 0x1a, 0xca,
+	0x4b, 0xa9, 0xea, 0x11, 0x99, 0xd3, 0xa3, 0x03, 0x28, 0x74, 0x35, 0xcb, 0x56, 0xac, 0xbe, 0x6a,
+	0x58, 0x1d, 0xdd, 0xb6, 0x4a, 0x39, 0x2a, 0xe1, 0xf1, 0x30, 0x09, 0xfb, 0x9a, 0x65, 0x1f, 0x3b,
+	0xc4, 0xf5, 0x88, 0x9c, 0xef, 0x7a, 0x1b, 0x88, 0x3c, 0xfd, 0xec, 0x0c, 0x9b, 0xae, 0xc0, 0x52,
+	0x7e, 0xba, 0xbc, 0x43, 0x42, 0xed, 0xf0, 0x13, 0x79, 0xba, 0xb7, 0x01, 0xfd, 0x10, 0x96, 0xbb,
+	0xba, 0xda, 0x72, 0xc5, 0x29, 0xcd, 0xce, 0xa0, 0xff, 0xb0, 0x54, 0xa0, 0x42, 0x9f, 0x0a, 0x9d,
+	0xa4, 0xae, 0xb6, 0x1c, 0x11, 0x55, 0xc2, 0x50, 0x8f, 0xc8, 0x4b, 0xdd, 0xf1, 0x46, 0xf4, 0x0e,
+	0xac, 0xa8, 0x86, 0xd1, 0xbd, 0x18, 0x97, 0x5e, 0xa4, 0xd2, 0x6f, 0x87, 0x49, 0xaf, 0x10, 0x9e,
+	0x71, 0xf1, 0x48, 0x9d, 0x68, 0x45, 0x0d, 0x10, 0x0d, 0x13, 0x1b, 0xaa, 0x89, 0x15, 0xc3, 0xd4,
+	0x0d, 0xdd, 0x52, 0xbb, 0x25, 0x91, 0xca, 0x7e, 0x32, 0x4c, 0xf6, 0x11, 0xa3, 0x3f, 0xe2, 0xe4,
+	0xf5, 0x88, 0x5c, 0x34, 0xfc, 0x4d, 0x4c, 0xaa, 0xde, 0xc4, 0x96, 0x35, 0x92, 0xba, 0x34, 0x4b,
+	0x2a, 0xa5, 0xf7, 0x4b, 0xf5, 0x35, 0xa1, 0x1a, 0x64, 0xf1, 0x90, 0xb0, 0x2b, 0xe7, 0xba, 0x8d,
+	0x4b, 0x88, 0x0a, 0x94, 0x42, 0x6f, 0x28, 0x25, 0x7d, 0xa0, 0xdb, 0xb8, 0x1e, 0x91, 0x01, 0xbb,
+	0x35, 0xa4, 0xc2, 0xa5, 0x73, 0x6c, 0x6a, 0x67, 0x17, 0x54, 0x8c, 0x42, 0x7b, 0x2c, 0x4d, 0xef,
+	0x97, 0x96, 0xa9, 0xc0, 0xa7, 0xc3, 0x04, 0x3e, 0xa0, 0x4c, 0x44, 0x44, 0xcd, 0x61, 0xa9, 0x47,
+	0xe4, 0xe5, 0xf3, 0xc9, 0x66, 0x72, 0xc4, 0xce, 0xb4, 0xbe, 0xda, 0xd5, 0x3e, 0xc4, 0xca, 0x69,
+	0x57, 0x6f, 0x3e, 0x2c, 0xad, 0x4c, 0x3f, 0x62, 0x3b, 0x9c, 0x7a, 0x9b, 0x10, 0x93, 0x23, 0x76,
+	0xe6, 0x6d, 0x40, 0xdf, 0x83, 0x8c, 0xd6, 0xb7, 0xb0, 0x69, 0x93, 0xbb, 0x77, 0x89, 0x8a, 0x5a,
+	0x0f, 0xbf, 0xf4, 0x84, 0x90, 0x5e, 0xbe, 0xb4, 0xc6, 0xcb, 0xe4, 0xee, 0x9a, 0x58, 0x35, 0x14,
+	0x7b, 0x68, 0x95, 0x2e, 0x4f, 0xbf, 0xbb, 0x32, 0x56, 0x8d, 0xc6, 0x90, 0xdc, 0x9b, 0x94, 0xc9,
+	0x8a, 0xdb, 0x29, 0x48, 0x9c, 0xab, 0xdd, 0x01, 0xde, 0x8b, 0xa7, 0xe3, 0x62, 0x62, 0x2f, 0x9e,
+	0x4e, 0x89, 0xe9, 0xbd, 0x78, 0x3a, 0x23, 0xc2, 0x5e, 0x3c, 0x0d, 0x62, 0x56, 0x7a, 0x12, 0xb2,
+	0x1e, 0xbb, 0x88, 0x4a, 0x90, 0xea, 0x61, 0xcb, 0x52, 0xdb, 0x98, 0x9a, 0xd1, 0x8c, 0xec, 0x54,
+	0xa5, 0x02, 0xe4, 0xbc, 0xb6, 0x50, 0xfa, 0x44, 0x80, 0xac, 0xc7, 0xcc, 0x11, 0xce, 0x73, 0x6c,
+	0xd2, 0xdd, 0xe0, 0x9c, 0xbc, 0x8a, 0x6e, 0x42, 0x9e, 0x6a, 0x52, 0x71, 0xfa, 0x89, 0xad, 0x8d,
+	0xcb, 0x39, 0xda, 0xf8, 0x80, 0x13, 0xad, 0x41, 0xd6, 0xd8, 0x32, 0x5c, 0x92, 0x18, 0x25, 0x01,
+	0x63, 0xcb, 0x70, 0x08, 0x6e, 0x40, 0x8e, 0xac, 0xd5, 0xa5, 0x88, 0xd3, 0x41, 0xb2, 0xa4, 0x8d,
+	0x93, 0x48, 0x7f, 0x8e, 0x82, 0x38, 0x6e, 0x3f, 0xd1, 0xcb, 0x10, 0x27, 0x2e, 0x8b, 0x7b, 0x85,
+	0xf2, 0x06, 0xf3, 0x67, 0x1b, 0x8e, 0x3f, 0xdb, 0x68, 0x38, 0xfe, 0x6c, 0x3b, 0xfd, 0xc5, 0x57,
+	0x6b, 0x91, 0x4f, 0xfe, 0xba, 0x26, 0xc8, 0x94, 0x03, 0x5d, 0x25, 0x56, 0x53, 0xd5, 0xfa, 0x8a,
+	0xd6, 0xa2, 0x53, 0xce, 0x10, 0x93, 0xa8, 0x6a, 0xfd, 0xdd, 0x16, 0xda, 0x07, 0xb1, 0xa9, 0xf7,
+	0x2d, 0xdc, 0xb7, 0x06, 0x96, 0xc2, 0x5c, 0x56, 0x29, 0x36, 0x69, 0xd1, 0x99, 0xbf, 0xad, 0x3a,
+	0x94, 0x47, 0x94, 0x50, 0x2e, 0x36, 0xfd, 0x0d, 0x68, 0x07, 0xc0, 0xf5, 0x6b, 0x56, 0x29, 0xbe,
+	0x1e, 0x0b, 0x3c, 0x24, 0x0f, 0x1c, 0x92, 0x13, 0xa3, 0xa5, 0xda, 0x78, 0x3b, 0x4e, 0xa6, 0x2b,
+	0x7b, 0x38, 0xd1, 0x13, 0x50, 0x54, 0x0d, 0x43, 0xb1, 0x6c, 0xd5, 0xc6, 0xca, 0xe9, 0x85, 0x8d,
+	0x2d, 0xea, 0x66, 0x72, 0x72, 0x5e, 0x35, 0x8c, 0x63, 0xd2, 0xba, 0x4d, 0x1a, 0xd1, 0xe3, 0x50,
+	0x20, 0x2e, 0x45, 0x53, 0xbb, 0x4a, 0x07, 0x6b, 0xed, 0x8e, 0x4d, 0xdd, 0x49, 0x4c, 0xce, 0xf3,
+	0xd6, 0x3a, 0x6d, 0x94, 0x5a, 0x90, 0xf3, 0xba, 0x13, 0x84, 0x20, 0xde, 0x52, 0x6d, 0x95, 0x6a,
+	0x32, 0x27, 0xd3, 0x32, 0x69, 0x33, 0x54, 0xbb, 0xc3, 0xf5, 0x43, 0xcb, 0xe8, 0x32, 0x24, 0xb9,
+	0xd8, 0x18, 0x15, 0xcb, 0x6b, 0x68, 0x05, 0x12, 0x86, 0xa9, 0x9f, 0x63, 0xba, 0x75, 0x69, 0x99,
+	0x55, 0x24, 0x19, 0x0a, 0x7e, 0xd7, 0x83, 0x0a, 0x10, 0xb5, 0x87, 0x7c, 0x94, 0xa8, 0x3d, 0x44,
+	0xcf, 0x41, 0x9c, 0x28, 0x92, 0x8e, 0x51, 0x08, 0x70, 0xb6, 0x9c, 0xaf, 0x71, 0x61, 0x60, 0x99,
+	0x52, 0x4a, 0x37, 0xa0, 0x38, 0x76, 0xa5, 0xc6, 0x85, 0x4a, 0x3b, 0x50, 0xf0, 0xdf, 0x1a, 0x74,
+	0x0d, 0x32, 0x3d, 0x75, 0xc8, 0xf5, 0x26, 0xd0, 0xf3, 0x97, 0xee, 0xa9, 0x43, 0xa6, 0xb2, 0x2b,
+	0x90, 0x22, 0x9d, 0x6d, 0xd5, 0xe2, 0xa7, 0x37, 0xd9, 0x53, 0x87, 0xaf, 0xab, 0x96, 0x54, 0x84,
+	0xbc, 0xcf, 0xfb, 0x49, 0x97, 0x61, 0x25, 0xc8, 0x99, 0x49, 0x1d, 0x58, 0x09, 0x72, 0x4a, 0xe8,
+	0x05, 0x48, 0xbb, 0xde, 0x8c, 0x9d, 0xd1, 0xab, 0x13, 0x2b, 0x74, 0x88, 0x65, 0x97, 0x94, 0x1c,
+	0x4e, 0xb2, 0xd7, 0x1d, 0x95, 0xc7, 0x2e, 0x39, 0x39, 0xa5, 0x1a, 0x46, 0x5d, 0xb5, 0x3a, 0xd2,
+	0xbb, 0x50, 0x0a, 0xf3, 0x54, 0x9e, 0xbd, 0x61, 0x2b, 0xe4, 0x35, 0xd2, 0x7e, 0xa6, 0x9b, 0x3d,
+	0xd5, 0xa6, 0xc2, 0xf2, 0x32, 0xaf, 0x91, 0x3d, 0x63, 0x5e, 0x2b, 0x46, 0x9b, 0x59, 0x45, 0x52,
+	0xe0, 0x6a, 0xa8, 0xb7, 0x22, 0x2c, 0x5a, 0xbf, 0x85, 0x99, 0xb2, 0xf3, 0x32, 0xab, 0x8c, 0x04,
+	0xb1, 0xc9, 0xb2, 0x0a, 0x19, 0xd6, 0xa2, 0x6b, 0xa5, 0xf2, 0x33, 0x32, 0xaf, 0x49, 0x9f, 0xc6,
+	0xe0, 0x72, 0xb0, 0xcf, 0x42, 0xeb, 0x90, 0x23, 0x3b, 0x61, 0x7b, 0x77, 0x2a, 0x26, 0x43, 0x4f,
+	0x1d, 0x36, 0xf8, 0x5e, 0x89, 0x10, 0x23, 0xc6, 0x32, 0xba, 0x1e, 0xbb, 0x95, 0x93, 0x49, 0x11,
+	0x9d, 0xc0, 0x52, 0x57, 0x6f, 0xaa, 0x5d, 0xa5, 0xab, 0x5a, 0xb6, 0xc2, 0x83, 0x19, 0x76, 0x5f,
+	0x6f, 0x4e, 0x28, 0x9b, 0x79, 0x1f, 0xdc, 0x62, 0xfb, 0x49, 0x6c, 0x1b, 0xbf, 0x6a, 0x45, 0x2a,
+	0x63, 0x5f, 0x75, 0xb6, 0x1a, 0xdd, 0x83, 0x6c, 0x4f, 0xb3, 0x4e, 0x71, 0x47, 0x3d, 0xd7, 0x74,
+	0x93, 0x5f, 0xdc, 0xc9, 0xf3, 0xf9, 0xc6, 0x88, 0x86, 0x4b, 0xf2, 0xb2, 0x79, 0xb6, 0x24, 0xe1,
+	0xbb, 0x2e, 0x8e, 0xe1, 0x4a, 0x2e, 0x6c, 0xb8, 0x9e, 0x83, 0x95, 0x3e, 0x1e, 0xda, 0xca, 0xc8,
+	0x34, 0xb0, 0x73, 0x92, 0xa2, 0xaa, 0x47, 0xa4, 0xcf, 0x35, 0x26, 0x16, 0x39, 0x32, 0xe8, 0x29,
+	0xea, 0xf5, 0x0d, 0xdd, 0xc2, 0xa6, 0xa2, 0xb6, 0x5a, 0x26, 0xb6, 0x2c, 0x1a, 0x28, 0xe6, 0xe4,
+	0xa2, 0xd3, 0x5e, 0x61, 0xcd, 0xd2, 0x2f, 0xbc, 0x5b, 0xe3, 0xf7, 0xf2, 0x5c, 0xf1, 0xc2, 0x48,
+	0xf1, 0xc7, 0xb0, 0xc2, 0xf9, 0x5b, 0x3e, 0xdd, 0xb3, 0x68, 0xfb, 0xda, 0xe4, 0x55, 0x1e, 0xd7,
+	0x39, 0x72, 0xd8, 0xc3, 0xd5, 0x1e, 0x7b, 0x34, 0xb5, 0x23, 0x88, 0x53, 0xa5, 0xc4, 0x99, 0x35,
+	0x23, 0xe5, 0xff, 0xb4, 0xad, 0xf8, 0x28, 0x06, 0x4b, 0x13, 0x21, 0x93, 0xbb, 0x30, 0x21, 0x70,
+	0x61, 0xd1, 0xc0, 0x85, 0xc5, 0x16, 0x5e, 0x18, 0xdf, 0xeb, 0xf8, 0xec, 0xbd, 0x4e, 0x7c, 0x87,
+	0x7b, 0x9d, 0x7c, 0xb4, 0xbd, 0xfe, 0xb7, 0xee, 0xc2, 0xaf, 0x05, 0x28, 0x87, 0xc7, 0x99, 0x81,
+	0xdb, 0xf1, 0x34, 0x2c, 0xb9, 0x53, 0x71, 0xc5, 0x33, 0xc3, 0x28, 0xba, 0x1d, 0x5c, 0x7e, 0xa8,
+	0x3b, 0x7d, 0x1c, 0x0a, 0x63, 0x51, 0x30, 0x3b, 0xca, 0xf9, 0x73, 0xef, 0xf8, 0xd2, 0xcf, 0x62,
+	0xb0, 0x12, 0x14, 0xaa, 0x06, 0xdc, 0xd6, 0x37, 0x61, 0xb9, 0x85, 0x9b, 0x5a, 0xeb, 0x51, 0x2f,
+	0xeb, 0x12, 0xe7, 0xfe, 0xdf, 0x5d, 0x9d, 0x3c, 0x25, 0xbf, 0xca, 0x42, 0x5a, 0xc6, 0x96, 0xa1,
+	0xf7, 0x2d, 0x8c, 0xb6, 0x21, 0x83, 0x87, 0x4d, 0x6c, 0xd8, 0x4e, 0xb4, 0x1c, 0x9c, 0x0c, 0x31,
+	0xea, 0x9a, 0x43, 0x49, 0xa0, 0x00, 0x97, 0x0d, 0xdd, 0xe1, 0x68, 0x47, 0x38, 0x70, 0xc1, 0xd9,
+	0xbd, 0x70, 0xc7, 0x8b, 0x0e, 0xdc, 0x11, 0x0b, 0xcd, 0xe4, 0x19, 0xd7, 0x18, 0xde, 0x71, 0x87,
+	0xe3, 0x1d, 0xf1, 0x19, 0x83, 0xf9, 0x00, 0x8f, 0xaa, 0x0f, 0xf0, 0x48, 0xce, 0x58, 0x66, 0x08,
+	0xe2, 0xf1, 0xa2, 0x83, 0x78, 0xa4, 0x66, 0xcc, 0x78, 0x0c, 0xf2, 0x78, 0xcd, 0x03, 0x79, 0x64,
+	0x42, 0xd3, 0x2e, 0xc6, 0x1a, 0x80, 0x79, 0xbc, 0xe2, 0x62, 0x1e, 0xb9, 0xd0, 0x9c, 0x8b, 0x33,
+	0x8f, 0x83, 0x1e, 0x87, 0x13, 0xa0, 0x07, 0x03, 0x29, 0x9e, 0x08, 0x15, 0x31, 0x03, 0xf5, 0x38,
+	0x9c, 0x40, 0x3d, 0x0a, 0x33, 0x04, 0xce, 0x80, 0x3d, 0x7e, 0x14, 0x0c, 0x7b, 0x84, 0x03, 0x13,
+	0x7c, 0x9a, 0xf3, 0xe1, 0x1e, 0x4a, 0x08, 0xee, 0x21, 0x86, 0xe6, 0xe8, 0x4c, 0xfc, 0xdc, 0xc0,
+	0xc7, 0x49, 0x00, 0xf0, 0xc1, 0x20, 0x8a, 0x5b, 0xa1, 0xc2, 0xe7, 0x40, 0x3e, 0x4e, 0x02, 0x90,
+	0x0f, 0x34, 0x53, 0xec, 0x4c, 0xe8, 0x63, 0xc7, 0x0f, 0x7d, 0x2c, 0x87, 0x44, 0x9d, 0xa3, 0xdb,
+	0x1e, 0x82, 0x7d, 0x9c, 0x86, 0x61, 0x1f, 0x0c, 0x9f, 0x78, 0x26, 0x54, 0xe2, 0x02, 0xe0, 0xc7,
+	0xe1, 0x04, 0xf8, 0x71, 0x69, 0xc6, 0x49, 0x9b, 0x81, 0x7e, 0x7c, 0xdf, 0x8b, 0x7e, 0x5c, 0x0e,
+	0x85, 0x3c, 0x1d, 0x0b, 0x10, 0x00, 0x7f, 0xbc, 0xe6, 0x81, 0x3f, 0xae, 0xcc, 0xb8, 0xc7, 0xd3,
+	0xf1, 0x8f, 0x84, 0x98, 0xdc, 0x8b, 0xa7, 0xd3, 0x62, 0x86, 0x21, 0x1f, 0x7b, 0xf1, 0x74, 0x56,
+	0xcc, 0x49, 0x4f, 0xc1, 0x92, 0xc3, 0xee, 0x1a, 0x5a, 0x92, 0xac, 0x60, 0xd3, 0xd4, 0x4d, 0x8e,
+	0x64, 0xb0, 0x8a, 0x74, 0x0b, 0x72, 0x2e, 0xe9, 0x74, 0xac, 0x84, 0x26, 0x85, 0x1e, 0x43, 0x2a,
+	0xfd, 0x41, 0x80, 0x9c, 0xd7, 0x46, 0xfa, 0x72, 0xe9, 0x0c, 0xcf, 0xa5, 0x3d, 0x08, 0x4a, 0xd4,
+	0x8f, 0xa0, 0xac, 0x41, 0x96, 0x24, 0x7b, 0x63, 0xe0, 0x88, 0x6a, 0xb8, 0xe0, 0xc8, 0x6d, 0x58,
+	0xa2, 0x1e, 0x9b, 0xe1, 0x2c, 0xdc, 0x2f, 0xc6, 0xa9, 0x5f, 0x2c, 0x92, 0x0e, 0xb6, 0x3d, 0xb4,
+	0x19, 0x3d, 0x0b, 0xcb, 0x1e, 0x5a, 0x37, 0x89, 0x64, 0x48, 0x81, 0xe8, 0x52, 0x57, 0x78, 0x36,
+	0xf9, 0x27, 0x01, 0x96, 0x26, 0x6c, 0x74, 0x20, 0x00, 0x22, 0x7c, 0x47, 0x00, 0x48, 0xf4, 0x91,
+	0x01, 0x10, 0x6f, 0x52, 0x1c, 0xf3, 0x27, 0xc5, 0xff, 0x14, 0x20, 0xef, 0x73, 0x15, 0x64, 0x0b,
+	0x9a, 0x7a, 0x0b, 0xf3, 0x34, 0x95, 0x96, 0x49, 0x4c, 0xd4, 0xd5, 0xdb, 0x3c, 0x19, 0x25, 0x45,
+	0x42, 0xe5, 0x7a, 0xbe, 0x0c, 0x77, 0x6c, 0x6e, 0x86, 0xcb, 0x22, 0x0f, 0x56, 0x21, 0xbc, 0x0f,
+	0x31, 0x43, 0xe6, 0x73, 0x32, 0x29, 0xa2, 0x15, 0x7e, 0xf8, 0x78, 0x04, 0xc1, 0x2a, 0xe8, 0x65,
+	0xc8, 0xd0, 0x27, 0x10, 0x45, 0x37, 0xac, 0x52, 0x7a, 0x32, 0xb6, 0x62, 0xcf, 0x24, 0x1b, 0x47,
+	0x84, 0xe6, 0xd0, 0xb0, 0xe4, 0xb4, 0xc1, 0x4b, 0x9e, 0x90, 0x27, 0xe3, 0x0b, 0x79, 0xae, 0x43,
+	0x86, 0xcc, 0xde, 0x32, 0xd4, 0x26, 0x2e, 0x01, 0x9d, 0xe8, 0xa8, 0x41, 0xfa, 0x7d, 0x14, 0x8a,
+	0xce, 0xca, 0x1d, 0x88, 0x25, 0x68, 0xed, 0xce, 0x91, 0x8c, 0x7a, 0xe0, 0x9d, 0xf9, 0xf4, 0xb1,
+	0x0a, 0xd0, 0x56, 0x2d, 0xe5, 0x03, 0xb5, 0x6f, 0xe3, 0x16, 0x57, 0x8a, 0xa7, 0x05, 0x95, 0x21,
+	0x4d, 0x6a, 0x03, 0x0b, 0xb7, 0x38, 0xd2, 0xe4, 0xd6, 0x51, 0x1d, 0x92, 0xf8, 0x1c, 0xf7, 0x6d,
+	0xab, 0x94, 0xa2, 0xdb, 0x7e, 0x79, 0x32, 0x1f, 0x27, 0xdd, 0xdb, 0x25, 0xb2, 0xd9, 0x7f, 0xff,
+	0x6a, 0x4d, 0x64, 0xd4, 0xcf, 0xe8, 0x3d, 0xcd, 0xc6, 0x3d, 0xc3, 0xbe, 0x90, 0x39, 0xbf, 0x5f,
+	0x0b, 0xe9, 0x31, 0x2d, 0x50, 0xcc, 0x33, 0xe7, 0xe0, 0x0b, 0x44, 0xa7, 0x9a, 0x6e, 0x6a, 0xf6,
+	0x85, 0x9c, 0xef, 0xe1, 0x9e, 0xa1, 0xeb, 0x5d, 0x85, 0xdd, 0xf1, 0x27, 0x40, 0x74, 0x74, 0xe5,
+	0x42, 0x47, 0x01, 0xca, 0x92, 0x6e, 0x42, 0x71, 0xcc, 0xea, 0x4c, 0xc6, 0xd3, 0x52, 0x05, 0x0a,
+	0x0e, 0x11, 0x0f, 0x87, 0x6f, 0x42, 0xde, 0xc4, 0x36, 0xc1, 0x14, 0x7d, 0x21, 0x7d, 0x8e, 0x35,
+	0xb2, 0x0b, 0xba, 0x17, 0x4f, 0x0b, 0x62, 0x74, 0x2f, 0x9e, 0x8e, 0x8a, 0x31, 0xe9, 0x08, 0x2e,
+	0x05, 0x46, 0x09, 0xe8, 0x25, 0xc8, 0x8c, 0x02, 0x0c, 0x61, 0x3d, 0x36, 0x1d, 0x37, 0x1a, 0xd1,
+	0x4a, 0x9f, 0x0b, 0x70, 0x29, 0x30, 0x4e, 0x40, 0x35, 0x48, 0x9a, 0xd8, 0x1a, 0x74, 0x19, 0x36,
+	0x54, 0xd8, 0x7a, 0x76, 0xbe, 0xf8, 0x82, 0xb4, 0x0e, 0xba, 0xb6, 0xcc, 0x99, 0xa5, 0x77, 0x20,
+	0xc9, 0x5a, 0x50, 0x16, 0x52, 0x27, 0x07, 0xf7, 0x0f, 0x0e, 0xdf, 0x3a, 0x10, 0x23, 0x08, 0x20,
+	0x59, 0xa9, 0x56, 0x6b, 0x47, 0x0d, 0x51, 0x40, 0x19, 0x48, 0x54, 0xb6, 0x0f, 0xe5, 0x86, 0x18,
+	0x25, 0xcd, 0x72, 0x6d, 0xaf, 0x56, 0x6d, 0x88, 0x31, 0xb4, 0x04, 0x79, 0x56, 0x56, 0x76, 0x0e,
+	0xe5, 0x37, 0x2a, 0x0d, 0x31, 0xee, 0x69, 0x3a, 0xae, 0x1d, 0xdc, 0xab, 0xc9, 0x62, 0x42, 0x7a,
+	0x1e, 0xae, 0x3a, 0xf3, 0x98, 0xc4, 0xb7, 0x5c, 0x98, 0x49, 0xf0, 0xc0, 0x4c, 0xd2, 0xa7, 0x51,
+	0x28, 0x3b, 0x3c, 0x01, 0x88, 0xd5, 0xde, 0xd8, 0xc2, 0xb7, 0x16, 0x88, 0x51, 0xc6, 0x56, 0x4f,
+	0xb2, 0x32, 0x13, 0x9f, 0x61, 0xbb, 0xd9, 0x61, 0x61, 0x0f, 0x33, 0x67, 0x79, 0x39, 0xcf, 0x5b,
+	0x29, 0x93, 0xc5, 0xc8, 0xde, 0xc3, 0x4d, 0x5b, 0x61, 0x27, 0xd2, 0xa2, 0xa9, 0x51, 0x46, 0xce,
+	0xb3, 0xd6, 0x63, 0xd6, 0x28, 0xbd, 0xbb, 0x90, 0x2e, 0x33, 0x90, 0x90, 0x6b, 0x0d, 0xf9, 0x6d,
+	0x31, 0x86, 0x10, 0x14, 0x68, 0x51, 0x39, 0x3e, 0xa8, 0x1c, 0x1d, 0xd7, 0x0f, 0x89, 0x2e, 0x97,
+	0xa1, 0xe8, 0xe8, 0xd2, 0x69, 0x4c, 0x48, 0x4f, 0xc3, 0x95, 0x90, 0x18, 0x29, 0xe0, 0x40, 0xff,
+	0x46, 0xf0, 0x52, 0xfb, 0xe3, 0x9c, 0x43, 0x48, 0x5a, 0xb6, 0x6a, 0x0f, 0x2c, 0xae, 0xc4, 0x97,
+	0xe6, 0x0d, 0x9a, 0x36, 0x9c, 0xc2, 0x31, 0x65, 0x97, 0xb9, 0x18, 0xe9, 0x05, 0x28, 0xf8, 0x7b,
+	0xc2, 0x75, 0x30, 0x3a, 0x44, 0x51, 0xe9, 0x2e, 0xa0, 0xc9, 0x58, 0x2a, 0x20, 0x59, 0x16, 0x82,
+	0x92, 0xe5, 0xdf, 0x09, 0x70, 0x6d, 0x4a, 0xdc, 0x84, 0xde, 0x1c, 0x5b, 0xe4, 0x2b, 0x8b, 0x44,
+	0x5d, 0x1b, 0xac, 0x6d, 0x6c, 0x99, 0x77, 0x20, 0xe7, 0x6d, 0x9f, 0x6f, 0x91, 0xbf, 0x8d, 0xc1,
+	0xa5, 0xc0, 0x10, 0xcc, 0x63, 0x4f, 0x85, 0x6f, 0x69, 0x4f, 0x5f, 0x05, 0xb0, 0x87, 0x0a, 0x3b,
+	0xd6, 0x8e, 0x53, 0x9e, 0xcc, 0xfc, 0x6a, 0x43, 0xdc, 0x6c, 0x0c, 0xf9, 0x25, 0xc8, 0xd8, 0xbc,
+	0x44, 0xd0, 0x20, 0x0f, 0xc4, 0x31, 0xa0, 0x0e, 0xdb, 0x2a, 0xc5, 0x16, 0xf2, 0xec, 0xe2, 0xb9,
+	0xbf, 0xd9, 0x42, 0x6f, 0xc3, 0x95, 0xb1, 0xa8, 0xc3, 0x15, 0x1d, 0x9f, 0x37, 0xf8, 0xb8, 0xe4,
+	0x0f, 0x3e, 0x1c, 0xd1, 0xde, 0xd0, 0x21, 0xe1, 0x0b, 0x1d, 0xd0, 0x16, 0xa4, 0x06, 0x46, 0xdb,
+	0x54, 0x5b, 0x98, 0x27, 0xa1, 0xa5, 0x89, 0x05, 0x9c, 0xb0, 0x7e, 0xd9, 0x21, 0xdc, 0x8b, 0xa7,
+	0x93, 0x62, 0x4a, 0x7a, 0x1e, 0x52, 0xbc, 0x87, 0x38, 0x91, 0xbe, 0xda, 0x73, 0x22, 0x45, 0x5a,
+	0x76, 0x7d, 0x69, 0x74, 0xe4, 0x4b, 0xa5, 0xb7, 0x01, 0x46, 0xb8, 0x0a, 0x31, 0x67, 0xa6, 0x3e,
+	0xe8, 0xb7, 0x28, 0x5b, 0x42, 0x66, 0x15, 0xf2, 0x0b, 0x80, 0x1c, 0x5b, 0x67, 0x53, 0x26, 0xed,
+	0x3e, 0x39, 0x76, 0x1e, 0x5c, 0x86, 0x51, 0x4b, 0x1a, 0xa0, 0x49, 0x6c, 0x3b, 0x64, 0x88, 0xd7,
+	0xfc, 0x43, 0xdc, 0x08, 0x45, 0xc9, 0x83, 0x87, 0xfa, 0x10, 0x12, 0xf4, 0x98, 0x91, 0x25, 0xd2,
+	0xb7, 0x1b, 0xbe, 0x6c, 0x52, 0x46, 0x3f, 0x06, 0x50, 0x6d, 0xdb, 0xd4, 0x4e, 0x07, 0xa3, 0x01,
+	0xd6, 0x82, 0x8f, 0x69, 0xc5, 0xa1, 0xdb, 0xbe, 0xce, 0xcf, 0xeb, 0xca, 0x88, 0xd5, 0x73, 0x66,
+	0x3d, 0x02, 0xa5, 0x03, 0x28, 0xf8, 0x79, 0x9d, 0xc8, 0x8c, 0xcd, 0xc1, 0x1f, 0x99, 0x31, 0xd5,
+	0xb3, 0xca, 0x28, 0xae, 0x8b, 0xb1, 0x07, 0x2a, 0x5a, 0x91, 0x7e, 0x12, 0x85, 0x9c, 0xf7, 0x94,
+	0xff, 0xf7, 0x05, 0x4f, 0xd2, 0xcf, 0x05, 0x48, 0xbb, 0xcb, 0xf7, 0x3f, 0x21, 0xf9, 0x9e, 0xf7,
+	0x98, 0xf6, 0xa2, 0xde, 0x77, 0x1f, 0xf6, 0xee, 0x16, 0x73, 0x1f, 0xf3, 0xee, 0xba, 0xbe, 0x36,
+	0x0c, 0x4b, 0xf2, 0xea, 0x9a, 0x9f, 0x2a, 0x27, 0xb4, 0xb8, 0x0b, 0x19, 0xd7, 0x54, 0x90, 0x74,
+	0xc9, 0xc1, 0xdc, 0x04, 0x7e, 0x61, 0x59, 0x95, 0xcc, 0xc4, 0xd0, 0x3f, 0xe0, 0x8f, 0x4a, 0x31,
+	0x99, 0x55, 0xa4, 0x16, 0x14, 0xc7, 0xec, 0x0c, 0xba, 0x0b, 0x29, 0x63, 0x70, 0xaa, 0x38, 0x87,
+	0x63, 0x0c, 0x99, 0x74, 0x02, 0xf1, 0xc1, 0x69, 0x57, 0x6b, 0xde, 0xc7, 0x17, 0xce, 0x64, 0x8c,
+	0xc1, 0xe9, 0x7d, 0x76, 0x86, 0xd8, 0x28, 0x51, 0xef, 0x28, 0xbf, 0x14, 0x20, 0xed, 0xdc, 0x09,
+	0xf4, 0xff, 0x90, 0x71, 0x6d, 0x98, 0xfb, 0x00, 0x1d, 0x6a, 0xfc, 0xb8, 0xfc, 0x11, 0x0b, 0xaa,
+	0x38, 0x2f, 0xe7, 0x5a, 0x4b, 0x39, 0xeb, 0xaa, 0xec, 0x2c, 0x15, 0xfc, 0x3a, 0x63, 0x56, 0x8e,
+	0x1a, 0xff, 0xdd, 0x7b, 0x3b, 0x5d, 0xb5, 0x2d, 0x67, 0x29, 0xcf, 0x6e, 0x8b, 0x54, 0x78, 0x18,
+	0xf9, 0x0f, 0x01, 0xc4, 0xf1, 0x1b, 0xfb, 0xad, 0x67, 0x37, 0xe9, 0x53, 0x63, 0x01, 0x3e, 0x15,
+	0x6d, 0xc2, 0xb2, 0x4b, 0xa1, 0x58, 0x5a, 0xbb, 0xaf, 0xda, 0x03, 0x13, 0x73, 0x2c, 0x17, 0xb9,
+	0x5d, 0xc7, 0x4e, 0xcf, 0xe4, 0xaa, 0x13, 0x8f, 0xb8, 0xea, 0x8f, 0xa2, 0x90, 0xf5, 0x20, 0xcb,
+	0xe8, 0xff, 0x3c, 0xc6, 0xa8, 0x10, 0xe0, 0x86, 0x3c, 0xb4, 0xa3, 0xc7, 0x64, 0xbf, 0x9a, 0xa2,
+	0x8b, 0xab, 0x29, 0x0c, 0xbf, 0x77, 0x80, 0xea, 0xf8, 0xc2, 0x40, 0xf5, 0x33, 0x80, 0x6c, 0xdd,
+	0x56, 0xbb, 0x04, 0x09, 0xd2, 0xfa, 0x6d, 0x85, 0x1d, 0x43, 0x66, 0x3a, 0x44, 0xda, 0xf3, 0x80,
+	0x76, 0x1c, 0xd1, 0x13, 0xf9, 0x53, 0x01, 0xd2, 0x6e, 0x8c, 0xbf, 0xe8, 0xfb, 0xef, 0x65, 0x48,
+	0xf2, 0x30, 0x96, 0x3d, 0x00, 0xf3, 0x5a, 0x20, 0x22, 0x5f, 0x86, 0x74, 0x0f, 0xdb, 0x2a, 0xb5,
+	0x83, 0xcc, 0x85, 0xba, 0xf5, 0xdb, 0xaf, 0x40, 0xd6, 0xf3, 0x4c, 0x4f, 0x4c, 0xe3, 0x41, 0xed,
+	0x2d, 0x31, 0x52, 0x4e, 0x7d, 0xfc, 0xd9, 0x7a, 0xec, 0x00, 0x7f, 0x40, 0x6e, 0xb3, 0x5c, 0xab,
+	0xd6, 0x6b, 0xd5, 0xfb, 0xa2, 0x50, 0xce, 0x7e, 0xfc, 0xd9, 0x7a, 0x4a, 0xc6, 0x14, 0x8c, 0xbd,
+	0x7d, 0x1f, 0x8a, 0x63, 0x1b, 0xe3, 0x8f, 0x91, 0x10, 0x14, 0xee, 0x9d, 0x1c, 0xed, 0xef, 0x56,
+	0x2b, 0x8d, 0x9a, 0xf2, 0xe0, 0xb0, 0x51, 0x13, 0x05, 0x74, 0x05, 0x96, 0xf7, 0x77, 0x5f, 0xaf,
+	0x37, 0x94, 0xea, 0xfe, 0x6e, 0xed, 0xa0, 0xa1, 0x54, 0x1a, 0x8d, 0x4a, 0xf5, 0xbe, 0x18, 0xdd,
+	0xfa, 0x63, 0x0e, 0xe2, 0x95, 0xed, 0xea, 0x2e, 0xaa, 0x42, 0x9c, 0x82, 0x38, 0x53, 0xbf, 0x09,
+	0x96, 0xa7, 0xc3, 0xea, 0x68, 0x07, 0x12, 0x14, 0xdf, 0x41, 0xd3, 0xff, 0x0d, 0x96, 0x67, 0xe0,
+	0xec, 0x64, 0x32, 0xf4, 0x46, 0x4e, 0xfd, 0x48, 0x58, 0x9e, 0x0e, 0xbb, 0xa3, 0x7d, 0x48, 0x39,
+	0xe9, 0xfd, 0xac, 0xdf, 0x7d, 0xe5, 0x99, 0x58, 0x38, 0x3a, 0x84, 0xb4, 0x9b, 0x00, 0xcf, 0xfc,
+	0xb0, 0x54, 0x9e, 0x0d, 0xea, 0x91, 0xe9, 0x39, 0x99, 0xf2, 0xac, 0x0f, 0x4c, 0xe5, 0x99, 0x10,
+	0x1f, 0xd1, 0x3c, 0x43, 0x71, 0xa6, 0x7f, 0x81, 0x2c, 0xcf, 0x78, 0x2f, 0x40, 0xbb, 0x90, 0xe4,
+	0xa9, 0xf9, 0x8c, 0x5f, 0x8d, 0xe5, 0x59, 0x2f, 0x00, 0x48, 0x86, 0xcc, 0x08, 0x1f, 0x9b, 0xfd,
+	0xb1, 0xb3, 0x3c, 0xc7, 0x53, 0x08, 0x7a, 0x07, 0xf2, 0xfe, 0xb4, 0x7f, 0xbe, 0x9f, 0x93, 0xe5,
+	0x39, 0xdf, 0x1a, 0x88, 0x7c, 0x3f, 0x06, 0x30, 0xdf, 0x4f, 0xca, 0xf2, 0x9c, 0x4f, 0x0f, 0xe8,
+	0x3d, 0x58, 0x9a, 0xcc, 0xd1, 0xe7, 0xff, 0x58, 0x59, 0x5e, 0xe0, 0x31, 0x02, 0xf5, 0x00, 0x05,
+	0xe4, 0xf6, 0x0b, 0xfc, 0xb3, 0x2c, 0x2f, 0xf2, 0x36, 0x81, 0x5a, 0x50, 0x1c, 0x4f, 0x98, 0xe7,
+	0xfd, 0x77, 0x59, 0x9e, 0xfb, 0x9d, 0x82, 0x8d, 0xe2, 0x4f, 0xb4, 0xe7, 0xfd, 0x87, 0x59, 0x9e,
+	0xfb, 0xd9, 0x02, 0x9d, 0x00, 0x78, 0x72, 0xe5, 0x39, 0xfe, 0x65, 0x96, 0xe7, 0x79, 0xc0, 0x40,
+	0x06, 0x2c, 0x07, 0x25, 0xd1, 0x8b, 0x7c, 0xd3, 0x2c, 0x2f, 0xf4, 0xae, 0x41, 0xce, 0xb3, 0x3f,
+	0x1d, 0x9e, 0xef, 0xdb, 0x66, 0x79, 0xce, 0x07, 0x8e, 0xed, 0xca, 0x17, 0x5f, 0xaf, 0x0a, 0x5f,
+	0x7e, 0xbd, 0x2a, 0xfc, 0xed, 0xeb, 0x55, 0xe1, 0x93, 0x6f, 0x56, 0x23, 0x5f, 0x7e, 0xb3, 0x1a,
+	0xf9, 0xcb, 0x37, 0xab, 0x91, 0x1f, 0x3c, 0xd9, 0xd6, 0xec, 0xce, 0xe0, 0x74, 0xa3, 0xa9, 0xf7,
+	0x36, 0x9b, 0x7a, 0x0f, 0xdb, 0xa7, 0x67, 0xf6, 0xa8, 0x30, 0xfa, 0x7d, 0x7f, 0x9a, 0xa4, 0x0e,
+	0xfe, 0xce, 0xbf, 0x06, 0x00, 0x58, 0x0a, 0x4a, 0x5c, 0x9d, 0x2f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -7314,6 +7383,18 @@ func (m *ResponseFinalizeBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.AppHash) > 0 {
 		i -= len(m.AppHash)
 		copy(dAtA[i:], m.AppHash)
@@ -7378,6 +7459,43 @@ func (m *ResponseFinalizeBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Upgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7871,12 +7989,12 @@ func (m *Misbehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n58, err58 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err58 != nil {
-		return 0, err58
+	n59, err59 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err59 != nil {
+		return 0, err59
 	}
-	i -= n58
-	i = encodeVarintTypes(dAtA, i, uint64(n58))
+	i -= n59
+	i = encodeVarintTypes(dAtA, i, uint64(n59))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -9188,6 +9306,27 @@ func (m *ResponseFinalizeBlock) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -15895,6 +16034,156 @@ func (m *ResponseFinalizeBlock) Unmarshal(dAtA []byte) error {
 				m.AppHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -310,7 +310,13 @@ func (r *Reactor) respondToPeer(msg *bcproto.BlockRequest, src p2p.Peer) {
 	if state.ConsensusParams.ABCI.VoteExtensionsEnabled(msg.Height) {
 		extCommit = r.store.LoadBlockExtendedCommit(msg.Height)
 		if extCommit == nil {
+			// the extended commit may have been pruned, see
+			// BlockStore.PruneExtendedCommits
 			r.Logger.Error("Found block in store with no extended commit", "height", msg.Height)
+			src.TrySend(p2p.Envelope{
+				ChannelID: BlocksyncChannel,
+				Message:   &bcproto.NoBlockResponse{Height: msg.Height},
+			})
 			return
 		}
 	}
@@ -484,6 +490,10 @@ func (r *Reactor) poolRoutine(stateSynced bool) {
 
 		didProcessCh = make(chan struct{}, 1)
 
+		// set once the application requires an upgrade after the last block,
+		// so that consensus halts after it
+		upgradeRequired = false
+
 		// metrics tracking
 		blocksSynced = 0
 		lastHundred  = time.Now()
@@ -548,7 +558,7 @@ FOR_LOOP:
 			}
 
 			// keep syncing
-			if !r.pool.IsCaughtUp() && !r.localNodeBlocksTheChain(state) {
+			if !upgradeRequired && !r.pool.IsCaughtUp() && !r.localNodeBlocksTheChain(state) {
 				continue FOR_LOOP
 			}
 
@@ -586,6 +596,10 @@ FOR_LOOP:
 			// coupling them as it's written here.  TODO uncouple from request
 			// routine.
 
+			if upgradeRequired {
+				continue FOR_LOOP
+			}
+
 			// See if there are any blocks to sync.
 			first, second, extCommit := r.pool.PeekTwoBlocks()
 			if first == nil || second == nil {
@@ -613,7 +627,7 @@ FOR_LOOP:
 			// Try again quickly next loop.
 			didProcessCh <- struct{}{}
 
-			firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
+			firstParts, err := first.MakePartSetForHeader(types.BlockPartSizeBytes, second.LastCommit.BlockID.PartSetHeader)
 			if err != nil {
 				r.Logger.Error("Failed to make part set", "height", first.Height, "err", err.Error())
 				break FOR_LOOP
@@ -636,17 +650,25 @@ FOR_LOOP:
 			}
 
 			// Fully verify second.LastCommit to ensure all signatures are valid.
-			err = state.Validators.VerifyCommit(chainID, firstID, first.Height, second.LastCommit)
+			err = state.Validators.VerifyCommitWithCache(chainID, firstID, first.Height, second.LastCommit,
+				r.blockExec.SignatureCache())
 			if err != nil {
 				r.handleValidationFailure(first, second, err)
 				continue FOR_LOOP
 			}
 
+			// Verify the proposer of the round first was decided in.
+			if err = state.VerifyProposer(first, second.LastCommit.Round); err != nil {
+				r.handleValidationFailure(first, second, err)
+				continue FOR_LOOP
+			}
+
 			// Fully verify extended commit if present
 			if extensionsEnabled {
 				// if vote extensions were required at this height, verify all
 				// signatures in the extended commit since it is persisted to the store.
-				if err = state.Validators.VerifyCommitExtended(chainID, firstID, first.Height, extCommit); err != nil {
+				if err = state.Validators.VerifyCommitExtendedWithCache(chainID, firstID, first.Height, extCommit,
+					r.blockExec.SignatureCache()); err != nil {
 					r.handleValidationFailure(first, second, err)
 					continue FOR_LOOP
 				}
@@ -694,6 +716,11 @@ FOR_LOOP:
 			r.metrics.recordBlockMetrics(first)
 			blocksSynced++
 
+			if r.blockExec.GetUpgrade(first.Height) != nil {
+				r.Logger.Info("Application requires an upgrade. Switching to consensus", "height", first.Height)
+				upgradeRequired = true
+			}
+
 			if blocksSynced%100 == 0 {
 				lastRate = 0.9*lastRate + 0.1*(100/time.Since(lastHundred).Seconds())
 				lastHundred = time.Now()
@@ -100,7 +100,7 @@ func (r *Reactor) blockIngestorRoutine(blockIngestor BlockIngestor) {
 				return
 			}
 
-			blockParts, err := block.MakePartSet(types.BlockPartSizeBytes)
+			blockParts, err := block.MakePartSetForHeader(types.BlockPartSizeBytes, nextBlock.LastCommit.BlockID.PartSetHeader)
 			if err != nil {
 				// should not happen
 				r.Logger.Error("Failed to make part set. Halting blocksync", "height", block.Height, "err", err)
@@ -122,7 +122,7 @@ func (r *Reactor) blockIngestorRoutine(blockIngestor BlockIngestor) {
 			}
 
 			// ... and verify it against the state
-			if err := ic.Verify(state); err != nil {
+			if err := ic.VerifyWithCache(state, r.blockExec.SignatureCache()); err != nil {
 				r.handleValidationFailure(block, nextBlock, fmt.Errorf("verify ingest candidate: %w", err))
 				continue
 			}
@@ -9,16 +9,16 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 
-	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/progressbar"
 	"github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
+	blockidx "github.com/cometbft/cometbft/state/indexer/block"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/state/indexer/sink/psql"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlite"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/kv"
-	"github.com/cometbft/cometbft/types"
 )
 
 const (
@@ -103,6 +103,11 @@ func init() {
 }
 
 func loadEventSinks(cfg *cmtcfg.Config, chainID string) (indexer.BlockIndexer, txindex.TxIndexer, error) {
+	filter, err := blockidx.AttributeFilterFromConfig(cfg.TxIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	switch strings.ToLower(cfg.TxIndex.Indexer) {
 	case "null":
 		return nil, nil, errors.New("found null event sink, please check the tx-index section in the config.toml")
@@ -111,7 +116,13 @@ func loadEventSinks(cfg *cmtcfg.Config, chainID string) (indexer.BlockIndexer, t
 		if conn == "" {
 			return nil, nil, errors.New("the psql connection settings cannot be empty")
 		}
-		es, err := psql.NewEventSink(conn, chainID)
+		es, err := psql.NewEventSink(conn, chainID, psql.WithAttributeFilter(filter))
+		if err != nil {
+			return nil, nil, err
+		}
+		return es.BlockIndexer(), es.TxIndexer(), nil
+	case "sqlite":
+		es, err := sqlite.NewEventSink(cfg.TxIndex.SqliteFile(), chainID, sqlite.WithAttributeFilter(filter))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -122,8 +133,8 @@ func loadEventSinks(cfg *cmtcfg.Config, chainID string) (indexer.BlockIndexer, t
 			return nil, nil, err
 		}
 
-		txIndexer := kv.NewTxIndex(store)
-		blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+		txIndexer := kv.NewTxIndex(store, kv.WithAttributeFilter(filter))
+		blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")), blockidxkv.WithAttributeFilter(filter))
 		return blockIndexer, txIndexer, nil
 	default:
 		return nil, nil, fmt.Errorf("unsupported event sink type: %s", cfg.TxIndex.Indexer)
@@ -150,47 +161,9 @@ func eventReIndex(cmd *cobra.Command, args eventReIndexArgs) error {
 		case <-cmd.Context().Done():
 			return fmt.Errorf("event re-index terminated at height %d: %w", height, cmd.Context().Err())
 		default:
-			block := args.blockStore.LoadBlock(height)
-			if block == nil {
-				return fmt.Errorf("not able to load block at height %d from the blockstore", height)
-			}
-
-			resp, err := args.stateStore.LoadFinalizeBlockResponse(height)
+			err := txindex.IndexHeight(height, args.blockStore, args.stateStore, args.txIndexer, args.blockIndexer)
 			if err != nil {
-				return fmt.Errorf("not able to load ABCI Response at height %d from the statestore", height)
-			}
-
-			e := types.EventDataNewBlockEvents{
-				Height: height,
-				Events: resp.Events,
-			}
-
-			numTxs := len(resp.TxResults)
-
-			var batch *txindex.Batch
-			if numTxs > 0 {
-				batch = txindex.NewBatch(int64(numTxs))
-
-				for idx, txResult := range resp.TxResults {
-					tr := abcitypes.TxResult{
-						Height: height,
-						Index:  uint32(idx),
-						Tx:     block.Txs[idx],
-						Result: *txResult,
-					}
-
-					if err = batch.Add(&tr); err != nil {
-						return fmt.Errorf("adding tx to batch: %w", err)
-					}
-				}
-
-				if err := args.txIndexer.AddBatch(batch); err != nil {
-					return fmt.Errorf("tx event re-index at height %d failed: %w", height, err)
-				}
-			}
-
-			if err := args.blockIndexer.Index(e); err != nil {
-				return fmt.Errorf("block event re-index at height %d failed: %w", height, err)
+				return err
 			}
 		}
 
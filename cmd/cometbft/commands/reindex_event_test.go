@@ -91,11 +91,12 @@ func TestLoadEventSink(t *testing.T) {
 		{"KV", "", false},
 		{"PSQL", "", true}, // true because empty connect url
 		// skip to test PSQL connect with correct url
+		{"SQLITE", "", false},
 		{"UnsupportedSinkType", "wrongUrl", true},
 	}
 
 	for idx, tc := range testCases {
-		cfg := cmtcfg.TestConfig()
+		cfg := cmtcfg.TestConfig().SetRoot(t.TempDir())
 		cfg.TxIndex.Indexer = tc.sinks
 		cfg.TxIndex.PsqlConn = tc.connURL
 		_, _, err := loadEventSinks(cfg, test.DefaultTestChainID)
@@ -105,6 +106,12 @@ func TestLoadEventSink(t *testing.T) {
 			require.NoError(t, err, idx)
 		}
 	}
+
+	// an invalid attribute filter is rejected
+	cfg := cmtcfg.TestConfig().SetRoot(t.TempDir())
+	cfg.TxIndex.DenyKeys = []string{"transfer.[a"}
+	_, _, err := loadEventSinks(cfg, test.DefaultTestChainID)
+	require.Error(t, err)
 }
 
 func TestLoadBlockStore(t *testing.T) {
@@ -3,6 +3,7 @@ package commands
 import (
 	"bytes"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -10,10 +11,30 @@ import (
 	"github.com/spf13/cobra"
 
 	cfg "github.com/cometbft/cometbft/config"
+	cs "github.com/cometbft/cometbft/consensus"
 	cmtos "github.com/cometbft/cometbft/libs/os"
 	nm "github.com/cometbft/cometbft/node"
 )
 
+// HaltExitCode is the exit status of the start command once consensus halted,
+// see the halt_height and halt_time options of the consensus config, and the
+// upgrade of the FinalizeBlock response.
+const HaltExitCode = 3
+
+// ErrNodeHalted is returned by the start command when the node refuses to
+// start or stopped because consensus halted. The command exits with
+// HaltExitCode.
+type ErrNodeHalted struct {
+	Err cs.ErrHalted
+}
+
+func (e ErrNodeHalted) Error() string { return e.Err.Error() }
+
+func (e ErrNodeHalted) Unwrap() error { return e.Err }
+
+// ExitCode implements cli.ExitCoder.
+func (ErrNodeHalted) ExitCode() int { return HaltExitCode }
+
 var genesisHash []byte
 
 // AddNodeFlags exposes some common configuration options on the command-line
@@ -78,6 +99,14 @@ func AddNodeFlags(cmd *cobra.Command) {
 		"consensus.create_empty_blocks_interval",
 		config.Consensus.CreateEmptyBlocksInterval.String(),
 		"the possible interval between empty blocks")
+	cmd.Flags().Int64(
+		"consensus.halt_height",
+		config.Consensus.HaltHeight,
+		"height after which consensus halts (0 to disable)")
+	cmd.Flags().Int64(
+		"consensus.halt_time",
+		config.Consensus.HaltTime,
+		"unix time in seconds after which consensus halts (0 to disable)")
 
 	// db flags
 	cmd.Flags().String(
@@ -103,6 +132,10 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 			}
 
 			n, err := nodeProvider(config, logger)
+			if errHalted := (cs.ErrHalted{}); errors.As(err, &errHalted) {
+				logger.Error("Refusing to start the node", "err", errHalted)
+				return ErrNodeHalted{Err: errHalted}
+			}
 			if err != nil {
 				return fmt.Errorf("failed to create node: %w", err)
 			}
@@ -120,10 +153,21 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 						logger.Error("unable to stop the node", "error", err)
 					}
 				}
+				if n.ConsensusState().GetHalt() != nil {
+					os.Exit(HaltExitCode)
+				}
 			})
 
-			// Run forever.
-			select {}
+			// Stop once consensus halted.
+			<-n.ConsensusState().Halted()
+			halt := n.ConsensusState().GetHalt()
+			logger.Info("Stopping the node", "reason", halt)
+			if n.IsRunning() {
+				if err := n.Stop(); err != nil {
+					logger.Error("unable to stop the node", "error", err)
+				}
+			}
+			return ErrNodeHalted{Err: cs.ErrHalted{Halt: *halt}}
 		},
 	}
 
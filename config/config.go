@@ -4,10 +4,13 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -51,6 +54,12 @@ const (
 	LibP2PLimitsModeDefault  = "default"
 	LibP2PLimitsModeCustom   = "custom"
 
+	LibP2PTransportQUIC = "quic"
+	LibP2PTransportTCP  = "tcp"
+
+	LibP2PSecurityNoise = "noise"
+	LibP2PSecurityTLS   = "tls"
+
 	v0 = "v0"
 	v1 = "v1"
 	v2 = "v2"
@@ -92,6 +101,7 @@ type Config struct {
 	Consensus       *ConsensusConfig       `mapstructure:"consensus"`
 	Storage         *StorageConfig         `mapstructure:"storage"`
 	TxIndex         *TxIndexConfig         `mapstructure:"tx_index"`
+	ValidatorStats  *ValidatorStatsConfig  `mapstructure:"validator_stats"`
 	Instrumentation *InstrumentationConfig `mapstructure:"instrumentation"`
 }
 
@@ -107,6 +117,7 @@ func DefaultConfig() *Config {
 		Consensus:       DefaultConsensusConfig(),
 		Storage:         DefaultStorageConfig(),
 		TxIndex:         DefaultTxIndexConfig(),
+		ValidatorStats:  DefaultValidatorStatsConfig(),
 		Instrumentation: DefaultInstrumentationConfig(),
 	}
 }
@@ -123,6 +134,7 @@ func TestConfig() *Config {
 		Consensus:       TestConsensusConfig(),
 		Storage:         TestStorageConfig(),
 		TxIndex:         TestTxIndexConfig(),
+		ValidatorStats:  TestValidatorStatsConfig(),
 		Instrumentation: TestInstrumentationConfig(),
 	}
 }
@@ -134,6 +146,7 @@ func (cfg *Config) SetRoot(root string) *Config {
 	cfg.P2P.RootDir = root
 	cfg.Mempool.RootDir = root
 	cfg.Consensus.RootDir = root
+	cfg.TxIndex.RootDir = root
 	return cfg
 }
 
@@ -161,12 +174,24 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return ErrInSection{Section: "consensus", Err: err}
 	}
+	if err := cfg.Storage.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "storage", Err: err}
+	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "tx_index", Err: err}
+	}
+	if err := cfg.ValidatorStats.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "validator_stats", Err: err}
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return ErrInSection{Section: "instrumentation", Err: err}
 	}
 	if !cfg.Consensus.CreateEmptyBlocks && cfg.Mempool.Type == MempoolTypeNop {
 		return fmt.Errorf("`nop` mempool does not support create_empty_blocks = false")
 	}
+	if cfg.Consensus.CompactBlocks && cfg.Mempool.Type != MempoolTypeFlood {
+		return fmt.Errorf("compact_blocks require the `flood` mempool, got `%s`", cfg.Mempool.Type)
+	}
 	return nil
 }
 
@@ -637,11 +662,32 @@ type LibP2PConfig struct {
 	// BootstrapPeers list of peers to bootstrap the libp2p host
 	BootstrapPeers []LibP2PBootstrapPeer `mapstructure:"bootstrap_peers"`
 
+	// Transports enabled transports ("quic", "tcp"), in dial preference order.
+	// The node listens on p2p.laddr with each of them.
+	Transports []string `mapstructure:"transports"`
+
+	// Security protocols of the TCP transport ("noise", "tls"), in preference order.
+	// Connections are multiplexed with yamux.
+	Security []string `mapstructure:"security"`
+
+	// ListenAddresses additional multiaddrs to listen on
+	// (e.g. "/ip6/::/udp/26656/quic-v1").
+	ListenAddresses []string `mapstructure:"listen_addresses"`
+
 	// Scaler optional configuration for reactor queue auto scaling
 	Scaler LibP2PScaler `mapstructure:"scaler"`
 
 	// Limits configuration for libp2p resource manager.
 	Limits LibP2PLimits `mapstructure:"limits"`
+
+	// Scoring configuration for misbehaving peers.
+	Scoring LibP2PScoring `mapstructure:"scoring"`
+
+	// Bandwidth rate limits of the libp2p streams.
+	Bandwidth LibP2PBandwidth `mapstructure:"bandwidth"`
+
+	// Bridge configuration for running the legacy and libp2p stacks simultaneously.
+	Bridge LibP2PBridge `mapstructure:"bridge"`
 }
 
 // LibP2PBootstrapPeer is a bootstrap peer for this node
@@ -700,6 +746,71 @@ type LibP2PLimits struct {
 	MaxPeers int `mapstructure:"max_peers"`
 	// MaxPeerStreams caps the number of concurrent streams per peer. Only used when mode is custom.
 	MaxPeerStreams int `mapstructure:"max_peer_streams"`
+	// MaxInboundPeers caps the number of inbound peers. 0 means only MaxPeers applies. Only used when mode is custom.
+	MaxInboundPeers int `mapstructure:"max_inbound_peers"`
+	// MaxOutboundPeers caps the number of outbound peers. 0 means only MaxPeers applies. Only used when mode is custom.
+	MaxOutboundPeers int `mapstructure:"max_outbound_peers"`
+	// ReservedPeerSlots number of MaxPeers slots only persistent peers can use. Only used when mode is custom.
+	ReservedPeerSlots int `mapstructure:"reserved_peer_slots"`
+	// MaxPeersPerIP caps the number of peers connected from the same IP. 0 disables the cap.
+	// Only used when mode is custom.
+	MaxPeersPerIP int `mapstructure:"max_peers_per_ip"`
+	// MaxPeersPerSubnet caps the number of peers connected from the same /24 (IPv4) or /64 (IPv6) subnet.
+	// 0 disables the cap. Only used when mode is custom.
+	MaxPeersPerSubnet int `mapstructure:"max_peers_per_subnet"`
+}
+
+// LibP2PScoring parameters for scoring and banning misbehaving peers.
+// Each error a peer is disconnected for adds a penalty to its score, which
+// decays over time. A peer whose score reaches the ban threshold is banned
+// for the ban duration. Unconditional peers are never banned.
+type LibP2PScoring struct {
+	// BanThreshold score at which a peer is banned. 0 disables banning.
+	BanThreshold float64 `mapstructure:"ban_threshold"`
+	// ErrorPenalty penalty for an error reported by a reactor (e.g. invalid vote or block).
+	ErrorPenalty float64 `mapstructure:"error_penalty"`
+	// MalformedPenalty penalty for a malformed or oversized message.
+	MalformedPenalty float64 `mapstructure:"malformed_penalty"`
+	// DecayHalfLife time after which a score is halved.
+	DecayHalfLife time.Duration `mapstructure:"decay_half_life"`
+	// BanDuration duration of a ban.
+	BanDuration time.Duration `mapstructure:"ban_duration"`
+}
+
+// LibP2PBandwidth rate limits of the libp2p streams, in bytes per second.
+// Peer rates limit the traffic with each peer; channel rates limit the traffic
+// of a channel with each peer, so that e.g. mempool gossip can't starve
+// consensus traffic. 0 means unlimited.
+type LibP2PBandwidth struct {
+	// SendRate rate at which data can be sent to each peer.
+	SendRate int64 `mapstructure:"send_rate"`
+	// RecvRate rate at which data can be received from each peer.
+	RecvRate int64 `mapstructure:"recv_rate"`
+	// Channels per-channel rate limits.
+	Channels []LibP2PChannelBandwidth `mapstructure:"channels"`
+}
+
+// LibP2PChannelBandwidth rate limits of a specific channel, in bytes per second.
+type LibP2PChannelBandwidth struct {
+	// Channel ID (e.g. 0x30 for mempool).
+	Channel int `mapstructure:"channel"`
+	// SendRate rate at which data can be sent to each peer on the channel.
+	SendRate int64 `mapstructure:"send_rate"`
+	// RecvRate rate at which data can be received from each peer on the channel.
+	RecvRate int64 `mapstructure:"recv_rate"`
+}
+
+// LibP2PBridge configures the migration bridge. When enabled, the node runs the
+// legacy (MConnection) stack on p2p.laddr and the libp2p stack on bridge.laddr,
+// so that it can talk to both legacy and migrated nodes.
+type LibP2PBridge struct {
+	// Enabled set true to run both stacks
+	Enabled bool `mapstructure:"enabled"`
+	// ListenAddress address to listen for libp2p connections on.
+	// Must differ from p2p.laddr.
+	ListenAddress string `mapstructure:"laddr"`
+	// ExternalAddress address to advertise to libp2p peers.
+	ExternalAddress string `mapstructure:"external_address"`
 }
 
 // DefaultP2PConfig returns a default configuration for the peer-to-peer layer
@@ -766,7 +877,12 @@ func (cfg *P2PConfig) ValidateBasic() error {
 		return cmterrors.ErrNegativeField{Field: "recv_rate"}
 	}
 	if cfg.LibP2PEnabled() {
-		return cfg.LibP2PConfig.ValidateBasic()
+		if err := cfg.LibP2PConfig.ValidateBasic(); err != nil {
+			return err
+		}
+		if cfg.LibP2PBridgeEnabled() && cfg.LibP2PConfig.Bridge.ListenAddress == cfg.ListenAddress {
+			return cmterrors.ErrInvalidField{Field: "p2p.libp2p.bridge.laddr", Reason: "must differ from p2p.laddr"}
+		}
 	}
 
 	return nil
@@ -776,12 +892,43 @@ func (cfg *P2PConfig) LibP2PEnabled() bool {
 	return cfg.LibP2PConfig.Enabled
 }
 
+// LibP2PBridgeEnabled returns true if the node runs both the legacy and libp2p stacks.
+func (cfg *P2PConfig) LibP2PBridgeEnabled() bool {
+	return cfg.LibP2PConfig.Enabled && cfg.LibP2PConfig.Bridge.Enabled
+}
+
+// LibP2PListenAddress returns the address the libp2p host listens on:
+// bridge.laddr in bridge mode, p2p.laddr otherwise.
+func (cfg *P2PConfig) LibP2PListenAddress() string {
+	if cfg.LibP2PBridgeEnabled() {
+		return cfg.LibP2PConfig.Bridge.ListenAddress
+	}
+
+	return cfg.ListenAddress
+}
+
+// LibP2PExternalAddress returns the address the libp2p host advertises:
+// bridge.external_address in bridge mode, p2p.external_address otherwise.
+func (cfg *P2PConfig) LibP2PExternalAddress() string {
+	if cfg.LibP2PBridgeEnabled() {
+		return cfg.LibP2PConfig.Bridge.ExternalAddress
+	}
+
+	return cfg.ExternalAddress
+}
+
 func DefaultLibP2PConfig() LibP2PConfig {
 	return LibP2PConfig{
-		Enabled:        false,
-		BootstrapPeers: []LibP2PBootstrapPeer{},
-		Scaler:         DefaultLibP2PScaler(),
-		Limits:         DefaultLibP2PLimits(),
+		Enabled:         false,
+		BootstrapPeers:  []LibP2PBootstrapPeer{},
+		Transports:      []string{LibP2PTransportQUIC},
+		Security:        []string{LibP2PSecurityNoise, LibP2PSecurityTLS},
+		ListenAddresses: []string{},
+		Scaler:          DefaultLibP2PScaler(),
+		Limits:          DefaultLibP2PLimits(),
+		Scoring:         DefaultLibP2PScoring(),
+		Bandwidth:       DefaultLibP2PBandwidth(),
+		Bridge:          DefaultLibP2PBridge(),
 	}
 }
 
@@ -800,16 +947,67 @@ func (cfg *LibP2PConfig) ValidateBasic() error {
 		}
 	}
 
-	// 2. validate scaler
+	// 2. validate transports
+	if len(cfg.Transports) == 0 {
+		return cmterrors.ErrRequiredField{Field: key("transports")}
+	}
+	if err := validateLibP2PList(cfg.Transports, key("transports"), LibP2PTransportQUIC, LibP2PTransportTCP); err != nil {
+		return err
+	}
+	if slices.Contains(cfg.Transports, LibP2PTransportTCP) && len(cfg.Security) == 0 {
+		return cmterrors.ErrRequiredField{Field: key("security")}
+	}
+	if err := validateLibP2PList(cfg.Security, key("security"), LibP2PSecurityNoise, LibP2PSecurityTLS); err != nil {
+		return err
+	}
+	for i, addr := range cfg.ListenAddresses {
+		if !strings.HasPrefix(addr, "/") {
+			return cmterrors.ErrInvalidField{Field: key("listen_addresses.%d", i), Reason: "must be a multiaddr"}
+		}
+	}
+
+	// 3. validate scaler
 	if err := cfg.Scaler.ValidateBasic(); err != nil {
 		return err
 	}
 
-	// 3. validate limits
+	// 4. validate limits
 	if err := cfg.Limits.ValidateBasic(); err != nil {
 		return err
 	}
 
+	// 5. validate scoring
+	if err := cfg.Scoring.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// 6. validate bandwidth
+	if err := cfg.Bandwidth.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// 7. validate bridge
+	if cfg.Bridge.Enabled && cfg.Bridge.ListenAddress == "" {
+		return cmterrors.ErrRequiredField{Field: key("bridge.laddr")}
+	}
+
+	return nil
+}
+
+// validateLibP2PList checks that the list holds distinct allowed values.
+func validateLibP2PList(list []string, field string, allowed ...string) error {
+	for i, item := range list {
+		if !slices.Contains(allowed, item) {
+			return cmterrors.ErrInvalidField{
+				Field:  fmt.Sprintf("%s.%d", field, i),
+				Reason: "must be one of: " + strings.Join(allowed, ", "),
+			}
+		}
+		if slices.Contains(list[:i], item) {
+			return cmterrors.ErrInvalidField{Field: fmt.Sprintf("%s.%d", field, i), Reason: "duplicate " + item}
+		}
+	}
+
 	return nil
 }
 
@@ -869,6 +1067,109 @@ func (s *LibP2PScaler) ValidateBasic() error {
 	return nil
 }
 
+func DefaultLibP2PScoring() LibP2PScoring {
+	return LibP2PScoring{
+		BanThreshold:     100,
+		ErrorPenalty:     25,
+		MalformedPenalty: 50,
+		DecayHalfLife:    10 * time.Minute,
+		BanDuration:      time.Hour,
+	}
+}
+
+// Enabled returns true if misbehaving peers are banned.
+func (s *LibP2PScoring) Enabled() bool {
+	return s.BanThreshold > 0
+}
+
+func (s *LibP2PScoring) ValidateBasic() error {
+	key := func(field string) string {
+		return "p2p.libp2p.scoring." + field
+	}
+
+	switch {
+	case s.BanThreshold < 0:
+		return cmterrors.ErrNegativeField{Field: key("ban_threshold")}
+	case s.ErrorPenalty < 0:
+		return cmterrors.ErrNegativeField{Field: key("error_penalty")}
+	case s.MalformedPenalty < 0:
+		return cmterrors.ErrNegativeField{Field: key("malformed_penalty")}
+	case s.DecayHalfLife < 0:
+		return cmterrors.ErrNegativeField{Field: key("decay_half_life")}
+	case s.BanDuration < 0:
+		return cmterrors.ErrNegativeField{Field: key("ban_duration")}
+	case s.Enabled() && s.BanDuration == 0:
+		return cmterrors.ErrRequiredField{Field: key("ban_duration")}
+	}
+
+	return nil
+}
+
+func DefaultLibP2PBandwidth() LibP2PBandwidth {
+	return LibP2PBandwidth{
+		SendRate: 0,
+		RecvRate: 0,
+		Channels: []LibP2PChannelBandwidth{},
+	}
+}
+
+// Enabled returns true if any rate limit is set.
+func (b *LibP2PBandwidth) Enabled() bool {
+	if b.SendRate > 0 || b.RecvRate > 0 {
+		return true
+	}
+
+	for _, ch := range b.Channels {
+		if ch.SendRate > 0 || ch.RecvRate > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *LibP2PBandwidth) ValidateBasic() error {
+	key := func(msg string, args ...any) string {
+		return fmt.Sprintf("p2p.libp2p.bandwidth.%s", fmt.Sprintf(msg, args...))
+	}
+
+	switch {
+	case b.SendRate < 0:
+		return cmterrors.ErrNegativeField{Field: key("send_rate")}
+	case b.RecvRate < 0:
+		return cmterrors.ErrNegativeField{Field: key("recv_rate")}
+	}
+
+	seen := make(map[int]struct{}, len(b.Channels))
+
+	for i, ch := range b.Channels {
+		if _, ok := seen[ch.Channel]; ok {
+			return cmterrors.ErrInvalidField{Field: key("channels.%d.channel", i), Reason: "must be unique"}
+		}
+
+		seen[ch.Channel] = struct{}{}
+
+		switch {
+		case ch.Channel < 0 || ch.Channel > math.MaxUint8:
+			return cmterrors.ErrInvalidField{Field: key("channels.%d.channel", i), Reason: "must be a byte"}
+		case ch.SendRate < 0:
+			return cmterrors.ErrNegativeField{Field: key("channels.%d.send_rate", i)}
+		case ch.RecvRate < 0:
+			return cmterrors.ErrNegativeField{Field: key("channels.%d.recv_rate", i)}
+		}
+	}
+
+	return nil
+}
+
+func DefaultLibP2PBridge() LibP2PBridge {
+	return LibP2PBridge{
+		Enabled:         false,
+		ListenAddress:   "tcp://0.0.0.0:26666",
+		ExternalAddress: "",
+	}
+}
+
 func DefaultLibP2PLimits() LibP2PLimits {
 	return LibP2PLimits{
 		Mode:           LibP2PLimitsModeDefault,
@@ -891,10 +1192,24 @@ func (l *LibP2PLimits) ValidateBasic() error {
 		return cmterrors.ErrNegativeField{Field: key("max_peers")}
 	case l.MaxPeerStreams < 0:
 		return cmterrors.ErrNegativeField{Field: key("max_peer_streams")}
+	case l.MaxInboundPeers < 0:
+		return cmterrors.ErrNegativeField{Field: key("max_inbound_peers")}
+	case l.MaxOutboundPeers < 0:
+		return cmterrors.ErrNegativeField{Field: key("max_outbound_peers")}
+	case l.ReservedPeerSlots < 0:
+		return cmterrors.ErrNegativeField{Field: key("reserved_peer_slots")}
+	case l.MaxPeersPerIP < 0:
+		return cmterrors.ErrNegativeField{Field: key("max_peers_per_ip")}
+	case l.MaxPeersPerSubnet < 0:
+		return cmterrors.ErrNegativeField{Field: key("max_peers_per_subnet")}
 	case l.Mode == LibP2PLimitsModeCustom && l.MaxPeers == 0:
 		return cmterrors.ErrRequiredField{Field: key("max_peers")}
 	case l.Mode == LibP2PLimitsModeCustom && l.MaxPeerStreams == 0:
 		return cmterrors.ErrRequiredField{Field: key("max_peer_streams")}
+	case l.Mode == LibP2PLimitsModeCustom && l.ReservedPeerSlots >= l.MaxPeers:
+		return cmterrors.ErrInvalidField{Field: key("reserved_peer_slots"), Reason: "must be less than max_peers"}
+	case l.Mode == LibP2PLimitsModeCustom && l.MaxPeersPerSubnet > 0 && l.MaxPeersPerSubnet < l.MaxPeersPerIP:
+		return cmterrors.ErrInvalidField{Field: key("max_peers_per_subnet"), Reason: "must not be less than max_peers_per_ip"}
 	}
 
 	return nil
@@ -1239,6 +1554,10 @@ type ConsensusConfig struct {
 	WalPath string `mapstructure:"wal_file"`
 	walFile string // overrides WalPath if set
 
+	// The timeouts below, and SkipTimeoutCommit, only apply to validators
+	// until the timeout consensus params are enabled, see
+	// types.FeatureParams.TimeoutParamsEnableHeight.
+
 	// How long we wait for a proposal block before prevoting nil
 	TimeoutPropose time.Duration `mapstructure:"timeout_propose"`
 	// How much timeout_propose increases with each round
@@ -1272,6 +1591,49 @@ type ConsensusConfig struct {
 
 	// BlockTimeTolerance is the maximum allowed difference between the proposed block time and wall-clock time.
 	BlockTimeTolerance time.Duration `mapstructure:"block_time_tolerance"`
+
+	// CompactBlocks enables compact block propagation: the proposal block is
+	// gossiped as its header plus the keys of its transactions to the peers
+	// supporting it, which reconstruct the block from their mempool and only
+	// fetch the missing transactions. Requires the "flood" mempool.
+	CompactBlocks bool `mapstructure:"compact_blocks"`
+	// How long we wait for a peer to reconstruct a compact block before
+	// falling back to gossiping the block parts.
+	CompactBlockTimeout time.Duration `mapstructure:"compact_block_timeout"`
+
+	// ErasureCodedParts makes the proposals of this node Reed-Solomon coded:
+	// any half of their block parts reconstruct the block, and different parts
+	// are sent to different peers.
+	ErasureCodedParts bool `mapstructure:"erasure_coded_parts"`
+
+	// OptimisticExecution starts executing a proposal block once the
+	// application accepts it in ProcessProposal, while the votes are gathered,
+	// instead of once it is decided. Requires the application to support
+	// FinalizeBlock being called several times for a height, see
+	// state.BlockExecutor.ExecuteBlockOptimistically.
+	OptimisticExecution bool `mapstructure:"optimistic_execution"`
+
+	// TraceHeights is the number of the last heights whose timeline (steps,
+	// proposal, block part and vote arrivals, timeouts and ABCI calls) is
+	// recorded, see the consensus_trace RPC route. 0 disables the recording.
+	TraceHeights int `mapstructure:"trace_heights"`
+
+	// CheckpointInterval is how often the consensus state of the current
+	// height (votes, proposal, locked and valid blocks) is saved to the state
+	// database, so that a restarted node resumes the height from it and only
+	// replays the messages of the WAL received after it. 0 disables the
+	// checkpoints.
+	CheckpointInterval time.Duration `mapstructure:"checkpoint_interval"`
+
+	// HaltHeight is the height after which consensus halts, e.g. for a
+	// coordinated upgrade. The start command then stops the node, which
+	// refuses to start again until the option is changed. 0 disables the halt.
+	HaltHeight int64 `mapstructure:"halt_height"`
+
+	// HaltTime is the unix time in seconds after which consensus halts: it
+	// halts after committing the first block whose time is at or after it. 0
+	// disables the halt.
+	HaltTime int64 `mapstructure:"halt_time"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -1292,6 +1654,14 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
 		DoubleSignCheckHeight:       int64(0),
 		BlockTimeTolerance:          60 * time.Second,
+		CompactBlocks:               false,
+		CompactBlockTimeout:         500 * time.Millisecond,
+		ErasureCodedParts:           false,
+		OptimisticExecution:         false,
+		TraceHeights:                10,
+		CheckpointInterval:          0,
+		HaltHeight:                  0,
+		HaltTime:                    0,
 	}
 }
 
@@ -1397,6 +1767,21 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.BlockTimeTolerance <= 0 {
 		return errors.New("block_time_tolerance must be positive")
 	}
+	if cfg.CompactBlockTimeout < 0 {
+		return cmterrors.ErrNegativeField{Field: "compact_block_timeout"}
+	}
+	if cfg.TraceHeights < 0 {
+		return cmterrors.ErrNegativeField{Field: "trace_heights"}
+	}
+	if cfg.CheckpointInterval < 0 {
+		return cmterrors.ErrNegativeField{Field: "checkpoint_interval"}
+	}
+	if cfg.HaltHeight < 0 {
+		return cmterrors.ErrNegativeField{Field: "halt_height"}
+	}
+	if cfg.HaltTime < 0 {
+		return cmterrors.ErrNegativeField{Field: "halt_time"}
+	}
 	return nil
 }
 
@@ -1410,13 +1795,21 @@ type StorageConfig struct {
 	// required for `/block_results` RPC queries, and to reindex events in the
 	// command-line tool.
 	DiscardABCIResponses bool `mapstructure:"discard_abci_responses"`
+
+	// Number of most recent heights whose extended commits (vote extensions
+	// and their signatures) are kept. Older extended commits are pruned
+	// independently of their blocks. 0 keeps them for as long as their blocks.
+	// When vote extensions are enabled, the blocks whose extended commits were
+	// pruned are no longer served to peers block syncing from this node.
+	ExtendedCommitRetainBlocks int64 `mapstructure:"extended_commit_retain_blocks"`
 }
 
 // DefaultStorageConfig returns the default configuration options relating to
 // CometBFT storage optimization.
 func DefaultStorageConfig() *StorageConfig {
 	return &StorageConfig{
-		DiscardABCIResponses: false,
+		DiscardABCIResponses:       false,
+		ExtendedCommitRetainBlocks: 0,
 	}
 }
 
@@ -1424,10 +1817,20 @@ func DefaultStorageConfig() *StorageConfig {
 // testing.
 func TestStorageConfig() *StorageConfig {
 	return &StorageConfig{
-		DiscardABCIResponses: false,
+		DiscardABCIResponses:       false,
+		ExtendedCommitRetainBlocks: 0,
 	}
 }
 
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *StorageConfig) ValidateBasic() error {
+	if cfg.ExtendedCommitRetainBlocks < 0 {
+		return cmterrors.ErrNegativeField{Field: "extended_commit_retain_blocks"}
+	}
+	return nil
+}
+
 // -----------------------------------------------------------------------------
 // TxIndexConfig
 // Remember that Event has the following structure:
@@ -1442,6 +1845,8 @@ func TestStorageConfig() *StorageConfig {
 // TxIndexConfig defines the configuration for the transaction indexer,
 // including composite keys to index.
 type TxIndexConfig struct {
+	RootDir string `mapstructure:"home"`
+
 	// What indexer to use for transactions
 	//
 	// Options:
@@ -1449,25 +1854,110 @@ type TxIndexConfig struct {
 	//   2) "kv" (default) - the simplest possible indexer,
 	//      backed by key-value storage (defaults to levelDB; see DBBackend).
 	//   3) "psql" - the indexer services backed by PostgreSQL.
+	//   4) "sqlite" - the indexer services backed by an embedded SQLite database.
 	Indexer string `mapstructure:"indexer"`
 
 	// The PostgreSQL connection configuration, the connection format:
 	// postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 	PsqlConn string `mapstructure:"psql-conn"`
+
+	// Path to the SQLite database file used by the "sqlite" indexer, relative
+	// to the home directory if not absolute.
+	SqlitePath string `mapstructure:"sqlite-path"`
+
+	// Composite keys ("type.key") of the event attributes to index, as glob
+	// patterns (e.g. "transfer.*"). If empty, every attribute flagged for
+	// indexing by the application is indexed.
+	AllowKeys []string `mapstructure:"allow-keys"`
+
+	// Composite keys ("type.key") of the event attributes not to index, as
+	// glob patterns. Takes precedence over AllowKeys.
+	DenyKeys []string `mapstructure:"deny-keys"`
+
+	// Maximum size in bytes of an attribute value to be indexed. Attributes
+	// with larger values are not indexed. 0 means unlimited.
+	MaxValueSize int `mapstructure:"max-value-size"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
 func DefaultTxIndexConfig() *TxIndexConfig {
 	return &TxIndexConfig{
-		Indexer: "kv",
+		Indexer:    "kv",
+		SqlitePath: filepath.Join(DefaultDataDir, "tx_index.sqlite"),
+		AllowKeys:  []string{},
+		DenyKeys:   []string{},
 	}
 }
 
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	for _, pattern := range append(append([]string{}, cfg.AllowKeys...), cfg.DenyKeys...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid key pattern %q: %w", pattern, err)
+		}
+	}
+	if cfg.MaxValueSize < 0 {
+		return cmterrors.ErrNegativeField{Field: "max-value-size"}
+	}
+	return nil
+}
+
+// SqliteFile returns the full path to the SQLite database file.
+func (cfg *TxIndexConfig) SqliteFile() string {
+	return rootify(cfg.SqlitePath, cfg.RootDir)
+}
+
 // TestTxIndexConfig returns a default configuration for the transaction indexer.
 func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
 }
 
+//-----------------------------------------------------------------------------
+// ValidatorStatsConfig
+
+// ValidatorStatsConfig defines the configuration for the validator liveness
+// service, which tracks how the validators sign the commits.
+type ValidatorStatsConfig struct {
+	// When true, the signed, missed and absent precommits of each validator
+	// are counted over the windows, persisted to the state database and
+	// served by the /validator_stats RPC endpoint and as Prometheus metrics.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Sizes, in heights, of the sliding windows the precommits are counted
+	// over. The records of the heights within the largest window are kept.
+	Windows []int64 `mapstructure:"windows"`
+}
+
+// DefaultValidatorStatsConfig returns a default configuration for the
+// validator liveness service.
+func DefaultValidatorStatsConfig() *ValidatorStatsConfig {
+	return &ValidatorStatsConfig{
+		Enabled: false,
+		Windows: []int64{100, 1000, 10000},
+	}
+}
+
+// TestValidatorStatsConfig returns a configuration for the validator liveness
+// service that can be used for testing.
+func TestValidatorStatsConfig() *ValidatorStatsConfig {
+	return DefaultValidatorStatsConfig()
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *ValidatorStatsConfig) ValidateBasic() error {
+	if cfg.Enabled && len(cfg.Windows) == 0 {
+		return errors.New("windows can't be empty")
+	}
+	for _, w := range cfg.Windows {
+		if w <= 0 {
+			return fmt.Errorf("windows must be positive, got %d", w)
+		}
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // InstrumentationConfig
 
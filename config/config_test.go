@@ -43,6 +43,14 @@ func TestConfigValidateBasic(t *testing.T) {
 	cfg.Consensus.CreateEmptyBlocks = false
 	cfg.Mempool.Type = config.MempoolTypeNop
 	assert.Error(t, cfg.ValidateBasic())
+	cfg.Consensus.CreateEmptyBlocks = true
+
+	// compact blocks are reconstructed from the flood mempool
+	cfg.Consensus.CompactBlocks = true
+	cfg.Mempool.Type = config.MempoolTypeApp
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Mempool.Type = config.MempoolTypeFlood
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestTLSConfiguration(t *testing.T) {
@@ -165,6 +173,57 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 				},
 				errContains: "p2p.libp2p.bootstrap_peers.0.id is required",
 			},
+			{
+				name: "allowsTCPWithQUIC",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Transports = []string{config.LibP2PTransportTCP, config.LibP2PTransportQUIC}
+					cfg.LibP2PConfig.Security = []string{config.LibP2PSecurityTLS}
+					cfg.LibP2PConfig.ListenAddresses = []string{"/ip6/::/udp/26656/quic-v1"}
+				},
+			},
+			{
+				name: "requiresTransports",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Transports = nil
+				},
+				errContains: "p2p.libp2p.transports is required",
+			},
+			{
+				name: "rejectsUnknownTransport",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Transports = []string{config.LibP2PTransportQUIC, "udp"}
+				},
+				errContains: "invalid field p2p.libp2p.transports.1 must be one of: quic, tcp",
+			},
+			{
+				name: "rejectsDuplicateTransport",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Transports = []string{config.LibP2PTransportTCP, config.LibP2PTransportTCP}
+				},
+				errContains: "invalid field p2p.libp2p.transports.1 duplicate tcp",
+			},
+			{
+				name: "requiresSecurityForTCP",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Transports = []string{config.LibP2PTransportTCP}
+					cfg.LibP2PConfig.Security = []string{}
+				},
+				errContains: "p2p.libp2p.security is required",
+			},
+			{
+				name: "rejectsUnknownSecurity",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Security = []string{"plaintext"}
+				},
+				errContains: "invalid field p2p.libp2p.security.0 must be one of: noise, tls",
+			},
+			{
+				name: "rejectsInvalidListenAddress",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.ListenAddresses = []string{"0.0.0.0:26656"}
+				},
+				errContains: "invalid field p2p.libp2p.listen_addresses.0 must be a multiaddr",
+			},
 			{
 				name: "rejectsNegativeScalerMinWorkers",
 				mutate: func(cfg *config.P2PConfig) {
@@ -235,6 +294,134 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 				},
 				errContains: "p2p.libp2p.limits.max_peer_streams is required",
 			},
+			{
+				name: "customLimitsWithQuotas",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Limits.Mode = config.LibP2PLimitsModeCustom
+					cfg.LibP2PConfig.Limits.MaxPeers = 50
+					cfg.LibP2PConfig.Limits.MaxPeerStreams = 32
+					cfg.LibP2PConfig.Limits.MaxInboundPeers = 40
+					cfg.LibP2PConfig.Limits.MaxOutboundPeers = 10
+					cfg.LibP2PConfig.Limits.ReservedPeerSlots = 5
+					cfg.LibP2PConfig.Limits.MaxPeersPerIP = 1
+					cfg.LibP2PConfig.Limits.MaxPeersPerSubnet = 4
+				},
+			},
+			{
+				name: "rejectsNegativeMaxInboundPeers",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Limits.MaxInboundPeers = -1
+				},
+				errContains: "p2p.libp2p.limits.max_inbound_peers can't be negative",
+			},
+			{
+				name: "rejectsReservedSlotsExceedingMaxPeers",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Limits.Mode = config.LibP2PLimitsModeCustom
+					cfg.LibP2PConfig.Limits.MaxPeers = 10
+					cfg.LibP2PConfig.Limits.MaxPeerStreams = 1
+					cfg.LibP2PConfig.Limits.ReservedPeerSlots = 10
+				},
+				errContains: "p2p.libp2p.limits.reserved_peer_slots must be less than max_peers",
+			},
+			{
+				name: "rejectsSubnetCapBelowIPCap",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Limits.Mode = config.LibP2PLimitsModeCustom
+					cfg.LibP2PConfig.Limits.MaxPeers = 10
+					cfg.LibP2PConfig.Limits.MaxPeerStreams = 1
+					cfg.LibP2PConfig.Limits.MaxPeersPerIP = 2
+					cfg.LibP2PConfig.Limits.MaxPeersPerSubnet = 1
+				},
+				errContains: "p2p.libp2p.limits.max_peers_per_subnet must not be less than max_peers_per_ip",
+			},
+			{
+				name: "disabledScoring",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Scoring.BanThreshold = 0
+					cfg.LibP2PConfig.Scoring.BanDuration = 0
+				},
+			},
+			{
+				name: "rejectsNegativeBanThreshold",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Scoring.BanThreshold = -1
+				},
+				errContains: "p2p.libp2p.scoring.ban_threshold can't be negative",
+			},
+			{
+				name: "rejectsNegativePenalty",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Scoring.MalformedPenalty = -1
+				},
+				errContains: "p2p.libp2p.scoring.malformed_penalty can't be negative",
+			},
+			{
+				name: "rejectsZeroBanDuration",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Scoring.BanDuration = 0
+				},
+				errContains: "p2p.libp2p.scoring.ban_duration is required",
+			},
+			{
+				name: "bandwidth",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Bandwidth.SendRate = 5120000
+					cfg.LibP2PConfig.Bandwidth.RecvRate = 5120000
+					cfg.LibP2PConfig.Bandwidth.Channels = []config.LibP2PChannelBandwidth{
+						{Channel: 0x30, SendRate: 1024000, RecvRate: 1024000},
+					}
+				},
+			},
+			{
+				name: "rejectsNegativeSendRate",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Bandwidth.SendRate = -1
+				},
+				errContains: "p2p.libp2p.bandwidth.send_rate can't be negative",
+			},
+			{
+				name: "rejectsInvalidChannel",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Bandwidth.Channels = []config.LibP2PChannelBandwidth{
+						{Channel: 256, SendRate: 1},
+					}
+				},
+				errContains: "p2p.libp2p.bandwidth.channels.0.channel must be a byte",
+			},
+			{
+				name: "rejectsDuplicateChannel",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Bandwidth.Channels = []config.LibP2PChannelBandwidth{
+						{Channel: 0x30, SendRate: 1},
+						{Channel: 0x30, RecvRate: 1},
+					}
+				},
+				errContains: "p2p.libp2p.bandwidth.channels.1.channel must be unique",
+			},
+			{
+				name: "bridge",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Bridge.Enabled = true
+					cfg.LibP2PConfig.Bridge.ListenAddress = "tcp://127.0.0.1:36666"
+				},
+			},
+			{
+				name: "rejectsBridgeWithoutListenAddress",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Bridge.Enabled = true
+					cfg.LibP2PConfig.Bridge.ListenAddress = ""
+				},
+				errContains: "p2p.libp2p.bridge.laddr is required",
+			},
+			{
+				name: "rejectsBridgeSharingListenAddress",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Bridge.Enabled = true
+					cfg.LibP2PConfig.Bridge.ListenAddress = cfg.ListenAddress
+				},
+				errContains: "p2p.libp2p.bridge.laddr must differ from p2p.laddr",
+			},
 		} {
 			t.Run(tt.name, func(t *testing.T) {
 				// ARRANGE
@@ -358,6 +545,11 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"BlockTimeTolerance":                   {func(c *config.ConsensusConfig) { c.BlockTimeTolerance = time.Second }, false},
 		"BlockTimeTolerance zero":              {func(c *config.ConsensusConfig) { c.BlockTimeTolerance = 0 }, true},
 		"BlockTimeTolerance negative":          {func(c *config.ConsensusConfig) { c.BlockTimeTolerance = -1 }, true},
+		"CompactBlockTimeout":                  {func(c *config.ConsensusConfig) { c.CompactBlockTimeout = time.Second }, false},
+		"CompactBlockTimeout negative":         {func(c *config.ConsensusConfig) { c.CompactBlockTimeout = -1 }, true},
+		"HaltHeight":                           {func(c *config.ConsensusConfig) { c.HaltHeight = 10 }, false},
+		"HaltHeight negative":                  {func(c *config.ConsensusConfig) { c.HaltHeight = -1 }, true},
+		"HaltTime negative":                    {func(c *config.ConsensusConfig) { c.HaltTime = -1 }, true},
 	}
 	for desc, tc := range testcases {
 		// appease linter
@@ -375,6 +567,24 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestTxIndexConfigValidateBasic(t *testing.T) {
+	cfg := config.TestTxIndexConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.AllowKeys = []string{"transfer.*"}
+	cfg.DenyKeys = []string{"*.memo"}
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// malformed pattern
+	cfg.DenyKeys = []string{"transfer.[a"}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.DenyKeys = nil
+
+	// tamper with maximum value size
+	cfg.MaxValueSize = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg := config.TestInstrumentationConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -353,6 +353,18 @@ bootstrap_peers = [{{ range $bps }}
 ]
 {{- end }}
 
+# Transports to listen on p2p.laddr with, in dial preference order:
+# - quic: QUIC over UDP
+# - tcp: TCP, secured with the protocols below and multiplexed with yamux.
+#   Use it where UDP is blocked or rate-limited.
+transports = [{{ range .P2P.LibP2PConfig.Transports }}{{ printf "%q, " . }}{{end}}]
+
+# Security protocols of the tcp transport, in preference order: noise, tls
+security = [{{ range .P2P.LibP2PConfig.Security }}{{ printf "%q, " . }}{{end}}]
+
+# Additional multiaddrs to listen on, e.g. ["/ip6/::/udp/26656/quic-v1"]
+listen_addresses = [{{ range .P2P.LibP2PConfig.ListenAddresses }}{{ printf "%q, " . }}{{end}}]
+
 # Options for scaling concurrent p2p message queues.
 # Tune workers to keep the system near the ideal operating point:
 # enough concurrency for throughput while keeping processing latency low.
@@ -402,6 +414,83 @@ max_peers = {{ .P2P.LibP2PConfig.Limits.MaxPeers }}
 # Maximum number of concurrent streams per peer (custom mode only)
 max_peer_streams = {{ .P2P.LibP2PConfig.Limits.MaxPeerStreams }}
 
+# Maximum number of inbound and outbound peers (custom mode only).
+# 0 means only max_peers applies.
+# Persistent and unconditional peers don't count towards these quotas.
+max_inbound_peers = {{ .P2P.LibP2PConfig.Limits.MaxInboundPeers }}
+max_outbound_peers = {{ .P2P.LibP2PConfig.Limits.MaxOutboundPeers }}
+
+# Number of max_peers slots reserved for persistent peers (custom mode only).
+# Unconditional peers are never limited.
+reserved_peer_slots = {{ .P2P.LibP2PConfig.Limits.ReservedPeerSlots }}
+
+# Maximum number of peers connected from the same IP address and from the same
+# /24 (IPv4) or /64 (IPv6) subnet (custom mode only). 0 disables the cap.
+# Persistent and unconditional peers are exempt.
+max_peers_per_ip = {{ .P2P.LibP2PConfig.Limits.MaxPeersPerIP }}
+max_peers_per_subnet = {{ .P2P.LibP2PConfig.Limits.MaxPeersPerSubnet }}
+
+# Scoring of misbehaving peers. Each error a peer is disconnected for
+# (e.g. an invalid vote or block, an oversized or malformed message) adds a
+# penalty to its score. Scores are halved every decay_half_life.
+# A peer whose score reaches ban_threshold is banned for ban_duration:
+# connections to and from it are rejected. Unconditional peers are never banned.
+# Banned peers are listed in the net_info RPC and can be unbanned with unban_peer.
+[p2p.libp2p.scoring]
+
+# Score at which a peer is banned. 0 disables banning.
+ban_threshold = {{ .P2P.LibP2PConfig.Scoring.BanThreshold }}
+
+# Penalty for an error reported by a reactor
+error_penalty = {{ .P2P.LibP2PConfig.Scoring.ErrorPenalty }}
+
+# Penalty for an oversized or malformed message
+malformed_penalty = {{ .P2P.LibP2PConfig.Scoring.MalformedPenalty }}
+
+# Time after which a score is halved
+decay_half_life = "{{ .P2P.LibP2PConfig.Scoring.DecayHalfLife }}"
+
+# Duration of a ban
+ban_duration = "{{ .P2P.LibP2PConfig.Scoring.BanDuration }}"
+
+# Bandwidth rate limits of the libp2p streams, in bytes per second. 0 means unlimited.
+# Peer rates limit the traffic with each peer. Channel rates limit the traffic
+# of a channel with each peer, e.g. so that mempool gossip can't starve consensus
+# traffic on constrained links. Bytes sent and received are reported per protocol
+# in the p2p_protocol_send_bytes_total and p2p_protocol_receive_bytes_total metrics.
+[p2p.libp2p.bandwidth]
+
+send_rate = {{ .P2P.LibP2PConfig.Bandwidth.SendRate }}
+recv_rate = {{ .P2P.LibP2PConfig.Bandwidth.RecvRate }}
+
+# Limit a specific channel, for example the mempool:
+# [[p2p.libp2p.bandwidth.channels]]
+# channel = 0x30
+# send_rate = 1024000
+# recv_rate = 1024000
+{{- range .P2P.LibP2PConfig.Bandwidth.Channels }}
+[[p2p.libp2p.bandwidth.channels]]
+channel = {{ printf "0x%02x" .Channel }}
+send_rate = {{ .SendRate }}
+recv_rate = {{ .RecvRate }}
+{{- end }}
+
+# Migration bridge. When enabled, the node runs the legacy (MConnection) stack
+# on p2p.laddr and the libp2p stack on bridge.laddr simultaneously, so that
+# validators can migrate to libp2p incrementally. Reactors see a single peer set:
+# a node connected over both stacks is only counted once.
+# persistent_peers and unconditional/private peer IDs in the legacy "<id>@host:port"
+# format are handled by the legacy stack; libp2p peers are set in bootstrap_peers.
+[p2p.libp2p.bridge]
+
+enabled = {{ .P2P.LibP2PConfig.Bridge.Enabled }}
+
+# Address to listen for libp2p connections on. Must differ from p2p.laddr.
+laddr = "{{ .P2P.LibP2PConfig.Bridge.ListenAddress }}"
+
+# Address to advertise to libp2p peers
+external_address = "{{ .P2P.LibP2PConfig.Bridge.ExternalAddress }}"
+
 #######################################################
 ###          Mempool Configuration Option          ###
 #######################################################
@@ -568,6 +657,9 @@ adaptive_sync = {{ .BlockSync.AdaptiveSync }}
 
 wal_file = "{{ js .Consensus.WalPath }}"
 
+# The timeouts below, and skip_timeout_commit, are overridden on validators by the
+# timeout consensus params, once enabled by feature.timeout_params_enable_height.
+
 # How long we wait for a proposal block before prevoting nil
 timeout_propose = "{{ .Consensus.TimeoutPropose }}"
 # How much timeout_propose increases with each round
@@ -605,6 +697,47 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # Maximum allowed difference between proposed block time and wall-clock time.
 block_time_tolerance = "{{ .Consensus.BlockTimeTolerance }}"
 
+# Compact block propagation: gossip the proposal block as its header plus the
+# keys of its transactions to the peers supporting it. They reconstruct the
+# block from their mempool and only fetch the missing transactions.
+# Requires the "flood" mempool.
+compact_blocks = {{ .Consensus.CompactBlocks }}
+
+# How long to wait for a peer to reconstruct a compact block before falling
+# back to gossiping the block parts.
+compact_block_timeout = "{{ .Consensus.CompactBlockTimeout }}"
+
+# Reed-Solomon code the block parts of the proposals of this node, so that any
+# half of them reconstruct the block, and send different parts to different
+# peers. All the nodes of the network must support erasure coded block parts.
+erasure_coded_parts = {{ .Consensus.ErasureCodedParts }}
+
+# Execute a proposal block (FinalizeBlock) once the application accepts it in
+# ProcessProposal, while the votes are gathered, instead of once it is decided.
+# The application must support FinalizeBlock being called again for a height
+# before Commit, discarding the previous call, when another block is decided.
+optimistic_execution = {{ .Consensus.OptimisticExecution }}
+
+# Number of the last heights whose timeline (step transitions, proposal, block
+# part and vote arrivals, timeouts and ABCI call durations) is recorded and
+# served by the /consensus_trace RPC endpoint. Set to 0 to disable it.
+trace_heights = {{ .Consensus.TraceHeights }}
+
+# How often the consensus state of the current height (votes, proposal, locked
+# and valid blocks) is saved to the state database. A restarted node resumes
+# the height from it, and only replays the messages of the WAL received after
+# it. Set to 0 to disable the checkpoints.
+checkpoint_interval = "{{ .Consensus.CheckpointInterval }}"
+
+# The height after which consensus halts, e.g. for a coordinated upgrade. The
+# node then stops, exiting with status 3, and refuses to start until this
+# option is changed. Set to 0 to disable.
+halt_height = {{ .Consensus.HaltHeight }}
+
+# The unix time in seconds after which consensus halts: it halts after
+# committing the first block whose time is at or after it. Set to 0 to disable.
+halt_time = {{ .Consensus.HaltTime }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -616,6 +749,14 @@ block_time_tolerance = "{{ .Consensus.BlockTimeTolerance }}"
 # reindex events in the command-line tool.
 discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 
+# Number of most recent heights whose extended commits (vote extensions and
+# their signatures) are kept. Older extended commits are pruned independently
+# of their blocks. 0 keeps them for as long as their blocks.
+# WARNING: when vote extensions are enabled, peers block syncing from this node
+# need the extended commits of the blocks it sends them. Blocks whose extended
+# commits were pruned are no longer served to them.
+extended_commit_retain_blocks = {{ .Storage.ExtendedCommitRetainBlocks }}
+
 #######################################################
 ###   Transaction Indexer Configuration Options     ###
 #######################################################
@@ -631,13 +772,46 @@ discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 #   2) "kv" (default) - the simplest possible indexer, backed by key-value storage (defaults to levelDB; see DBBackend).
 # 		- When "kv" is chosen "tx.height" and "tx.hash" will always be indexed.
 #   3) "psql" - the indexer services backed by PostgreSQL.
-# When "kv" or "psql" is chosen "tx.height" and "tx.hash" will always be indexed.
+#   4) "sqlite" - the indexer services backed by an embedded SQLite database.
+# When "kv", "psql" or "sqlite" is chosen "tx.height" and "tx.hash" will always be indexed.
 indexer = "{{ .TxIndex.Indexer }}"
 
 # The PostgreSQL connection configuration, the connection format:
 #   postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 psql-conn = "{{ .TxIndex.PsqlConn }}"
 
+# Path to the SQLite database file used by the "sqlite" indexer.
+# Relative paths are resolved against the CometBFT home directory.
+sqlite-path = "{{ js .TxIndex.SqlitePath }}"
+
+# Composite keys ("type.key") of the event attributes to index, as glob
+# patterns (e.g. "transfer.*" or "*.sender"). If empty, every attribute the
+# application flags for indexing is indexed. "tx.hash", "tx.height" and
+# "block.height" are always indexed.
+allow-keys = [{{ range .TxIndex.AllowKeys }}{{ printf "%q, " . }}{{end}}]
+
+# Composite keys ("type.key") of the event attributes not to index, as glob
+# patterns. Takes precedence over allow-keys.
+deny-keys = [{{ range .TxIndex.DenyKeys }}{{ printf "%q, " . }}{{end}}]
+
+# Maximum size in bytes of an attribute value to be indexed. Attributes with
+# larger values are not indexed. 0 means unlimited.
+max-value-size = {{ .TxIndex.MaxValueSize }}
+
+#######################################################
+###      Validator Stats Configuration Options      ###
+#######################################################
+[validator_stats]
+
+# When true, the signed, missed (precommitted nil) and absent precommits of
+# each validator are counted over the windows, persisted to the state database
+# and served by the /validator_stats RPC endpoint and as Prometheus metrics.
+enabled = {{ .ValidatorStats.Enabled }}
+
+# Sizes, in heights, of the sliding windows the precommits are counted over.
+# The records of the heights within the largest window are kept.
+windows = [{{ range .ValidatorStats.Windows }}{{ . }}, {{end}}]
+
 #######################################################
 ###       Instrumentation Configuration Options     ###
 #######################################################
@@ -0,0 +1,352 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// the latest checkpoint of the consensus state saved to the database
+var checkpointKey = []byte("consensusCheckpointKey")
+
+// CheckpointDB sets the database, usually the state database of the node, the
+// checkpoints of the consensus state are saved to, see
+// ConsensusConfig.CheckpointInterval.
+func CheckpointDB(db dbm.DB) StateOption {
+	return func(cs *State) { cs.checkpointDB = db }
+}
+
+// loadCheckpoint returns the latest checkpoint saved to db, or nil if none.
+func loadCheckpoint(db dbm.DB) (*cmtcons.Checkpoint, error) {
+	bz, err := db.Get(checkpointKey)
+	if err != nil || len(bz) == 0 {
+		return nil, err
+	}
+	cp := new(cmtcons.Checkpoint)
+	if err := proto.Unmarshal(bz, cp); err != nil {
+		return nil, fmt.Errorf("unmarshaling consensus checkpoint: %w", err)
+	}
+	return cp, nil
+}
+
+func saveCheckpoint(db dbm.DB, cp *cmtcons.Checkpoint) error {
+	bz, err := proto.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return db.SetSync(checkpointKey, bz)
+}
+
+// checkpoint saves the consensus state of the current height to the checkpoint
+// database, and marks the point it was taken at in the WAL, if the checkpoint
+// interval elapsed since the previous checkpoint.
+// NOTE: it must be called by the receiveRoutine between two messages, so that
+// the state reflects every message written to the WAL before the marker.
+func (cs *State) checkpoint() {
+	if cs.checkpointDB == nil || cs.config.CheckpointInterval <= 0 || cs.replayMode {
+		return
+	}
+	now := cs.now()
+	if now.Sub(cs.lastCheckpoint) < cs.config.CheckpointInterval {
+		return
+	}
+	cs.lastCheckpoint = now
+
+	cs.mtx.RLock()
+	cs.checkpointSeq++
+	cp, err := cs.makeCheckpoint()
+	cs.mtx.RUnlock()
+	if err != nil {
+		cs.Logger.Error("failed to make consensus checkpoint", "height", cp.Height, "err", err)
+		return
+	}
+	if err := saveCheckpoint(cs.checkpointDB, cp); err != nil {
+		cs.Logger.Error("failed to save consensus checkpoint", "height", cp.Height, "err", err)
+		return
+	}
+	// If the marker is lost, e.g. on a crash, the checkpoint is ignored and
+	// the whole height is replayed.
+	if err := cs.wal.Write(CheckpointMarkerMessage{Height: cp.Height, Sequence: cp.Sequence}); err != nil {
+		cs.Logger.Error("failed writing to WAL", "err", err)
+	}
+}
+
+// makeCheckpoint returns a checkpoint of the consensus state of the current
+// height. The votes of the rounds received from peers to catch up beyond the
+// next round aren't included.
+func (cs *State) makeCheckpoint() (*cmtcons.Checkpoint, error) {
+	cp := &cmtcons.Checkpoint{
+		Height:                    cs.Height,
+		Sequence:                  cs.checkpointSeq,
+		Round:                     cs.Round,
+		Step:                      uint32(cs.Step),
+		StartTime:                 cs.StartTime,
+		CommitTime:                cs.CommitTime,
+		LockedRound:               cs.LockedRound,
+		ValidRound:                cs.ValidRound,
+		CommitRound:               cs.CommitRound,
+		TriggeredTimeoutPrecommit: cs.TriggeredTimeoutPrecommit,
+	}
+	if cs.Proposal != nil {
+		cp.Proposal = cs.Proposal.ToProto()
+	}
+
+	addPartSet := func(ps *types.PartSet) (*cmtproto.PartSetHeader, error) {
+		if ps == nil {
+			return nil, nil
+		}
+		psh := ps.Header()
+		header := psh.ToProto()
+		for _, saved := range cp.PartSets {
+			if bytes.Equal(saved.Header.Hash, header.Hash) {
+				return &header, nil
+			}
+		}
+		pbps := cmtcons.CheckpointPartSet{Header: header}
+		for i := 0; i < int(ps.Total()); i++ {
+			part := ps.GetPart(i)
+			if part == nil {
+				continue
+			}
+			pbPart, err := part.ToProto()
+			if err != nil {
+				return nil, err
+			}
+			pbps.Parts = append(pbps.Parts, *pbPart)
+		}
+		cp.PartSets = append(cp.PartSets, pbps)
+		return &header, nil
+	}
+	var err error
+	if cp.ProposalBlockPartsHeader, err = addPartSet(cs.ProposalBlockParts); err != nil {
+		return cp, err
+	}
+	if cp.LockedBlockPartsHeader, err = addPartSet(cs.LockedBlockParts); err != nil {
+		return cp, err
+	}
+	if cp.ValidBlockPartsHeader, err = addPartSet(cs.ValidBlockParts); err != nil {
+		return cp, err
+	}
+
+	for round := int32(0); round <= cs.Votes.Round(); round++ {
+		for _, voteSet := range []*types.VoteSet{cs.Votes.Prevotes(round), cs.Votes.Precommits(round)} {
+			for _, vote := range voteSet.List() {
+				cp.Votes = append(cp.Votes, *vote.ToProto())
+			}
+		}
+	}
+	return cp, nil
+}
+
+// restoreCheckpoint restores the consensus state of the current height from
+// cp, which must have been taken at the same height. The state isn't modified
+// if an error is returned.
+func (cs *State) restoreCheckpoint(cp *cmtcons.Checkpoint) error {
+	if cp.Height != cs.Height {
+		return fmt.Errorf("checkpoint of height %d, expected %d", cp.Height, cs.Height)
+	}
+	step, err := cmtmath.SafeConvertUint8(int64(cp.Step))
+	if err != nil {
+		return err
+	}
+
+	partSets := make(map[string]*types.PartSet, len(cp.PartSets))
+	for _, pbps := range cp.PartSets {
+		header, err := types.PartSetHeaderFromProto(&pbps.Header)
+		if err != nil {
+			return err
+		}
+		ps := types.NewPartSetFromHeader(*header)
+		for i := range pbps.Parts {
+			part, err := types.PartFromProto(&pbps.Parts[i])
+			if err != nil {
+				return err
+			}
+			if _, err := ps.AddPart(part); err != nil {
+				return err
+			}
+		}
+		partSets[string(header.Hash)] = ps
+	}
+	partSet := func(header *cmtproto.PartSetHeader) (*types.PartSet, *types.Block, error) {
+		if header == nil {
+			return nil, nil, nil
+		}
+		ps, ok := partSets[string(header.Hash)]
+		if !ok {
+			return nil, nil, fmt.Errorf("missing part set %X", header.Hash)
+		}
+		if !ps.IsComplete() {
+			return ps, nil, nil
+		}
+		block, err := blockFromParts(ps)
+		return ps, block, err
+	}
+
+	var proposal *types.Proposal
+	if cp.Proposal != nil {
+		if proposal, err = types.ProposalFromProto(cp.Proposal); err != nil {
+			return err
+		}
+	}
+	proposalBlockParts, proposalBlock, err := partSet(cp.ProposalBlockPartsHeader)
+	if err != nil {
+		return err
+	}
+	lockedBlockParts, lockedBlock, err := partSet(cp.LockedBlockPartsHeader)
+	if err != nil {
+		return err
+	}
+	validBlockParts, validBlock, err := partSet(cp.ValidBlockPartsHeader)
+	if err != nil {
+		return err
+	}
+	if (lockedBlockParts != nil && lockedBlock == nil) || (validBlockParts != nil && validBlock == nil) {
+		return errors.New("incomplete locked or valid block")
+	}
+
+	extEnabled := cs.state.ConsensusParams.ABCI.VoteExtensionsEnabled(cs.Height)
+	var votes *cstypes.HeightVoteSet
+	if extEnabled {
+		votes = cstypes.NewExtendedHeightVoteSet(cs.state.ChainID, cs.Height, cs.Validators)
+	} else {
+		votes = cstypes.NewHeightVoteSet(cs.state.ChainID, cs.Height, cs.Validators)
+	}
+	votes.SetSignatureCache(cs.blockExec.SignatureCache())
+	votes.SetRound(cmtmath.SafeAddInt32(cp.Round, 1))
+	for i := range cp.Votes {
+		vote, err := types.VoteFromProto(&cp.Votes[i])
+		if err != nil {
+			return err
+		}
+		if _, err := votes.AddVote(vote, "", extEnabled); err != nil {
+			return err
+		}
+	}
+
+	cs.Round = cp.Round
+	cs.Step = cstypes.RoundStepType(step)
+	cs.StartTime = cp.StartTime
+	cs.CommitTime = cp.CommitTime
+	cs.Proposal = proposal
+	cs.ProposalBlock = proposalBlock
+	cs.ProposalBlockParts = proposalBlockParts
+	cs.LockedRound = cp.LockedRound
+	cs.LockedBlock = lockedBlock
+	cs.LockedBlockParts = lockedBlockParts
+	cs.ValidRound = cp.ValidRound
+	cs.ValidBlock = validBlock
+	cs.ValidBlockParts = validBlockParts
+	cs.Votes = votes
+	cs.CommitRound = cp.CommitRound
+	cs.TriggeredTimeoutPrecommit = cp.TriggeredTimeoutPrecommit
+	cs.newStep()
+
+	// Resume the timeout of the step, which restarts in full. The timeout of
+	// the NewHeight step is scheduled on start.
+	switch cs.Step {
+	case cstypes.RoundStepNewRound:
+		// As in enterNewRound: wait for txs in round 0, else propose.
+		if cs.config.WaitForTxs() && cs.Round == 0 && !cs.needProofBlock(cs.Height) {
+			if cs.config.CreateEmptyBlocksInterval > 0 {
+				cs.scheduleTimeout(cs.config.CreateEmptyBlocksInterval, cs.Height, cs.Round, cstypes.RoundStepNewRound)
+			}
+		} else {
+			cs.scheduleTimeout(0, cs.Height, cs.Round, cstypes.RoundStepNewRound)
+		}
+	case cstypes.RoundStepPropose:
+		cs.scheduleTimeout(cs.roundTimeouts().ProposeTimeout(cs.Round), cs.Height, cs.Round, cstypes.RoundStepPropose)
+	case cstypes.RoundStepPrevoteWait:
+		cs.scheduleTimeout(cs.roundTimeouts().PrevoteTimeout(cs.Round), cs.Height, cs.Round, cstypes.RoundStepPrevoteWait)
+	case cstypes.RoundStepPrecommitWait:
+		cs.scheduleTimeout(cs.roundTimeouts().PrecommitTimeout(cs.Round), cs.Height, cs.Round, cstypes.RoundStepPrecommitWait)
+	case cstypes.RoundStepCommit:
+		cs.tryFinalizeCommit(cs.Height)
+	}
+	return nil
+}
+
+// restoreLatestCheckpoint restores the consensus state from the latest
+// checkpoint if it was taken at height and its marker follows the #ENDHEIGHT of
+// endHeight in the WAL. It returns the number of messages of the WAL following
+// the #ENDHEIGHT the restored state reflects, or 0 if none was restored.
+func (cs *State) restoreLatestCheckpoint(height, endHeight int64) int {
+	if cs.checkpointDB == nil {
+		return 0
+	}
+	cp, err := loadCheckpoint(cs.checkpointDB)
+	if err != nil {
+		cs.Logger.Error("failed to load consensus checkpoint; replaying the whole height", "err", err)
+		return 0
+	}
+	if cp == nil {
+		return 0
+	}
+	// Keep the sequence increasing across restarts, so that the markers written
+	// from now on can't be mistaken for the ones written before.
+	cs.checkpointSeq = max(cs.checkpointSeq, cp.Sequence)
+	if cp.Height != height {
+		return 0
+	}
+
+	n, found, err := cs.findCheckpointMarker(endHeight, cp)
+	if err != nil || !found {
+		cs.Logger.Info("consensus checkpoint not marked in the WAL; replaying the whole height",
+			"height", height, "sequence", cp.Sequence, "err", err)
+		return 0
+	}
+	if err := cs.restoreCheckpoint(cp); err != nil {
+		cs.Logger.Error("failed to restore consensus checkpoint; replaying the whole height",
+			"height", height, "sequence", cp.Sequence, "err", err)
+		return 0
+	}
+	cs.Logger.Info("restored consensus checkpoint", "height", height, "round", cs.Round, "step", cs.Step)
+	return n
+}
+
+// findCheckpointMarker returns the number of messages of the WAL following the
+// #ENDHEIGHT of endHeight up to, and including, the marker of cp, and false if
+// there's no such marker.
+func (cs *State) findCheckpointMarker(endHeight int64, cp *cmtcons.Checkpoint) (int, bool, error) {
+	gr, found, err := cs.wal.SearchForEndHeight(endHeight, &WALSearchOptions{IgnoreDataCorruptionErrors: true})
+	if err != nil || !found {
+		return 0, false, err
+	}
+	defer gr.Close()
+
+	dec := WALDecoder{rd: gr}
+	for n := 1; ; n++ {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			return 0, false, nil
+		} else if err != nil {
+			return 0, false, err
+		}
+		if m, ok := msg.Msg.(CheckpointMarkerMessage); ok && m.Height == cp.Height && m.Sequence == cp.Sequence {
+			return n, true, nil
+		}
+	}
+}
+
+func blockFromParts(ps *types.PartSet) (*types.Block, error) {
+	bz, err := io.ReadAll(ps.GetReader())
+	if err != nil {
+		return nil, err
+	}
+	pbb := new(cmtproto.Block)
+	if err := proto.Unmarshal(bz, pbb); err != nil {
+		return nil, err
+	}
+	return types.BlockFromProto(pbb)
+}
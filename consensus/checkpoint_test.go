@@ -0,0 +1,154 @@
+package consensus
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestCheckpointRestore(t *testing.T) {
+	cs1, vss := randState(4)
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	// cs1 proposes, prevotes and precommits its block once it gets a polka
+	startTestRound(cs1, height, round)
+	propBlockID := ensureNewProposal(proposalCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	signAddVotes(cs1, cmtproto.PrevoteType, propBlockID.Hash, propBlockID.PartSetHeader, false, vss[1:3]...)
+	ensurePrevote(voteCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+
+	cs1.mtx.RLock()
+	cp, err := cs1.makeCheckpoint()
+	rs := cs1.RoundState
+	cs1.mtx.RUnlock()
+	require.NoError(t, err)
+	// the proposal, locked and valid blocks are the same
+	require.Len(t, cp.PartSets, 1)
+
+	cs2 := newState(cs1.state, cs1.privValidator, kvstore.NewInMemoryApplication())
+	require.NoError(t, cs2.restoreCheckpoint(cp))
+
+	assert.Equal(t, rs.Round, cs2.Round)
+	assert.Equal(t, cstypes.RoundStepPrecommit, cs2.Step)
+	assert.Equal(t, rs.Proposal, cs2.Proposal)
+	assert.Equal(t, propBlockID.Hash, cs2.ProposalBlock.Hash())
+	assert.Equal(t, round, cs2.LockedRound)
+	assert.Equal(t, propBlockID.Hash, cs2.LockedBlock.Hash())
+	assert.Equal(t, round, cs2.ValidRound)
+	assert.Equal(t, propBlockID.Hash, cs2.ValidBlock.Hash())
+	assert.Equal(t, rs.Votes.Prevotes(round).BitArray(), cs2.Votes.Prevotes(round).BitArray())
+	assert.Equal(t, rs.Votes.Precommits(round).BitArray(), cs2.Votes.Precommits(round).BitArray())
+	blockID, ok := cs2.Votes.Prevotes(round).TwoThirdsMajority()
+	assert.True(t, ok)
+	assert.Equal(t, propBlockID, blockID)
+
+	// a checkpoint of another height isn't restored
+	cp.Height++
+	require.Error(t, cs2.restoreCheckpoint(cp))
+}
+
+func TestCheckpointRestoreNewRound(t *testing.T) {
+	cs1, _ := randState(4)
+	height := cs1.Height
+
+	// a checkpoint taken at the start of round 1, before its proposal step
+	cp, err := cs1.makeCheckpoint()
+	require.NoError(t, err)
+	cp.Round = 1
+	cp.Step = uint32(cstypes.RoundStepNewRound)
+
+	cs2 := newState(cs1.state, cs1.privValidator, kvstore.NewInMemoryApplication())
+	require.NoError(t, cs2.restoreCheckpoint(cp))
+	require.Equal(t, cstypes.RoundStepNewRound, cs2.Step)
+
+	// the round resumes with its proposal step
+	stepCh := subscribe(cs2.eventBus, types.EventQueryNewRoundStep)
+	cs2.startRoutines(0)
+	ensureNewEvent(stepCh, height, 1, ensureTimeout, "Timeout expired while waiting for the propose step")
+	rs := cs2.GetRoundState()
+	assert.EqualValues(t, 1, rs.Round)
+	assert.GreaterOrEqual(t, rs.Step, cstypes.RoundStepPropose)
+}
+
+func TestCheckpointSkipsMarkedWALMessages(t *testing.T) {
+	cs, _ := randState(1)
+	cs.checkpointDB = dbm.NewMemDB()
+
+	wal, err := NewWAL(filepath.Join(t.TempDir(), "wal"))
+	require.NoError(t, err)
+	wal.SetLogger(log.TestingLogger())
+	require.NoError(t, wal.Start())
+	t.Cleanup(func() {
+		if err := wal.Stop(); err != nil {
+			t.Error(err)
+		}
+		wal.Wait()
+	})
+	cs.wal = wal
+
+	cs.checkpointSeq = 5
+	cp, err := cs.makeCheckpoint()
+	require.NoError(t, err)
+	require.NoError(t, saveCheckpoint(cs.checkpointDB, cp))
+
+	// the WAL starts with #ENDHEIGHT 0
+	height := cs.Height
+	for _, msg := range []WALMessage{
+		types.EventDataRoundState{Height: height, Round: 0, Step: "RoundStepNewHeight"},
+		CheckpointMarkerMessage{Height: height, Sequence: 4},
+		CheckpointMarkerMessage{Height: height, Sequence: 5},
+		types.EventDataRoundState{Height: height, Round: 0, Step: "RoundStepNewRound"},
+	} {
+		require.NoError(t, wal.Write(msg))
+	}
+	require.NoError(t, wal.FlushAndSync())
+
+	// the messages up to the marker of the checkpoint are skipped
+	assert.Equal(t, 3, cs.restoreLatestCheckpoint(height, 0))
+
+	// a checkpoint without a marker isn't restored, but its sequence is kept
+	cp.Sequence = 6
+	require.NoError(t, saveCheckpoint(cs.checkpointDB, cp))
+	assert.Equal(t, 0, cs.restoreLatestCheckpoint(height, 0))
+	assert.EqualValues(t, 6, cs.checkpointSeq)
+
+	// nor is a checkpoint of another height
+	assert.Equal(t, 0, cs.restoreLatestCheckpoint(height+1, height))
+}
+
+func TestCheckpointInterval(t *testing.T) {
+	cs, _ := randState(1)
+	cs.checkpointDB = dbm.NewMemDB()
+
+	// disabled
+	cs.checkpoint()
+	cp, err := loadCheckpoint(cs.checkpointDB)
+	require.NoError(t, err)
+	assert.Nil(t, cp)
+
+	cs.config.CheckpointInterval = time.Minute
+	cs.checkpoint()
+	cs.checkpoint()
+	cp, err = loadCheckpoint(cs.checkpointDB)
+	require.NoError(t, err)
+	require.NotNil(t, cp)
+	assert.Equal(t, cs.Height, cp.Height)
+	// the second call is within the interval
+	assert.EqualValues(t, 1, cp.Sequence)
+}
@@ -0,0 +1,489 @@
+package consensus
+
+import (
+	"bytes"
+	"time"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// compactBlockPollInterval is how often the consensus state is checked
+	// while waiting for the proposal of a compact block.
+	compactBlockPollInterval = 10 * time.Millisecond
+
+	// compactBlockTxsMaxBytes is the maximum size of the transactions sent in
+	// a single CompactBlockTxs message.
+	compactBlockTxsMaxBytes = maxMsgSize - 1024
+	// compactBlockTxOverhead bounds the encoding overhead of a transaction
+	// (its index and length prefixes) in a CompactBlockTxs message.
+	compactBlockTxOverhead = 16
+
+	compactBlockReconstructed = "reconstructed"
+	compactBlockFallback      = "fallback"
+)
+
+// TxProvider returns the transactions known to the node by key, e.g. the
+// mempool (see mempool.CListMempool). It's used to reconstruct compact blocks.
+type TxProvider interface {
+	GetTxByKey(key types.TxKey) (types.Tx, bool)
+}
+
+// WithCompactBlocks enables compact block propagation. The compact blocks
+// received are reconstructed from the transactions of the given provider.
+func WithCompactBlocks(txs TxProvider) ReactorOption {
+	return func(conR *Reactor) { conR.compactBlocks = newCompactBlocks(txs) }
+}
+
+// compactBlocks tracks the compact blocks waiting for their missing
+// transactions, by peer.
+type compactBlocks struct {
+	txs TxProvider
+
+	mtx     cmtsync.Mutex
+	pending map[p2p.ID]*pendingCompactBlock
+}
+
+// pendingCompactBlock is a compact block being reconstructed.
+type pendingCompactBlock struct {
+	height int64
+	round  int32
+	txKeys []types.TxKey
+
+	txs     types.Txs // missing transactions are nil
+	missing int
+	done    chan struct{} // closed once no transaction is missing
+}
+
+func newCompactBlocks(txs TxProvider) *compactBlocks {
+	return &compactBlocks{
+		txs:     txs,
+		pending: make(map[p2p.ID]*pendingCompactBlock),
+	}
+}
+
+// start registers the reconstruction of the compact block received from the
+// peer, with all its transactions missing. Returns false if a compact block of
+// the peer is already being reconstructed, in which case msg must be dropped.
+// The pending compact block is kept until remove is called.
+func (cb *compactBlocks) start(peerID p2p.ID, msg *CompactBlockMessage) (*pendingCompactBlock, bool) {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	if _, ok := cb.pending[peerID]; ok {
+		return nil, false
+	}
+
+	pending := &pendingCompactBlock{
+		height:  msg.Height,
+		round:   msg.Round,
+		txKeys:  msg.TxKeys,
+		txs:     make(types.Txs, len(msg.TxKeys)),
+		missing: len(msg.TxKeys),
+		done:    make(chan struct{}),
+	}
+	if pending.missing == 0 {
+		close(pending.done)
+	}
+	cb.pending[peerID] = pending
+
+	return pending, true
+}
+
+// lookupTxs looks up the transactions of the pending compact block in the
+// provider. The missing ones must then be received from the peer (see addTxs).
+func (cb *compactBlocks) lookupTxs(pending *pendingCompactBlock) {
+	found := make(types.Txs, len(pending.txKeys))
+	for i, key := range pending.txKeys {
+		if tx, ok := cb.txs.GetTxByKey(key); ok {
+			found[i] = tx
+		}
+	}
+
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	if pending.missing == 0 {
+		return
+	}
+	for i, tx := range found {
+		if tx != nil && pending.txs[i] == nil {
+			pending.txs[i] = tx
+			pending.missing--
+		}
+	}
+	if pending.missing == 0 {
+		close(pending.done)
+	}
+}
+
+// addTxs adds the transactions received from the peer to its pending
+// compact block. Transactions not matching the requested keys are ignored.
+func (cb *compactBlocks) addTxs(peerID p2p.ID, msg *CompactBlockTxsMessage) {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	pending, ok := cb.pending[peerID]
+	if !ok || pending.height != msg.Height || pending.round != msg.Round || pending.missing == 0 {
+		return
+	}
+
+	for i, index := range msg.Indexes {
+		if int(index) >= len(pending.txs) || pending.txs[index] != nil {
+			continue
+		}
+		if msg.Txs[i].Key() != pending.txKeys[index] {
+			continue
+		}
+
+		pending.txs[index] = msg.Txs[i]
+		pending.missing--
+	}
+
+	if pending.missing == 0 {
+		close(pending.done)
+	}
+}
+
+// remove forgets the peer's pending compact block.
+func (cb *compactBlocks) remove(peerID p2p.ID, pending *pendingCompactBlock) {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	if cb.pending[peerID] == pending {
+		delete(cb.pending, peerID)
+	}
+}
+
+// missingIndexes returns the indexes of the missing transactions of the
+// pending compact block.
+func (cb *compactBlocks) missingIndexes(pending *pendingCompactBlock) []uint32 {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	indexes := make([]uint32, 0, pending.missing)
+	for i, tx := range pending.txs {
+		if tx == nil {
+			indexes = append(indexes, uint32(i))
+		}
+	}
+	return indexes
+}
+
+// peerSupportsCompactBlocks returns true if the peer advertises the compact
+// block channel.
+func peerSupportsCompactBlocks(peer p2p.Peer) bool {
+	nodeInfo, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && nodeInfo.HasChannel(CompactBlockChannel)
+}
+
+// gossipCompactBlock sends the proposal block to the peer as a compact block,
+// if it supports them and has none of the block parts yet. Returns true while
+// the peer is reconstructing the block, meaning the block parts must not be
+// sent. Once the peer fails to reconstruct it or the timeout expires, the
+// block parts are gossiped as usual.
+func (conR *Reactor) gossipCompactBlock(
+	logger log.Logger,
+	ps *PeerState,
+	rs *cstypes.RoundState,
+	prs *cstypes.PeerRoundState,
+) bool {
+	if !rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
+		return false
+	}
+
+	sent, pending := ps.getCompactBlock(prs.Height, prs.Round, conR.conS.config.CompactBlockTimeout)
+	if sent {
+		return pending
+	}
+
+	if rs.ProposalBlock == nil || !rs.ProposalBlockParts.IsComplete() ||
+		!prs.ProposalBlockParts.IsEmpty() || !peerSupportsCompactBlocks(ps.peer) {
+		return false
+	}
+
+	return ps.sendCompactBlock(logger, rs.ProposalBlock, prs)
+}
+
+// handleCompactBlock reconstructs the compact block received from the peer
+// and acknowledges it. On success, the block parts are passed to the
+// consensus state as if the peer sent them.
+// It must be called for a compact block registered with compactBlocks.start,
+// which is removed once done.
+func (conR *Reactor) handleCompactBlock(
+	peer p2p.Peer,
+	ps *PeerState,
+	msg *CompactBlockMessage,
+	pending *pendingCompactBlock,
+) {
+	defer conR.compactBlocks.remove(peer.ID(), pending)
+
+	logger := conR.Logger.With("peer", peer, "height", msg.Height, "round", msg.Round)
+
+	reconstructed := conR.reconstructCompactBlock(logger, peer, ps, msg, pending)
+
+	outcome := compactBlockReconstructed
+	if !reconstructed {
+		outcome = compactBlockFallback
+	}
+	conR.Metrics.CompactBlocks.With("outcome", outcome).Add(1)
+
+	peer.TrySend(p2p.Envelope{
+		ChannelID: CompactBlockChannel,
+		Message: &cmtcons.CompactBlockAck{
+			Height:        msg.Height,
+			Round:         msg.Round,
+			Reconstructed: reconstructed,
+		},
+	})
+}
+
+func (conR *Reactor) reconstructCompactBlock(
+	logger log.Logger,
+	peer p2p.Peer,
+	ps *PeerState,
+	msg *CompactBlockMessage,
+	pending *pendingCompactBlock,
+) bool {
+	timeout := time.NewTimer(conR.conS.config.CompactBlockTimeout)
+	defer timeout.Stop()
+
+	blockHash := msg.Block.Hash()
+
+	// Nothing to reconstruct if we already have the block
+	if rs := conR.conS.GetRoundState(); rs.Height == msg.Height && rs.ProposalBlock != nil &&
+		bytes.Equal(rs.ProposalBlock.Hash(), blockHash) {
+		ps.setHasAllProposalBlockParts(msg.Height, msg.Round)
+		return true
+	}
+
+	conR.compactBlocks.lookupTxs(pending)
+
+	if missing := conR.compactBlocks.missingIndexes(pending); len(missing) > 0 {
+		conR.Metrics.CompactBlockMissingTxs.Add(float64(len(missing)))
+		logger.Debug("Requesting the missing txs of a compact block", "missing", len(missing))
+
+		if !peer.Send(p2p.Envelope{
+			ChannelID: CompactBlockChannel,
+			Message: &cmtcons.CompactBlockTxsRequest{
+				Height:  msg.Height,
+				Round:   msg.Round,
+				Indexes: missing,
+			},
+		}) {
+			return false
+		}
+
+		select {
+		case <-pending.done:
+		case <-timeout.C:
+			logger.Debug("Timed out waiting for the missing txs of a compact block")
+			return false
+		}
+	}
+
+	block := &types.Block{
+		Header:     msg.Block.Header,
+		Data:       types.Data{Txs: pending.txs},
+		Evidence:   msg.Block.Evidence,
+		LastCommit: msg.Block.LastCommit,
+	}
+	if !bytes.Equal(block.Data.Hash(), block.DataHash) {
+		logger.Info("Compact block txs don't match the data hash")
+		return false
+	}
+
+	// Wait for the proposal, which may be processed after the compact block
+	var partSetHeader types.PartSetHeader
+	for {
+		rs := conR.conS.GetRoundState()
+		if rs.Height > msg.Height || (rs.Height == msg.Height && rs.Round > msg.Round) {
+			return false
+		}
+		if rs.Height == msg.Height && rs.ProposalBlockParts != nil {
+			partSetHeader = rs.ProposalBlockParts.Header()
+			break
+		}
+
+		select {
+		case <-time.After(compactBlockPollInterval):
+		case <-timeout.C:
+			logger.Debug("Timed out waiting for the proposal of a compact block")
+			return false
+		}
+	}
+
+	partSet, err := block.MakePartSetForHeader(types.BlockPartSizeBytes, partSetHeader)
+	if err != nil {
+		logger.Error("Failed to make the parts of a compact block", "err", err)
+		return false
+	}
+	if !partSet.HasHeader(partSetHeader) {
+		logger.Info("Compact block doesn't match the proposal",
+			"partSetHeader", partSet.Header(), "proposalPartSetHeader", partSetHeader)
+		return false
+	}
+
+	ps.setHasAllProposalBlockParts(msg.Height, msg.Round)
+
+	// (the data parts are enough to complete an erasure coded part set)
+	for i := 0; i < int(partSet.DataParts()); i++ {
+		conR.conS.peerMsgQueue <- msgInfo{
+			&BlockPartMessage{Height: msg.Height, Round: msg.Round, Part: partSet.GetPart(i)},
+			peer.ID(),
+		}
+	}
+
+	logger.Debug("Reconstructed compact block", "hash", blockHash, "txs", len(pending.txs))
+
+	return true
+}
+
+// sendCompactBlockTxs sends the requested transactions of the proposal block,
+// split in messages of at most compactBlockTxsMaxBytes. Transactions too large
+// to fit in a message are not sent.
+func (conR *Reactor) sendCompactBlockTxs(peer p2p.Peer, msg *CompactBlockTxsRequestMessage) {
+	rs := conR.getRoundState()
+	if rs.Height != msg.Height || rs.Round != msg.Round || rs.ProposalBlock == nil {
+		return
+	}
+
+	txs := rs.ProposalBlock.Txs
+	resp := &cmtcons.CompactBlockTxs{Height: msg.Height, Round: msg.Round}
+	size := 0
+
+	for _, index := range msg.Indexes {
+		if int(index) >= len(txs) {
+			continue
+		}
+
+		tx := txs[index]
+		if len(tx)+compactBlockTxOverhead > compactBlockTxsMaxBytes {
+			// The tx alone would exceed the channel's message capacity. The
+			// peer times out waiting for it and falls back to block parts.
+			continue
+		}
+		if len(resp.Txs) > 0 && size+len(tx)+compactBlockTxOverhead > compactBlockTxsMaxBytes {
+			if !peer.Send(p2p.Envelope{ChannelID: CompactBlockChannel, Message: resp}) {
+				return
+			}
+			resp = &cmtcons.CompactBlockTxs{Height: msg.Height, Round: msg.Round}
+			size = 0
+		}
+
+		resp.Indexes = append(resp.Indexes, index)
+		resp.Txs = append(resp.Txs, tx)
+		size += len(tx) + compactBlockTxOverhead
+	}
+
+	if len(resp.Txs) > 0 {
+		peer.Send(p2p.Envelope{ChannelID: CompactBlockChannel, Message: resp})
+	}
+}
+
+// compactBlockPeerState is the state of the last compact block sent to a
+// peer.
+type compactBlockPeerState struct {
+	height        int64
+	round         int32
+	sentAt        time.Time
+	acked         bool
+	reconstructed bool
+}
+
+// getCompactBlock returns whether a compact block was sent to the peer at the
+// given height and round, and if so whether the peer is still reconstructing
+// it (not acknowledged and sent less than timeout ago).
+func (ps *PeerState) getCompactBlock(height int64, round int32, timeout time.Duration) (sent, pending bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	cb := ps.compactBlock
+	if cb.sentAt.IsZero() || cb.height != height || cb.round != round {
+		return false, false
+	}
+
+	return true, !cb.acked && time.Since(cb.sentAt) < timeout
+}
+
+// sendCompactBlock sends the block to the peer as a compact block.
+// Returns true and records the compact block as sent if it was sent.
+func (ps *PeerState) sendCompactBlock(logger log.Logger, block *types.Block, prs *cstypes.PeerRoundState) bool {
+	txKeys := make([][]byte, len(block.Txs))
+	for i, tx := range block.Txs {
+		key := tx.Key()
+		txKeys[i] = key[:]
+	}
+
+	// the block without its transactions
+	compact := &types.Block{
+		Header:     block.Header,
+		Evidence:   block.Evidence,
+		LastCommit: block.LastCommit,
+	}
+	pb, err := compact.ToProto()
+	if err != nil {
+		logger.Error("Could not convert compact block to proto", "err", err)
+		return false
+	}
+
+	logger.Debug("Sending compact block", "height", prs.Height, "round", prs.Round, "txs", len(txKeys))
+	if !ps.peer.Send(p2p.Envelope{
+		ChannelID: CompactBlockChannel,
+		Message: &cmtcons.CompactBlock{
+			Height: prs.Height,
+			Round:  prs.Round,
+			Block:  *pb,
+			TxKeys: txKeys,
+		},
+	}) {
+		return false
+	}
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactBlock = compactBlockPeerState{
+		height: prs.Height,
+		round:  prs.Round,
+		sentAt: time.Now(),
+	}
+
+	return true
+}
+
+// ApplyCompactBlockAckMessage updates the peer state for the acknowledged
+// compact block. If the peer reconstructed the block, it has all the parts.
+func (ps *PeerState) ApplyCompactBlockAckMessage(msg *CompactBlockAckMessage) {
+	ps.mtx.Lock()
+	cb := &ps.compactBlock
+	if cb.height == msg.Height && cb.round == msg.Round {
+		cb.acked = true
+		cb.reconstructed = msg.Reconstructed
+	}
+	ps.mtx.Unlock()
+
+	if msg.Reconstructed {
+		ps.setHasAllProposalBlockParts(msg.Height, msg.Round)
+	}
+}
+
+// setHasAllProposalBlockParts marks all the proposal block parts as known
+// for the peer.
+func (ps *PeerState) setHasAllProposalBlockParts(height int64, round int32) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != height || ps.PRS.Round != round || ps.PRS.ProposalBlockParts == nil {
+		return
+	}
+
+	for i := 0; i < ps.PRS.ProposalBlockParts.Size(); i++ {
+		ps.PRS.ProposalBlockParts.SetIndex(i, true)
+	}
+}
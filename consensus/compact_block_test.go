@@ -0,0 +1,307 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+	p2pmock "github.com/cometbft/cometbft/p2p/mock"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestCompactBlockMsgProto(t *testing.T) {
+	block := makeCompactTestBlock(types.Txs{types.Tx("a=1"), types.Tx("b=2")})
+
+	msg := &CompactBlockMessage{
+		Height: block.Height,
+		Round:  1,
+		Block:  &types.Block{Header: block.Header, LastCommit: block.LastCommit},
+		TxKeys: []types.TxKey{block.Txs[0].Key(), block.Txs[1].Key()},
+	}
+
+	pb, err := MsgToProto(msg)
+	require.NoError(t, err)
+
+	decoded, err := MsgFromProto(pb)
+	require.NoError(t, err)
+
+	compact, ok := decoded.(*CompactBlockMessage)
+	require.True(t, ok)
+	assert.Equal(t, msg.Height, compact.Height)
+	assert.Equal(t, msg.Round, compact.Round)
+	assert.Equal(t, msg.TxKeys, compact.TxKeys)
+	assert.Empty(t, compact.Block.Txs)
+	assert.Equal(t, block.Hash(), compact.Block.Hash())
+
+	// invalid tx key
+	pb.(*cmtcons.CompactBlock).TxKeys[0] = []byte{1, 2, 3}
+	_, err = MsgFromProto(pb)
+	require.Error(t, err)
+}
+
+func TestCompactBlockMessageValidateBasic(t *testing.T) {
+	block := makeCompactTestBlock(nil)
+
+	testCases := []struct {
+		testName  string
+		malleate  func(*CompactBlockMessage)
+		expectErr bool
+	}{
+		{"Valid Message", func(*CompactBlockMessage) {}, false},
+		{"Negative Height", func(m *CompactBlockMessage) { m.Height = -1 }, true},
+		{"Negative Round", func(m *CompactBlockMessage) { m.Round = -1 }, true},
+		{"Nil Block", func(m *CompactBlockMessage) { m.Block = nil }, true},
+		{"Height Mismatch", func(m *CompactBlockMessage) { m.Height = 2 }, true},
+		{"Block With Txs", func(m *CompactBlockMessage) { m.Block.Txs = types.Txs{types.Tx("a=1")} }, true},
+		{"Invalid Header", func(m *CompactBlockMessage) { m.Block.ProposerAddress = nil }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			message := &CompactBlockMessage{
+				Height: block.Height,
+				Block:  &types.Block{Header: block.Header},
+			}
+
+			tc.malleate(message)
+			assert.Equal(t, tc.expectErr, message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestCompactBlocks(t *testing.T) {
+	const peerID = p2p.ID("peer")
+
+	txs := types.Txs{types.Tx("a=1"), types.Tx("b=2"), types.Tx("c=3")}
+
+	msg := &CompactBlockMessage{
+		Height: 1,
+		TxKeys: []types.TxKey{txs[0].Key(), txs[1].Key(), txs[2].Key()},
+	}
+
+	t.Run("AllTxsKnown", func(t *testing.T) {
+		cb := newCompactBlocks(testTxProvider(txs))
+
+		pending, ok := cb.start(peerID, msg)
+		require.True(t, ok)
+		cb.lookupTxs(pending)
+
+		require.Zero(t, pending.missing)
+		require.Equal(t, txs, pending.txs)
+		require.Eventually(t, isClosed(pending.done), time.Second, time.Millisecond)
+
+		cb.remove(peerID, pending)
+		require.Empty(t, cb.pending)
+	})
+
+	t.Run("MissingTxs", func(t *testing.T) {
+		// ARRANGE
+		cb := newCompactBlocks(testTxProvider(txs[:1]))
+
+		// ACT (1)
+		pending, ok := cb.start(peerID, msg)
+		require.True(t, ok)
+		cb.lookupTxs(pending)
+
+		// ASSERT (1)
+		require.Equal(t, 2, pending.missing)
+		require.Equal(t, []uint32{1, 2}, cb.missingIndexes(pending))
+
+		// ACT (2): a tx not matching its key, then a tx for another block
+		cb.addTxs(peerID, &CompactBlockTxsMessage{Height: 1, Indexes: []uint32{1}, Txs: types.Txs{txs[2]}})
+		cb.addTxs(peerID, &CompactBlockTxsMessage{Height: 2, Indexes: []uint32{1}, Txs: types.Txs{txs[1]}})
+
+		// ASSERT (2)
+		require.Equal(t, 2, pending.missing)
+		require.False(t, isClosed(pending.done)())
+
+		// ACT (3)
+		cb.addTxs(peerID, &CompactBlockTxsMessage{Height: 1, Indexes: []uint32{1, 2}, Txs: txs[1:]})
+
+		// ASSERT (3)
+		require.Zero(t, pending.missing)
+		require.Equal(t, txs, pending.txs)
+		require.True(t, isClosed(pending.done)())
+
+		cb.remove(peerID, pending)
+		require.Empty(t, cb.pending)
+	})
+
+	t.Run("OneInFlightPerPeer", func(t *testing.T) {
+		cb := newCompactBlocks(testTxProvider(nil))
+
+		pending, ok := cb.start(peerID, msg)
+		require.True(t, ok)
+
+		// another compact block of the same peer is dropped
+		_, ok = cb.start(peerID, &CompactBlockMessage{Height: 1, Round: 1})
+		require.False(t, ok)
+
+		// but not one of another peer
+		other, ok := cb.start(p2p.ID("other"), msg)
+		require.True(t, ok)
+		cb.remove(p2p.ID("other"), other)
+
+		cb.remove(peerID, pending)
+		_, ok = cb.start(peerID, msg)
+		require.True(t, ok)
+	})
+}
+
+func TestPeerStateCompactBlock(t *testing.T) {
+	const timeout = time.Minute
+
+	ps := NewPeerState(nil).SetLogger(log.TestingLogger())
+	ps.PRS.Height = 1
+	ps.PRS.Round = 0
+
+	ps.InitProposalBlockParts(types.PartSetHeader{Total: 3, Hash: cmtrand.Bytes(32)})
+
+	// nothing sent yet
+	sent, pending := ps.getCompactBlock(1, 0, timeout)
+	require.False(t, sent)
+	require.False(t, pending)
+
+	ps.compactBlock = compactBlockPeerState{height: 1, round: 0, sentAt: time.Now()}
+
+	sent, pending = ps.getCompactBlock(1, 0, timeout)
+	require.True(t, sent)
+	require.True(t, pending)
+
+	// the peer reconstructed the block: it has all the parts
+	ps.ApplyCompactBlockAckMessage(&CompactBlockAckMessage{Height: 1, Round: 0, Reconstructed: true})
+
+	sent, pending = ps.getCompactBlock(1, 0, timeout)
+	require.True(t, sent)
+	require.False(t, pending)
+	require.True(t, ps.GetRoundState().ProposalBlockParts.IsFull())
+
+	// the compact block times out
+	ps.compactBlock = compactBlockPeerState{height: 1, round: 0, sentAt: time.Now().Add(-2 * timeout)}
+
+	_, pending = ps.getCompactBlock(1, 0, timeout)
+	require.False(t, pending)
+}
+
+// Ensure a testnet makes blocks with compact blocks, fetching the txs missing
+// from the mempools.
+func TestReactorCompactBlocks(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(t, N, "consensus_reactor_test", newMockTickerFunc(true), newKVStore)
+	defer cleanup()
+
+	// every node knows a shared tx and one of its own, which the others must
+	// fetch to reconstruct its proposal
+	for i, cs := range css {
+		mempool := cs.txNotifier.(*mempl.CListMempool)
+		require.NoError(t, mempool.CheckTx(kvstore.NewTx("shared", "tx"), nil, mempl.TxInfo{}))
+		require.NoError(t, mempool.CheckTx(kvstore.NewTxFromID(i), nil, mempl.TxInfo{}))
+	}
+
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N, func(cs *State) ReactorOption {
+		return WithCompactBlocks(cs.txNotifier.(*mempl.CListMempool))
+	})
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	// wait till everyone makes the first new block
+	timeoutWaitGroup(N, func(j int) {
+		msg := <-blocksSubs[j].Out()
+		block := msg.Data().(types.EventDataNewBlock).Block
+		assert.Len(t, block.Txs, 2)
+	})
+
+	// at least one peer reconstructed the proposal from a compact block
+	require.Eventually(t, func() bool {
+		for _, r := range reactors {
+			for _, peer := range r.Switch.Peers().Copy() {
+				ps := peer.Get(types.PeerStateKey).(*PeerState)
+
+				ps.mtx.Lock()
+				reconstructed := ps.compactBlock.height == 1 && ps.compactBlock.reconstructed
+				ps.mtx.Unlock()
+
+				if reconstructed {
+					return true
+				}
+			}
+		}
+		return false
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+// Ensure the requested txs are sent in messages that fit in the channel, and
+// that a tx too large to fit is omitted.
+func TestReactorSendCompactBlockTxs(t *testing.T) {
+	maxTxBytes := compactBlockTxsMaxBytes - compactBlockTxOverhead
+	txs := types.Txs{
+		types.Tx("a=1"),
+		cmtrand.Bytes(maxMsgSize),
+		cmtrand.Bytes(maxTxBytes),
+		types.Tx("b=2"),
+	}
+	conR := &Reactor{rs: cstypes.RoundState{Height: 1, ProposalBlock: makeCompactTestBlock(txs)}}
+	peer := &recordingPeer{Peer: p2pmock.NewPeer(nil)}
+
+	conR.sendCompactBlockTxs(peer, &CompactBlockTxsRequestMessage{Height: 1, Indexes: []uint32{0, 1, 2, 3}})
+
+	var indexes []uint32
+	for _, e := range peer.sent {
+		require.Equal(t, CompactBlockChannel, e.ChannelID)
+		msg := e.Message.(*cmtcons.CompactBlockTxs)
+		require.LessOrEqual(t, msg.Wrap().(*cmtcons.Message).Size(), maxMsgSize)
+		indexes = append(indexes, msg.Indexes...)
+	}
+	assert.Equal(t, []uint32{0, 2, 3}, indexes)
+}
+
+// recordingPeer is a mock peer recording the envelopes sent to it.
+type recordingPeer struct {
+	*p2pmock.Peer
+	sent []p2p.Envelope
+}
+
+func (p *recordingPeer) Send(e p2p.Envelope) bool {
+	p.sent = append(p.sent, e)
+	return true
+}
+
+// makeCompactTestBlock returns a valid block at height 1 with the given txs.
+func makeCompactTestBlock(txs types.Txs) *types.Block {
+	block := types.MakeBlock(1, txs, nil, nil)
+	block.ProposerAddress = cmtrand.Bytes(crypto.AddressSize)
+
+	return block
+}
+
+// testTxProvider is a TxProvider of the given txs.
+type testTxProvider types.Txs
+
+func (txs testTxProvider) GetTxByKey(key types.TxKey) (types.Tx, bool) {
+	for _, tx := range txs {
+		if tx.Key() == key {
+			return tx, true
+		}
+	}
+	return nil, false
+}
+
+func isClosed(ch chan struct{}) func() bool {
+	return func() bool {
+		select {
+		case <-ch:
+			return true
+		default:
+			return false
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package consensus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/version"
+)
+
+// the software the node started committing blocks with, see CheckHalt
+var softwareVersionKey = []byte("consensusSoftwareVersionKey")
+
+// The reasons consensus halts after a height.
+const (
+	HaltReasonHeight  = "halt_height"
+	HaltReasonTime    = "halt_time"
+	HaltReasonUpgrade = "upgrade"
+)
+
+// Halt describes why consensus halted after committing a height.
+type Halt struct {
+	Height int64
+	// one of HaltReasonHeight, HaltReasonTime or HaltReasonUpgrade
+	Reason string
+	// the upgrade required by the application, if the reason is
+	// HaltReasonUpgrade
+	Upgrade *abci.Upgrade
+}
+
+func (h Halt) String() string {
+	switch h.Reason {
+	case HaltReasonUpgrade:
+		if h.Upgrade.Info == "" {
+			return fmt.Sprintf("upgrade %q required by the application", h.Upgrade.Name)
+		}
+		return fmt.Sprintf("upgrade %q required by the application (%s)", h.Upgrade.Name, h.Upgrade.Info)
+	default:
+		return h.Reason + " reached"
+	}
+}
+
+// ErrHalted is returned by CheckHalt when the node must not proceed past its
+// latest block.
+type ErrHalted struct {
+	Halt Halt
+}
+
+func (e ErrHalted) Error() string {
+	if e.Halt.Reason == HaltReasonUpgrade {
+		return fmt.Sprintf("consensus halted after height %d: %v; upgrade the software to proceed",
+			e.Halt.Height, e.Halt)
+	}
+	return fmt.Sprintf("consensus halted after height %d: %v; unset or raise %s to proceed",
+		e.Halt.Height, e.Halt, e.Halt.Reason)
+}
+
+// configHalt returns the halt required by the config after the block of the
+// given height and time, or nil.
+func configHalt(config *cfg.ConsensusConfig, height int64, blockTime time.Time) *Halt {
+	switch {
+	case config.HaltHeight > 0 && height >= config.HaltHeight:
+		return &Halt{Height: height, Reason: HaltReasonHeight}
+	case config.HaltTime > 0 && blockTime.Unix() >= config.HaltTime:
+		return &Halt{Height: height, Reason: HaltReasonTime}
+	}
+	return nil
+}
+
+// CheckHalt returns ErrHalted if the node must not proceed past the latest
+// block of state: if the config requires consensus to halt after it, or if the
+// application required an upgrade after it and neither the version of
+// CometBFT nor the software version of the application, appVersion as
+// reported by Info, changed since. Otherwise, it saves the software to db,
+// usually the state database of the node.
+func CheckHalt(
+	config *cfg.ConsensusConfig,
+	db dbm.DB,
+	stateStore sm.Store,
+	state sm.State,
+	appVersion string,
+) error {
+	software, err := loadSoftwareVersion(db)
+	if err != nil {
+		return err
+	}
+	changed := software == nil || software.Version != version.TMCoreSemVer || software.AppVersion != appVersion
+
+	if height := state.LastBlockHeight; height > 0 {
+		if halt := configHalt(config, height, state.LastBlockTime); halt != nil {
+			return ErrHalted{Halt: *halt}
+		}
+		// The response is kept even if the ABCI responses are discarded.
+		if !changed && software.Height < height {
+			resp, err := stateStore.LoadLastFinalizeBlockResponse(height)
+			if err == nil && resp.Upgrade != nil {
+				return ErrHalted{Halt: Halt{Height: height, Reason: HaltReasonUpgrade, Upgrade: resp.Upgrade}}
+			}
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	return saveSoftwareVersion(db, &cmtcons.SoftwareVersion{
+		Version:    version.TMCoreSemVer,
+		AppVersion: appVersion,
+		Height:     state.LastBlockHeight,
+	})
+}
+
+func loadSoftwareVersion(db dbm.DB) (*cmtcons.SoftwareVersion, error) {
+	bz, err := db.Get(softwareVersionKey)
+	if err != nil || len(bz) == 0 {
+		return nil, err
+	}
+	sv := new(cmtcons.SoftwareVersion)
+	if err := proto.Unmarshal(bz, sv); err != nil {
+		return nil, fmt.Errorf("unmarshaling software version: %w", err)
+	}
+	return sv, nil
+}
+
+func saveSoftwareVersion(db dbm.DB, sv *cmtcons.SoftwareVersion) error {
+	bz, err := proto.Marshal(sv)
+	if err != nil {
+		return err
+	}
+	return db.SetSync(softwareVersionKey, bz)
+}
+
+// GetHalt returns why consensus halted, or nil if it didn't.
+func (cs *State) GetHalt() *Halt {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.halt
+}
+
+// Halted returns a channel closed once consensus halted, see GetHalt.
+func (cs *State) Halted() <-chan struct{} {
+	return cs.halted
+}
+
+// haltAfterCommit halts consensus if the config or the application require it
+// after the committed block of the given height and time, and returns true if
+// it did. The block and the state are already saved, so it only flushes the
+// WAL. Once halted, consensus ignores messages, timeouts and blocks to ingest,
+// so that the node keeps serving the committed blocks to its peers but commits
+// no more.
+func (cs *State) haltAfterCommit(height int64, blockTime time.Time) bool {
+	halt := configHalt(cs.config, height, blockTime)
+	if upgrade := cs.blockExec.GetUpgrade(height); upgrade != nil {
+		halt = &Halt{Height: height, Reason: HaltReasonUpgrade, Upgrade: upgrade}
+	}
+	if halt == nil {
+		return false
+	}
+	if cs.halt == nil {
+		defer close(cs.halted)
+	}
+	cs.halt = halt
+
+	if err := cs.wal.FlushAndSync(); err != nil {
+		cs.Logger.Error("failed to flush WAL", "err", err)
+	}
+	cs.Logger.Info("consensus halted", "height", halt.Height, "reason", halt)
+	return true
+}
@@ -0,0 +1,101 @@
+package consensus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// upgradeApp requires an upgrade after the block of the given height.
+type upgradeApp struct {
+	*kvstore.Application
+	height int64
+}
+
+func (app *upgradeApp) FinalizeBlock(
+	ctx context.Context,
+	req *abci.RequestFinalizeBlock,
+) (*abci.ResponseFinalizeBlock, error) {
+	resp, err := app.Application.FinalizeBlock(ctx, req)
+	if err == nil && req.Height == app.height {
+		resp.Upgrade = &abci.Upgrade{Name: "v2"}
+	}
+	return resp, err
+}
+
+func TestStateHaltHeight(t *testing.T) {
+	cs, _ := randState(1)
+	cs.config.HaltHeight = 2
+	newBlockCh := subscribe(cs.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs, cs.Height, cs.Round)
+	ensureNewBlock(newBlockCh, 1)
+	ensureNewBlock(newBlockCh, 2)
+	ensureNoNewEventOnChannel(newBlockCh)
+
+	select {
+	case <-cs.Halted():
+	default:
+		t.Fatal("expected the halted channel to be closed")
+	}
+	assert.Equal(t, &Halt{Height: 2, Reason: HaltReasonHeight}, cs.GetHalt())
+	assert.EqualValues(t, 2, cs.GetState().LastBlockHeight)
+}
+
+func TestStateHaltUpgrade(t *testing.T) {
+	cs, _ := randStateWithApp(1, &upgradeApp{Application: kvstore.NewInMemoryApplication(), height: 1})
+	newBlockCh := subscribe(cs.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs, cs.Height, cs.Round)
+	ensureNewBlock(newBlockCh, 1)
+	ensureNoNewEventOnChannel(newBlockCh)
+
+	halt := cs.GetHalt()
+	require.NotNil(t, halt)
+	assert.Equal(t, HaltReasonUpgrade, halt.Reason)
+	assert.EqualValues(t, 1, halt.Height)
+	assert.Equal(t, "v2", halt.Upgrade.Name)
+}
+
+func TestCheckHalt(t *testing.T) {
+	config := cfg.TestConsensusConfig()
+	db := dbm.NewMemDB()
+	stateStore := sm.NewStore(db, sm.StoreOptions{})
+	state := sm.State{LastBlockHeight: 10, LastBlockTime: time.Unix(1000, 0)}
+
+	require.NoError(t, CheckHalt(config, db, stateStore, state, "1.0"))
+
+	// the application requires an upgrade after the next block
+	state.LastBlockHeight = 11
+	upgrade := &abci.Upgrade{Name: "v2"}
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(11, &abci.ResponseFinalizeBlock{Upgrade: upgrade}))
+	var errHalted ErrHalted
+	require.ErrorAs(t, CheckHalt(config, db, stateStore, state, "1.0"), &errHalted)
+	assert.Equal(t, Halt{Height: 11, Reason: HaltReasonUpgrade, Upgrade: upgrade}, errHalted.Halt)
+
+	// the upgraded application proceeds, also once restarted
+	require.NoError(t, CheckHalt(config, db, stateStore, state, "2.0"))
+	require.NoError(t, CheckHalt(config, db, stateStore, state, "2.0"))
+
+	config.HaltHeight = 11
+	require.ErrorAs(t, CheckHalt(config, db, stateStore, state, "2.0"), &errHalted)
+	assert.Equal(t, Halt{Height: 11, Reason: HaltReasonHeight}, errHalted.Halt)
+
+	config.HaltHeight = 12
+	require.NoError(t, CheckHalt(config, db, stateStore, state, "2.0"))
+
+	config.HaltTime = 1000
+	require.ErrorAs(t, CheckHalt(config, db, stateStore, state, "2.0"), &errHalted)
+	assert.Equal(t, Halt{Height: 11, Reason: HaltReasonTime}, errHalted.Halt)
+}
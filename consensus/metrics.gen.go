@@ -136,6 +136,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "duplicate_block_part",
 			Help:      "Number of times we received a duplicate block part",
 		}, labels).With(labelsAndValues...),
+		CompactBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks",
+			Help:      "Number of compact blocks received, by outcome: reconstructed if the block was reconstructed, fallback if the block parts are needed.",
+		}, append(labels, "outcome")).With(labelsAndValues...),
+		CompactBlockMissingTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_missing_txs",
+			Help:      "Number of transactions of the compact blocks missing from the mempool.",
+		}, labels).With(labelsAndValues...),
 		DuplicateVote: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -253,6 +265,8 @@ func NopMetrics() *Metrics {
 		CommittedHeight:             discard.NewGauge(),
 		BlockParts:                  discard.NewCounter(),
 		DuplicateBlockPart:          discard.NewCounter(),
+		CompactBlocks:               discard.NewCounter(),
+		CompactBlockMissingTxs:      discard.NewCounter(),
 		DuplicateVote:               discard.NewCounter(),
 		StepDurationSeconds:         discard.NewHistogram(),
 		BlockGossipPartsReceived:    discard.NewCounter(),
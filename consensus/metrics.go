@@ -70,6 +70,12 @@ type Metrics struct {
 	// Number of times we received a duplicate block part
 	DuplicateBlockPart metrics.Counter
 
+	// Number of compact blocks received, by outcome: reconstructed if the
+	// block was reconstructed, fallback if the block parts are needed.
+	CompactBlocks metrics.Counter `metrics_labels:"outcome"`
+	// Number of transactions of the compact blocks missing from the mempool.
+	CompactBlockMissingTxs metrics.Counter
+
 	// Number of times we received a duplicate vote
 	DuplicateVote metrics.Counter
 
@@ -110,6 +110,44 @@ func MsgToProto(msg Message) (proto.Message, error) {
 
 		pb = vsb
 
+	case *CompactBlockMessage:
+		block, err := msg.Block.ToProto()
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "CompactBlock", Err: err}
+		}
+		txKeys := make([][]byte, len(msg.TxKeys))
+		for i := range msg.TxKeys {
+			txKeys[i] = msg.TxKeys[i][:]
+		}
+		pb = &cmtcons.CompactBlock{
+			Height: msg.Height,
+			Round:  msg.Round,
+			Block:  *block,
+			TxKeys: txKeys,
+		}
+
+	case *CompactBlockTxsRequestMessage:
+		pb = &cmtcons.CompactBlockTxsRequest{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}
+
+	case *CompactBlockTxsMessage:
+		pb = &cmtcons.CompactBlockTxs{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     msg.Txs.ToSliceOfBytes(),
+		}
+
+	case *CompactBlockAckMessage:
+		pb = &cmtcons.CompactBlockAck{
+			Height:        msg.Height,
+			Round:         msg.Round,
+			Reconstructed: msg.Reconstructed,
+		}
+
 	default:
 		return nil, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -225,6 +263,50 @@ func MsgFromProto(p proto.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *cmtcons.CompactBlock:
+		block, err := compactBlockFromProto(&msg.Block)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "CompactBlock", Err: err}
+		}
+		txKeys := make([]types.TxKey, len(msg.TxKeys))
+		for i, key := range msg.TxKeys {
+			if len(key) != types.TxKeySize {
+				return nil, cmterrors.ErrMsgToProto{
+					MessageName: "CompactBlock",
+					Err:         fmt.Errorf("invalid tx key size %d, expected %d", len(key), types.TxKeySize),
+				}
+			}
+			txKeys[i] = types.TxKey(key)
+		}
+		pb = &CompactBlockMessage{
+			Height: msg.Height,
+			Round:  msg.Round,
+			Block:  block,
+			TxKeys: txKeys,
+		}
+	case *cmtcons.CompactBlockTxsRequest:
+		pb = &CompactBlockTxsRequestMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}
+	case *cmtcons.CompactBlockTxs:
+		txs := make(types.Txs, len(msg.Txs))
+		for i, tx := range msg.Txs {
+			txs[i] = tx
+		}
+		pb = &CompactBlockTxsMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     txs,
+		}
+	case *cmtcons.CompactBlockAck:
+		pb = &CompactBlockAckMessage{
+			Height:        msg.Height,
+			Round:         msg.Round,
+			Reconstructed: msg.Reconstructed,
+		}
 	default:
 		return nil, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -236,6 +318,31 @@ func MsgFromProto(p proto.Message) (Message, error) {
 	return pb, nil
 }
 
+// compactBlockFromProto converts the block of a compact block, which has no
+// transactions. Unlike types.BlockFromProto, it doesn't validate the block,
+// whose data hash only matches once the transactions are filled in.
+func compactBlockFromProto(bp *cmtproto.Block) (*types.Block, error) {
+	h, err := types.HeaderFromProto(&bp.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &types.Block{Header: h}
+	if err := b.Evidence.FromProto(&bp.Evidence); err != nil {
+		return nil, err
+	}
+
+	if bp.LastCommit != nil {
+		lc, err := types.CommitFromProto(bp.LastCommit)
+		if err != nil {
+			return nil, err
+		}
+		b.LastCommit = lc
+	}
+
+	return b, nil
+}
+
 // WALToProto takes a WAL message and return a proto walMessage and error
 func WALToProto(msg WALMessage) (*cmtcons.WALMessage, error) {
 	var pb cmtcons.WALMessage
@@ -287,6 +394,15 @@ func WALToProto(msg WALMessage) (*cmtcons.WALMessage, error) {
 				},
 			},
 		}
+	case CheckpointMarkerMessage:
+		pb = cmtcons.WALMessage{
+			Sum: &cmtcons.WALMessage_CheckpointMarker{
+				CheckpointMarker: &cmtcons.CheckpointMarker{
+					Height:   msg.Height,
+					Sequence: msg.Sequence,
+				},
+			},
+		}
 	default:
 		return nil, fmt.Errorf("to proto: wal message not recognized: %T", msg)
 	}
@@ -340,6 +456,12 @@ func WALFromProto(msg *cmtcons.WALMessage) (WALMessage, error) {
 			Height: msg.EndHeight.Height,
 		}
 		return pb, nil
+	case *cmtcons.WALMessage_CheckpointMarker:
+		pb := CheckpointMarkerMessage{
+			Height:   msg.CheckpointMarker.Height,
+			Sequence: msg.CheckpointMarker.Sequence,
+		}
+		return pb, nil
 	default:
 		return nil, fmt.Errorf("from proto: wal message not recognized: %T", msg)
 	}
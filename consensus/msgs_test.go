@@ -290,6 +290,17 @@ func TestWALMsgProto(t *testing.T) {
 				},
 			},
 		}, false},
+		{"successful CheckpointMarkerMessage", CheckpointMarkerMessage{
+			Height:   1,
+			Sequence: 2,
+		}, &cmtcons.WALMessage{
+			Sum: &cmtcons.WALMessage_CheckpointMarker{
+				CheckpointMarker: &cmtcons.CheckpointMarker{
+					Height:   1,
+					Sequence: 2,
+				},
+			},
+		}, false},
 		{"failure", nil, &cmtcons.WALMessage{}, true},
 	}
 	for _, tt := range testsCases {
@@ -2,6 +2,7 @@ package consensus
 
 import (
 	"fmt"
+	"hash/fnv"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -28,6 +29,9 @@ const (
 	DataChannel        = byte(0x21)
 	VoteChannel        = byte(0x22)
 	VoteSetBitsChannel = byte(0x23)
+	// CompactBlockChannel is only advertised if compact blocks are enabled
+	// (see WithCompactBlocks).
+	CompactBlockChannel = byte(0x24)
 
 	maxMsgSize = 1048576 // 1MB; NOTE/TODO: keep in sync with types.PartSet sizes.
 
@@ -52,6 +56,8 @@ type Reactor struct {
 
 	consensusParams atomic.Pointer[types.ConsensusParams] // copy of latest blocks consensus params
 
+	compactBlocks *compactBlocks // nil if compact blocks are disabled
+
 	Metrics *Metrics
 }
 
@@ -159,7 +165,7 @@ conR:
 // GetChannels implements Reactor
 func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	// TODO optimize
-	return []*p2p.ChannelDescriptor{
+	channels := []*p2p.ChannelDescriptor{
 		{
 			ID:                  StateChannel,
 			Priority:            6,
@@ -193,6 +199,19 @@ func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			MessageType:         &cmtcons.Message{},
 		},
 	}
+
+	if conR.compactBlocks != nil {
+		channels = append(channels, &p2p.ChannelDescriptor{
+			ID:                  CompactBlockChannel,
+			Priority:            10,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		})
+	}
+
+	return channels
 }
 
 // InitPeer implements Reactor by creating a state for the peer.
@@ -402,6 +421,40 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
 
+	case CompactBlockChannel:
+		if conR.compactBlocks == nil {
+			conR.Logger.Error("Received compact block message while compact blocks are disabled", "src", e.Src)
+			return
+		}
+		if conR.WaitSync() {
+			conR.Logger.Info("Ignoring message received during sync", "msg", msg)
+			return
+		}
+		switch msg := msg.(type) {
+		case *CompactBlockMessage:
+			if rs := conR.getRoundState(); rs.Height != msg.Height || rs.Round != msg.Round {
+				conR.Logger.Debug("Ignoring compact block for another round", "src", e.Src,
+					"height", msg.Height, "round", msg.Round, "curHeight", rs.Height, "curRound", rs.Round)
+				return
+			}
+			pending, ok := conR.compactBlocks.start(e.Src.ID(), msg)
+			if !ok {
+				conR.Logger.Debug("Ignoring compact block while another one of the peer is reconstructed",
+					"src", e.Src, "height", msg.Height, "round", msg.Round)
+				return
+			}
+			go conR.handleCompactBlock(e.Src, ps, msg, pending)
+		case *CompactBlockTxsRequestMessage:
+			conR.sendCompactBlockTxs(e.Src, msg)
+		case *CompactBlockTxsMessage:
+			conR.compactBlocks.addTxs(e.Src.ID(), msg)
+		case *CompactBlockAckMessage:
+			ps.ApplyCompactBlockAckMessage(msg)
+		default:
+			// don't punish (leave room for soft upgrades)
+			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		}
+
 	default:
 		conR.Logger.Error(fmt.Sprintf("Unknown chId %X", e.ChannelID))
 	}
@@ -620,6 +673,16 @@ OUTER_LOOP:
 		rs := conR.getRoundState()
 		prs := ps.GetRoundState()
 
+		// --------------------
+		// Send compact block?
+		// (The peer doesn't need the block parts while reconstructing it)
+		// --------------------
+
+		if conR.compactBlocks != nil && conR.gossipCompactBlock(logger, ps, &rs, prs) {
+			time.Sleep(conR.conS.config.PeerGossipSleepDuration)
+			continue OUTER_LOOP
+		}
+
 		// --------------------
 		// Send block part?
 		// (Note these can match on hash so round doesn't matter)
@@ -815,7 +878,14 @@ func pickPartToSend(
 ) (*types.Part, bool) {
 	// If peer has same part set header as us, send block parts
 	if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
-		if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(); ok {
+		missing := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy())
+		if rs.ProposalBlockParts.IsErasureCoded() {
+			if index, ok := pickErasureCodedPart(rs.ProposalBlockParts, missing, ps.partOffset()); ok {
+				part := rs.ProposalBlockParts.GetPart(index)
+				// If sending this part fails, restart the OUTER_LOOP (busy-waiting).
+				return part, true
+			}
+		} else if index, ok := missing.PickRandom(); ok {
 			part := rs.ProposalBlockParts.GetPart(index)
 			// If sending this part fails, restart the OUTER_LOOP (busy-waiting).
 			return part, true
@@ -851,6 +921,22 @@ func pickPartToSend(
 	return nil, false
 }
 
+// pickErasureCodedPart picks the part of an erasure coded part set to send to
+// a peer. It picks the first part the peer is missing from the given offset
+// on, so that different peers are sent different parts and relay them to each
+// other, and can reconstruct the block from the first parts they receive.
+// The remaining parts are still sent until the peer reports it has the block,
+// since it may not decode erasure coded parts.
+func pickErasureCodedPart(partSet *types.PartSet, missing *bits.BitArray, offset uint32) (int, bool) {
+	total := int(partSet.Total())
+	for i := 0; i < total; i++ {
+		if index := int((offset + uint32(i)) % uint32(total)); missing.GetIndex(index) {
+			return index, true
+		}
+	}
+	return 0, false
+}
+
 func pickPartForCatchup(
 	logger log.Logger,
 	rs *cstypes.RoundState,
@@ -1086,6 +1172,8 @@ type PeerState struct {
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
+
+	compactBlock compactBlockPeerState // last compact block sent to the peer
 }
 
 // peerStateStats holds internal statistics for a peer.
@@ -1221,6 +1309,17 @@ func (ps *PeerState) PickSendVote(votes types.VoteSetReader) bool {
 	return false
 }
 
+// partOffset returns the index from which erasure coded block parts are sent
+// to the peer, derived from its ID so that it differs between peers.
+func (ps *PeerState) partOffset() uint32 {
+	if ps.peer == nil {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(ps.peer.ID()))
+	return h.Sum32()
+}
+
 // SendPartSetHasPart sends the part to the peer.
 // Returns true and marks the peer as having the part if the part was sent.
 func (ps *PeerState) SendPartSetHasPart(part *types.Part, prs *cstypes.PeerRoundState) bool {
@@ -1667,6 +1766,10 @@ func init() {
 	cmtjson.RegisterType(&HasVoteMessage{}, "tendermint/HasVote")
 	cmtjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	cmtjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	cmtjson.RegisterType(&CompactBlockMessage{}, "tendermint/CompactBlock")
+	cmtjson.RegisterType(&CompactBlockTxsRequestMessage{}, "tendermint/CompactBlockTxsRequest")
+	cmtjson.RegisterType(&CompactBlockTxsMessage{}, "tendermint/CompactBlockTxs")
+	cmtjson.RegisterType(&CompactBlockAckMessage{}, "tendermint/CompactBlockAck")
 }
 
 //-------------------------------------
@@ -2013,3 +2116,140 @@ func (m *HasProposalBlockPartMessage) ValidateBasic() error {
 func (m *HasProposalBlockPartMessage) String() string {
 	return fmt.Sprintf("[HasProposalBlockPart PI:%v HR:{%v/%02d}]", m.Index, m.Height, m.Round)
 }
+
+//-------------------------------------
+
+// maxCompactBlockTxs is the maximum number of transactions of a block. Each
+// transaction takes at least 2 bytes (its field tag and length prefix) of a
+// block of at most types.MaxBlockSizeBytes.
+const maxCompactBlockTxs = types.MaxBlockSizeBytes / 2
+
+// CompactBlockMessage is sent instead of the proposal block parts to the
+// peers supporting compact blocks. The block is sent without its
+// transactions, which are identified by their keys (in order).
+type CompactBlockMessage struct {
+	Height int64
+	Round  int32
+	Block  *types.Block
+	TxKeys []types.TxKey
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return cmterrors.ErrNegativeField{Field: "Height"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if m.Block == nil {
+		return cmterrors.ErrRequiredField{Field: "Block"}
+	}
+	if m.Block.Height != m.Height {
+		return cmterrors.ErrInvalidField{Field: "Block", Reason: "height does not match the message height"}
+	}
+	if len(m.Block.Txs) != 0 {
+		return cmterrors.ErrInvalidField{Field: "Block", Reason: "must not contain transactions"}
+	}
+	if len(m.TxKeys) > maxCompactBlockTxs {
+		return cmterrors.ErrInvalidField{
+			Field:  "TxKeys",
+			Reason: fmt.Sprintf("must not exceed %d", maxCompactBlockTxs),
+		}
+	}
+	if err := m.Block.Header.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "Block", Err: err}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockMessage) String() string {
+	return fmt.Sprintf("[CompactBlock H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.TxKeys))
+}
+
+//-------------------------------------
+
+// CompactBlockTxsRequestMessage is sent to request the transactions of a
+// compact block missing from the mempool.
+type CompactBlockTxsRequestMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsRequestMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return cmterrors.ErrNegativeField{Field: "Height"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if len(m.Indexes) == 0 {
+		return cmterrors.ErrRequiredField{Field: "Indexes"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsRequestMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxsRequest H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.Indexes))
+}
+
+//-------------------------------------
+
+// CompactBlockTxsMessage is sent in response to a
+// CompactBlockTxsRequestMessage.
+type CompactBlockTxsMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+	Txs     types.Txs
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return cmterrors.ErrNegativeField{Field: "Height"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if len(m.Indexes) != len(m.Txs) {
+		return cmterrors.ErrInvalidField{Field: "Txs", Reason: "must have as many elements as Indexes"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxs H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.Txs))
+}
+
+//-------------------------------------
+
+// CompactBlockAckMessage is sent once a compact block was processed. If the
+// block could not be reconstructed, the peer falls back to sending the block
+// parts.
+type CompactBlockAckMessage struct {
+	Height        int64
+	Round         int32
+	Reconstructed bool
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockAckMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return cmterrors.ErrNegativeField{Field: "Height"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockAckMessage) String() string {
+	return fmt.Sprintf("[CompactBlockAck H:%v R:%v Reconstructed:%v]", m.Height, m.Round, m.Reconstructed)
+}
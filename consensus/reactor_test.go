@@ -46,7 +46,9 @@ import (
 
 var defaultTestTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
 
-func startConsensusNet(t *testing.T, css []*State, n int) (
+// startConsensusNet starts a testnet of the given consensus states.
+// The reactor options are derived from each node's consensus state.
+func startConsensusNet(t *testing.T, css []*State, n int, reactorOptions ...func(*State) ReactorOption) (
 	[]*Reactor,
 	[]types.Subscription,
 	[]*types.EventBus,
@@ -57,7 +59,11 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	for i := 0; i < n; i++ {
 		/*logger, err := cmtflags.ParseLogLevel("consensus:info,*:error", logger, "info")
 		if err != nil {	t.Fatal(err)}*/
-		reactors[i] = NewReactor(css[i], true) // so we dont start the consensus states
+		options := make([]ReactorOption, 0, len(reactorOptions))
+		for _, option := range reactorOptions {
+			options = append(options, option(css[i]))
+		}
+		reactors[i] = NewReactor(css[i], true, options...) // so we dont start the consensus states
 		reactors[i].SetLogger(css[i].Logger)
 
 		// eventBus is already started with the cs
@@ -122,6 +128,74 @@ func TestReactorBasic(t *testing.T) {
 	})
 }
 
+// Ensure a testnet makes blocks with erasure coded proposals.
+func TestReactorErasureCodedParts(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(t, N, "consensus_reactor_test", newMockTickerFunc(true), newKVStore)
+	defer cleanup()
+	for _, cs := range css {
+		cs.config.ErasureCodedParts = true
+	}
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+	// wait till everyone makes the first new block
+	timeoutWaitGroup(N, func(j int) {
+		<-blocksSubs[j].Out()
+	})
+
+	for _, cs := range css {
+		// one data part and one parity part
+		blockMeta := cs.blockStore.LoadBlockMeta(1)
+		require.EqualValues(t, 2, blockMeta.BlockID.PartSetHeader.Total)
+		require.NotNil(t, cs.blockStore.LoadBlock(1))
+	}
+}
+
+func TestPickErasureCodedPart(t *testing.T) {
+	partSet, err := types.NewErasureCodedPartSetFromData(cmtrand.Bytes(960), 256, 5)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, partSet.DataParts())
+	require.EqualValues(t, 10, partSet.Total())
+
+	pick := func(peerParts *bits.BitArray, offset uint32) (int, bool) {
+		missing := partSet.BitArray().Sub(peerParts)
+		return pickErasureCodedPart(partSet, missing, offset)
+	}
+
+	// peers with different offsets are sent different parts
+	peerParts := bits.NewBitArray(10)
+	index, ok := pick(peerParts, 0)
+	require.True(t, ok)
+	require.Equal(t, 0, index)
+
+	index, ok = pick(peerParts, 17)
+	require.True(t, ok)
+	require.Equal(t, 7, index)
+
+	// the first missing part from the offset on, wrapping around
+	peerParts.SetIndex(7, true)
+	peerParts.SetIndex(8, true)
+	peerParts.SetIndex(9, true)
+	index, ok = pick(peerParts, 17)
+	require.True(t, ok)
+	require.Equal(t, 0, index)
+
+	// the remaining parts are still sent once the peer has enough parts to
+	// reconstruct the data, in case it doesn't decode them
+	peerParts.SetIndex(0, true)
+	peerParts.SetIndex(4, true)
+	index, ok = pick(peerParts, 17)
+	require.True(t, ok)
+	require.Equal(t, 1, index)
+
+	// nothing to send once the peer has the block
+	for i := 0; i < 10; i++ {
+		peerParts.SetIndex(i, true)
+	}
+	_, ok = pick(peerParts, 17)
+	require.False(t, ok)
+}
+
 // Ensure we can process blocks with evidence
 func TestReactorWithEvidence(t *testing.T) {
 	nValidators := 4
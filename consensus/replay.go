@@ -38,7 +38,8 @@ var crc32c = crc32.MakeTable(crc32.Castagnoli)
 // NOTE: receiveRoutine should not be running.
 func (cs *State) readReplayMessage(msg *TimedWALMessage, newStepSub types.Subscription) error {
 	// Skip meta messages which exist for demarcating boundaries.
-	if _, ok := msg.Msg.(EndHeightMessage); ok {
+	switch msg.Msg.(type) {
+	case EndHeightMessage, CheckpointMarkerMessage:
 		return nil
 	}
 
@@ -136,7 +137,11 @@ func (cs *State) catchupReplay(csHeight int64) error {
 	}
 	defer gr.Close()
 
-	cs.Logger.Info("Catchup by replaying consensus messages", "height", csHeight)
+	// Resume the height from its latest checkpoint, if any, and skip the
+	// messages written to the WAL before it.
+	skip := cs.restoreLatestCheckpoint(csHeight, endHeight)
+
+	cs.Logger.Info("Catchup by replaying consensus messages", "height", csHeight, "skipped", skip)
 
 	var msg *TimedWALMessage
 	dec := WALDecoder{rd: gr}
@@ -153,6 +158,10 @@ LOOP:
 		case err != nil:
 			return err
 		}
+		if skip > 0 {
+			skip--
+			continue
+		}
 
 		// NOTE: since the priv key is set when the msgs are received
 		// it will attempt to eg double sign but we can just ignore it
@@ -206,6 +215,8 @@ type Handshaker struct {
 	logger       log.Logger
 
 	nBlocks int // number of blocks applied to the state
+
+	appVersion string // software version of the app, as reported by Info
 }
 
 func NewHandshaker(stateStore sm.Store, state sm.State,
@@ -237,6 +248,12 @@ func (h *Handshaker) NBlocks() int {
 	return h.nBlocks
 }
 
+// AppVersion returns the software version of the app, as reported by Info
+// during the handshake.
+func (h *Handshaker) AppVersion() string {
+	return h.appVersion
+}
+
 // TODO: retry the handshake/replay if it fails ?
 func (h *Handshaker) Handshake(proxyApp proxy.AppConns) error {
 	return h.HandshakeWithContext(context.TODO(), proxyApp)
@@ -250,6 +267,8 @@ func (h *Handshaker) HandshakeWithContext(ctx context.Context, proxyApp proxy.Ap
 		return fmt.Errorf("error calling Info: %v", err)
 	}
 
+	h.appVersion = res.Version
+
 	blockHeight := res.LastBlockHeight
 	if blockHeight < 0 {
 		return fmt.Errorf("got a negative last block height (%d) from the app", blockHeight)
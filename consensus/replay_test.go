@@ -156,6 +156,14 @@ func TestWALCrash(t *testing.T) {
 	}
 }
 
+// TestWALCrashWithCheckpoints checks that the consensus state resumes from its
+// checkpoints after any WAL failure, including while writing their markers.
+func TestWALCrashWithCheckpoints(t *testing.T) {
+	consensusReplayConfig := ResetConfig(t.Name())
+	consensusReplayConfig.Consensus.CheckpointInterval = time.Nanosecond
+	crashWALandCheckLiveness(t, consensusReplayConfig, func(dbm.DB, *State, context.Context) {}, 2)
+}
+
 // TestWALCrashOnInternalBlockPartWrite checks liveness after a crash on
 // internal BlockPartMessage Write.
 func TestWALCrashOnInternalBlockPartWrite(t *testing.T) {
@@ -1311,6 +1319,15 @@ func (bs *mockBlockStore) PruneBlocks(height int64, _ sm.State) (uint64, int64,
 	return pruned, evidencePoint, nil
 }
 
+func (bs *mockBlockStore) PruneExtendedCommits(height int64) (uint64, error) {
+	pruned := uint64(0)
+	for i := int64(0); i < height-1; i++ {
+		bs.extCommits[i] = nil
+		pruned++
+	}
+	return pruned, nil
+}
+
 func (bs *mockBlockStore) DeleteLatestBlock() error { return nil }
 func (bs *mockBlockStore) Close() error             { return nil }
 
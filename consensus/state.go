@@ -13,6 +13,8 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	cfg "github.com/cometbft/cometbft/config"
 	cstypes "github.com/cometbft/cometbft/consensus/types"
 	"github.com/cometbft/cometbft/crypto"
@@ -135,6 +137,22 @@ type State struct {
 
 	// offline state sync height indicating to which height the node synced offline
 	offlineStateSyncHeight int64
+
+	// the clock of the node, see SetClock
+	now func() time.Time
+
+	// the timelines of the last heights, see GetHeightTraceJSON
+	traces *cstypes.TraceRecorder
+
+	// the database the checkpoints of the consensus state are saved to, see
+	// CheckpointDB, the sequence of the latest one and when it was taken
+	checkpointDB   dbm.DB
+	checkpointSeq  uint64
+	lastCheckpoint time.Time
+
+	// set once consensus halted, see haltAfterCommit, and closed channel
+	halt   *Halt
+	halted chan struct{}
 }
 
 // StateOption sets an optional parameter on the State.
@@ -160,11 +178,14 @@ func NewState(
 		timeoutTicker:    NewTimeoutTicker(),
 		statsMsgQueue:    make(chan msgInfo, msgQueueSize),
 		done:             make(chan struct{}),
+		halted:           make(chan struct{}),
 		doWALCatchup:     true,
 		wal:              nilWAL{},
 		evpool:           evpool,
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		now:              cmttime.Now,
+		traces:           cstypes.NewTraceRecorder(config.TraceHeights, maxTraceEvents),
 	}
 	for _, option := range options {
 		option(cs)
@@ -209,6 +230,16 @@ func (cs *State) SetEventBus(b *types.EventBus) {
 	cs.blockExec.SetEventBus(b)
 }
 
+// SetClock sets the clock of the node, used for the timing of rounds and
+// votes, and to validate the time of proposed blocks. It may be useful to
+// overwrite to simulate clock skew.
+func (cs *State) SetClock(now func() time.Time) {
+	cs.mtx.Lock()
+	cs.now = now
+	cs.mtx.Unlock()
+	cs.blockExec.SetClock(now)
+}
+
 // StateMetrics sets the metrics.
 func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
@@ -391,8 +422,17 @@ func (cs *State) OnStart() error {
 		return err
 	}
 
+	// The latest block may have been committed by blocksync, or before a
+	// restart with an unchanged config.
+	cs.mtx.Lock()
+	halted := cs.state.LastBlockHeight > 0 && cs.haltAfterCommit(cs.state.LastBlockHeight, cs.state.LastBlockTime)
+	cs.mtx.Unlock()
+
 	// now start the receiveRoutine
 	go cs.receiveRoutine(0)
+	if halted {
+		return nil
+	}
 
 	// schedule the first round!
 	// use GetRoundState so we don't race the receiveRoutine for access
@@ -552,8 +592,8 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 
 // enterNewRound(height, 0) at cs.StartTime.
 func (cs *State) scheduleRound0(rs *cstypes.RoundState) {
-	// cs.Logger.Info("scheduleRound0", "now", cmttime.Now(), "startTime", cs.StartTime)
-	sleepDuration := rs.StartTime.Sub(cmttime.Now())
+	// cs.Logger.Info("scheduleRound0", "now", cs.now(), "startTime", cs.StartTime)
+	sleepDuration := rs.StartTime.Sub(cs.now())
 	cs.scheduleTimeout(sleepDuration, rs.Height, 0, cstypes.RoundStepNewHeight)
 }
 
@@ -683,7 +723,8 @@ func (cs *State) updateToState(state sm.State) {
 	}
 
 	// Reset fields based on state.
-	validators := state.Validators
+	validators := state.Validators.Copy()
+	validators.SetProposer(state.ProposerSelector(), 0)
 
 	switch {
 	case state.LastBlockHeight == 0: // Very first commit should be empty.
@@ -723,9 +764,9 @@ func (cs *State) updateToState(state sm.State) {
 		// to be gathered for the first block.
 		// And alternative solution that relies on clocks:
 		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(cmttime.Now())
+		cs.StartTime = cs.timeouts(height, state.ConsensusParams, validators).CommitTime(cs.now())
 	} else {
-		cs.StartTime = cs.config.Commit(cs.CommitTime)
+		cs.StartTime = cs.timeouts(height, state.ConsensusParams, validators).CommitTime(cs.CommitTime)
 	}
 
 	cs.Validators = validators
@@ -743,6 +784,7 @@ func (cs *State) updateToState(state sm.State) {
 	} else {
 		cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, validators)
 	}
+	cs.Votes.SetSignatureCache(cs.blockExec.SignatureCache())
 	cs.CommitRound = -1
 	cs.LastValidators = state.LastValidators
 	cs.TriggeredTimeoutPrecommit = false
@@ -761,6 +803,7 @@ func (cs *State) newStep() {
 	}
 
 	cs.nSteps++
+	cs.recordTrace(cs.Height, cstypes.TraceEvent{Type: cstypes.TraceEventStep, Round: cs.Round, Step: cs.Step.String()})
 
 	// newStep is called by updateToState in NewState before the eventBus is set!
 	if cs.eventBus != nil {
@@ -874,6 +917,8 @@ func (cs *State) receiveRoutine(maxSteps int) {
 			onExit(cs)
 			return
 		}
+
+		cs.checkpoint()
 	}
 }
 
@@ -909,12 +954,20 @@ func (cs *State) handleMsg(mi msgInfo) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	// consensus halted, see haltAfterCommit
+	if cs.halt != nil {
+		return
+	}
+
 	var (
 		added bool
 		err   error
 	)
 
 	msg, peerID := mi.Msg, mi.PeerID
+	// the arrival time of the message, as handling it may take long, e.g. if
+	// it completes a commit
+	received := cs.now()
 
 	cs.Logger.Debug("State.handleMsg", "peer_id", string(peerID), "msg", msg)
 
@@ -923,10 +976,27 @@ func (cs *State) handleMsg(mi msgInfo) {
 		// will not cause transition.
 		// once proposal is set, we can receive block parts
 		err = cs.setProposal(msg.Proposal)
+		cs.recordTrace(msg.Proposal.Height, cstypes.TraceEvent{
+			Time:  received,
+			Type:  cstypes.TraceEventProposal,
+			Round: msg.Proposal.Round,
+			Peer:  string(peerID),
+			Added: err == nil,
+			Error: errString(err),
+		})
 
 	case *BlockPartMessage:
 		// if the proposal is complete, we'll enterPrevote or tryFinalizeCommit
 		added, err = cs.addProposalBlockPart(msg, peerID)
+		cs.recordTrace(msg.Height, cstypes.TraceEvent{
+			Time:  received,
+			Type:  cstypes.TraceEventBlockPart,
+			Round: msg.Round,
+			Peer:  string(peerID),
+			Index: int32(msg.Part.Index),
+			Added: added,
+			Error: errString(err),
+		})
 
 		// We unlock here to yield to any routines that need to read the RoundState.
 		// Previously, this code held the lock from the point at which the final block
@@ -955,6 +1025,17 @@ func (cs *State) handleMsg(mi msgInfo) {
 		// attempt to add the vote and dupeout the validator if it's a duplicate signature
 		// if the vote gives us a 2/3-any or 2/3-one, we transition
 		added, err = cs.tryAddVote(msg.Vote, peerID)
+		cs.recordTrace(msg.Vote.Height, cstypes.TraceEvent{
+			Time:      received,
+			Type:      cstypes.TraceEventVote,
+			Round:     msg.Vote.Round,
+			Peer:      string(peerID),
+			VoteType:  types.SignedMsgTypeToShortString(msg.Vote.Type),
+			Validator: msg.Vote.ValidatorAddress,
+			Index:     msg.Vote.ValidatorIndex,
+			Added:     added,
+			Error:     errString(err),
+		})
 
 		// if err == ErrAddingVote {
 		// TODO: punish peer
@@ -1009,6 +1090,17 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	if cs.halt != nil {
+		return
+	}
+
+	cs.recordTrace(ti.Height, cstypes.TraceEvent{
+		Type:     cstypes.TraceEventTimeout,
+		Round:    ti.Round,
+		Step:     ti.Step.String(),
+		Duration: ti.Duration,
+	})
+
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
 		// NewRound event fired from enterNewRound.
@@ -1051,7 +1143,7 @@ func (cs *State) handleTxsAvailable() {
 	defer cs.mtx.Unlock()
 
 	// We only need to do this for round 0.
-	if cs.Round != 0 {
+	if cs.Round != 0 || cs.halt != nil {
 		return
 	}
 
@@ -1063,7 +1155,7 @@ func (cs *State) handleTxsAvailable() {
 		}
 
 		// +1ms to ensure RoundStepNewRound timeout always happens after RoundStepNewHeight
-		timeoutCommit := cs.StartTime.Sub(cmttime.Now()) + 1*time.Millisecond
+		timeoutCommit := cs.StartTime.Sub(cs.now()) + 1*time.Millisecond
 		cs.scheduleTimeout(timeoutCommit, cs.Height, 0, cstypes.RoundStepNewRound)
 
 	case cstypes.RoundStepNewRound: // after timeoutCommit
@@ -1077,7 +1169,7 @@ func (cs *State) handleTxsAvailable() {
 
 // Enter: `timeoutNewHeight` by startTime (commitTime+timeoutCommit),
 //
-//	or, if SkipTimeoutCommit==true, after receiving all precommits from (height,round-1)
+//	or, if BypassCommitTimeout==true, after receiving all precommits from (height,round-1)
 //
 // Enter: `timeoutPrecommits` after any +2/3 precommits from (height,round-1)
 // Enter: +2/3 precommits for nil at (height,round-1)
@@ -1094,7 +1186,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
-	if now := cmttime.Now(); cs.StartTime.After(now) {
+	if now := cs.now(); cs.StartTime.After(now) {
 		logger.Debug("need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
 
@@ -1105,6 +1197,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	if cs.Round < round {
 		validators = validators.Copy()
 		validators.IncrementProposerPriority(cmtmath.SafeSubInt32(round, cs.Round))
+		validators.SetProposer(cs.state.ProposerSelector(), round)
 	}
 
 	// Setup new round
@@ -1201,7 +1294,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.roundTimeouts().ProposeTimeout(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1257,7 +1350,7 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 			panic("Method createProposalBlock should not provide a nil block without errors")
 		}
 		cs.metrics.ProposalCreateCount.Add(1)
-		blockParts, err = block.MakePartSet(types.BlockPartSizeBytes)
+		blockParts, err = cs.makeProposalBlockParts(block)
 		if err != nil {
 			cs.Logger.Error("unable to create proposal block part set", "error", err)
 			return
@@ -1291,6 +1384,32 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	}
 }
 
+// roundTimeouts returns the timeouts of the rounds of the current height.
+func (cs *State) roundTimeouts() types.TimeoutParams {
+	return cs.timeouts(cs.Height, cs.state.ConsensusParams, cs.Validators)
+}
+
+// timeouts returns the timeouts of the rounds at the given height: the timeout
+// consensus params once enabled, if this node is one of the validators, and the
+// timeouts of the config otherwise, so that they only override the consensus
+// params on the other nodes.
+func (cs *State) timeouts(height int64, params types.ConsensusParams, validators *types.ValidatorSet) types.TimeoutParams {
+	if params.Feature.TimeoutParamsEnabled(height) &&
+		cs.privValidatorPubKey != nil && validators.HasAddress(cs.privValidatorPubKey.Address()) {
+		return params.Timeout
+	}
+	return types.TimeoutParams{
+		Propose:             cs.config.TimeoutPropose,
+		ProposeDelta:        cs.config.TimeoutProposeDelta,
+		Prevote:             cs.config.TimeoutPrevote,
+		PrevoteDelta:        cs.config.TimeoutPrevoteDelta,
+		Precommit:           cs.config.TimeoutPrecommit,
+		PrecommitDelta:      cs.config.TimeoutPrecommitDelta,
+		Commit:              cs.config.TimeoutCommit,
+		BypassCommitTimeout: cs.config.SkipTimeoutCommit,
+	}
+}
+
 // Returns true if the proposal block is complete &&
 // (if POLRound was proposed, we have +2/3 prevotes from there).
 func (cs *State) isProposalComplete() bool {
@@ -1342,13 +1461,37 @@ func (cs *State) createProposalBlock(ctx context.Context) (*types.Block, error)
 
 	proposerAddr := cs.privValidatorPubKey.Address()
 
+	start := cs.now()
 	ret, err := cs.blockExec.CreateProposalBlock(ctx, cs.Height, cs.state, lastExtCommit, proposerAddr)
+	cs.traceABCI(cs.Height, cs.Round, "prepare_proposal", start)
 	if err != nil {
 		panic(err)
 	}
 	return ret, nil
 }
 
+// makeProposalBlockParts returns the parts of the block to propose, erasure
+// coded if enabled. There are as many parity parts as data parts, within the
+// number of parts a proposal may have; the parts are not erasure coded if
+// there's no room for parity parts.
+func (cs *State) makeProposalBlockParts(block *types.Block) (*types.PartSet, error) {
+	if !cs.config.ErasureCodedParts {
+		return block.MakePartSet(types.BlockPartSizeBytes)
+	}
+
+	maxBytes := cs.state.ConsensusParams.Block.MaxBytes
+	if maxBytes == -1 {
+		maxBytes = int64(types.MaxBlockSizeBytes)
+	}
+	maxParts := uint32((maxBytes-1)/int64(types.BlockPartSizeBytes) + 1)
+
+	dataParts := types.ErasureCodedDataParts(block.Size(), types.BlockPartSizeBytes)
+	if dataParts >= maxParts {
+		return block.MakePartSet(types.BlockPartSizeBytes)
+	}
+	return block.MakeErasureCodedPartSet(types.BlockPartSizeBytes, min(dataParts, maxParts-dataParts))
+}
+
 // Enter: `timeoutPropose` after entering Propose.
 // Enter: proposal block and POL is ready.
 // Prevote for LockedBlock if we're locked, or ProposalBlock if valid.
@@ -1416,7 +1559,9 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		Please see `PrepareProosal`-`ProcessProposal` coherence and determinism properties
 		in the ABCI++ specification.
 	*/
+	start := cs.now()
 	isAppValid, err := cs.blockExec.ProcessProposal(cs.ProposalBlock, cs.state)
+	cs.traceABCI(height, round, "process_proposal", start)
 	if err != nil {
 		panic(fmt.Sprintf(
 			"state machine returned an error (%v) when calling ProcessProposal", err,
@@ -1432,6 +1577,12 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		return
 	}
 
+	if cs.config.OptimisticExecution {
+		// execute the block while the votes are gathered, in case it is decided
+		blockID := types.BlockID{Hash: cs.ProposalBlock.Hash(), PartSetHeader: cs.ProposalBlockParts.Header()}
+		cs.blockExec.ExecuteBlockOptimistically(cs.state, blockID, cs.ProposalBlock)
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1467,7 +1618,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.roundTimeouts().PrevoteTimeout(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1626,7 +1777,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.roundTimeouts().PrecommitTimeout(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block
@@ -1648,7 +1799,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		// keep cs.Round the same, commitRound points to the right Precommits set.
 		cs.updateRoundStep(cs.Round, cstypes.RoundStepCommit)
 		cs.CommitRound = commitRound
-		cs.CommitTime = cmttime.Now()
+		cs.CommitTime = cs.now()
 		cs.newStep()
 
 		// Maybe finalize immediately.
@@ -1770,6 +1921,7 @@ func (cs *State) finalizeCommit(height int64) {
 		seenExtendedCommit := cs.Votes.Precommits(cs.CommitRound).MakeExtendedCommit(cs.state.ConsensusParams.ABCI)
 		if cs.state.ConsensusParams.ABCI.VoteExtensionsEnabled(block.Height) {
 			cs.blockStore.SaveBlockWithExtendedCommit(block, blockParts, seenExtendedCommit)
+			cs.publishExtendedCommit(seenExtendedCommit)
 		} else {
 			cs.blockStore.SaveBlock(block, blockParts, seenExtendedCommit.ToCommit())
 		}
@@ -1809,6 +1961,7 @@ func (cs *State) finalizeCommit(height int64) {
 	// Execute and commit the block, update and save the state, and update the mempool.
 	// We use apply verified block here because we have verified the block in this function already.
 	// NOTE The block.AppHash won't reflect these txs until the next block.
+	start := cs.now()
 	stateCopy, err := cs.blockExec.ApplyVerifiedBlock(
 		stateCopy,
 		types.BlockID{
@@ -1817,6 +1970,7 @@ func (cs *State) finalizeCommit(height int64) {
 		},
 		block,
 	)
+	cs.traceABCI(height, cs.CommitRound, "finalize_block", start)
 	if err != nil {
 		panic(fmt.Sprintf("failed to apply block; error %v", err))
 	}
@@ -1836,6 +1990,10 @@ func (cs *State) finalizeCommit(height int64) {
 		logger.Error("failed to get private validator pubkey", "err", err)
 	}
 
+	if cs.haltAfterCommit(block.Height, block.Time) {
+		return
+	}
+
 	// cs.StartTime is already set.
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
@@ -1846,6 +2004,15 @@ func (cs *State) finalizeCommit(height int64) {
 	// * cs.StartTime is set to when we will start round0.
 }
 
+// publishExtendedCommit publishes the extended commit of a height once it is
+// saved to the block store.
+func (cs *State) publishExtendedCommit(extCommit *types.ExtendedCommit) {
+	data := types.EventDataExtendedCommit{Height: extCommit.Height, ExtendedCommit: extCommit}
+	if err := cs.eventBus.PublishEventExtendedCommit(data); err != nil {
+		cs.Logger.Error("failed publishing extended commit", "height", extCommit.Height, "err", err)
+	}
+}
+
 func (cs *State) recordMetrics(height int64, block *types.Block) {
 	cs.metrics.Validators.Set(float64(cs.Validators.Size()))
 	cs.metrics.ValidatorsPower.Set(float64(cs.Validators.TotalVotingPower()))
@@ -2209,7 +2376,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		cs.evsw.FireEvent(types.EventVote, vote)
 
 		// if we can skip timeoutCommit and have all the votes now,
-		if cs.config.SkipTimeoutCommit && cs.LastCommit.HasAll() {
+		if cs.roundTimeouts().BypassCommitTimeout && cs.LastCommit.HasAll() {
 			// go straight to new round (skip timeout commit)
 			// cs.scheduleTimeout(time.Duration(0), cs.Height, 0, cstypes.RoundStepNewHeight)
 			cs.enterNewRound(cs.Height, 0)
@@ -2258,7 +2425,9 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 				return false, err
 			}
 
+			start := cs.now()
 			err := cs.blockExec.VerifyVoteExtension(context.TODO(), vote)
+			cs.traceABCI(vote.Height, vote.Round, "verify_vote_extension", start)
 			cs.metrics.MarkVoteExtensionReceived(err == nil)
 			if err != nil {
 				return false, err
@@ -2395,7 +2564,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 
 			if len(blockID.Hash) != 0 {
 				cs.enterCommit(height, vote.Round)
-				if cs.config.SkipTimeoutCommit && precommits.HasAll() {
+				if cs.roundTimeouts().BypassCommitTimeout && precommits.HasAll() {
 					cs.enterNewRound(cs.Height, 0)
 				}
 			} else {
@@ -2448,7 +2617,9 @@ func (cs *State) signVote(
 		// if the signedMessage type is for a non-nil precommit, add
 		// VoteExtension
 		if extEnabled {
+			start := cs.now()
 			ext, err := cs.blockExec.ExtendVote(context.TODO(), vote, block, cs.state)
+			cs.traceABCI(vote.Height, vote.Round, "extend_vote", start)
 			if err != nil {
 				return nil, err
 			}
@@ -2488,7 +2659,7 @@ func (cs *State) signVote(
 }
 
 func (cs *State) voteTime() time.Time {
-	now := cmttime.Now()
+	now := cs.now()
 	minVoteTime := now
 	// Minimum time increment between blocks
 	const timeIota = time.Millisecond
@@ -7,7 +7,6 @@ import (
 	cstypes "github.com/cometbft/cometbft/consensus/types"
 	"github.com/cometbft/cometbft/state"
 	types "github.com/cometbft/cometbft/types"
-	cmttime "github.com/cometbft/cometbft/types/time"
 	"github.com/pkg/errors"
 )
 
@@ -123,6 +122,12 @@ func (ic *IngestCandidate) ValidateBasic() error {
 
 // Verify verifies the block against provided state using light client verification.
 func (ic *IngestCandidate) Verify(state state.State) error {
+	return ic.VerifyWithCache(state, nil)
+}
+
+// VerifyWithCache is like Verify, but skips verifying the commit signatures
+// found in the cache and adds the verified ones to it.
+func (ic *IngestCandidate) VerifyWithCache(state state.State, verifiedSignatureCache *types.SignatureCache) error {
 	var (
 		height            = ic.Height()
 		blockID           = ic.BlockID()
@@ -140,7 +145,7 @@ func (ic *IngestCandidate) Verify(state state.State) error {
 
 	// Fully verify ic.commit (the next block's LastCommit) to ensure all
 	// signatures are valid.
-	err := state.Validators.VerifyCommit(chainID, blockID, height, ic.commit)
+	err := state.Validators.VerifyCommitWithCache(chainID, blockID, height, ic.commit, verifiedSignatureCache)
 	if err != nil {
 		return fmt.Errorf("verify commit: %w", err)
 	}
@@ -150,11 +155,16 @@ func (ic *IngestCandidate) Verify(state state.State) error {
 		return fmt.Errorf("validate block: %w", err)
 	}
 
+	// verify the proposer of the round the block was decided in
+	if err := state.VerifyProposer(ic.block, ic.commit.Round); err != nil {
+		return fmt.Errorf("verify proposer: %w", err)
+	}
+
 	// verify commit extensions
 	if ic.extensionsEnabled() {
 		// if extensions are enabled, we must fully verify the commit since it
 		// is not validated within ValidateBlock but it will be written to the store.
-		err = state.Validators.VerifyCommitExtended(chainID, blockID, height, ic.extCommit)
+		err = state.Validators.VerifyCommitExtendedWithCache(chainID, blockID, height, ic.extCommit, verifiedSignatureCache)
 		if err != nil {
 			return fmt.Errorf("verify extended commit: %w", err)
 		}
@@ -228,6 +238,10 @@ func (cs *State) ingestBlock(ic IngestCandidate) error {
 		return errors.Wrap(ErrValidation, "unverified ingest candidate")
 	}
 
+	if cs.halt != nil {
+		return ErrHalted{Halt: *cs.halt}
+	}
+
 	var (
 		block           = ic.block
 		blockParts      = ic.blockParts
@@ -263,7 +277,7 @@ func (cs *State) ingestBlock(ic IngestCandidate) error {
 	cs.updateRoundStep(commitRound, cstypes.RoundStepCommit)
 	cs.CommitRound = commitRound
 	cs.LastCommit = commitVoteSet
-	cs.CommitTime = cmttime.Now()
+	cs.CommitTime = cs.now()
 	cs.newStep()
 
 	// ============ finalizeCommit(height) ============
@@ -272,6 +286,7 @@ func (cs *State) ingestBlock(ic IngestCandidate) error {
 	// so blocksync responds to peers with the correct height.
 	if ic.extensionsEnabled() {
 		cs.blockStore.SaveBlockWithExtendedCommit(block, blockParts, ic.extCommit)
+		cs.publishExtendedCommit(ic.extCommit)
 	} else {
 		cs.blockStore.SaveBlock(block, blockParts, ic.commit)
 	}
@@ -282,7 +297,9 @@ func (cs *State) ingestBlock(ic IngestCandidate) error {
 	}
 
 	// the following flow is similar to finalizeCommit(height)
+	start := cs.now()
 	stateCopy, err := cs.blockExec.ApplyVerifiedBlock(stateCopy, ic.BlockID(), block)
+	cs.traceABCI(height, ic.commitRound, "finalize_block", start)
 	if err != nil {
 		// we can't recover from this error
 		panic(errors.Wrapf(err, "failed to apply verified block (height: %d, hash: %x)", block.Height, block.Hash()))
@@ -304,6 +321,10 @@ func (cs *State) ingestBlock(ic IngestCandidate) error {
 		logger.Error("Failed to get private validator pubkey", "err", err)
 	}
 
+	if cs.haltAfterCommit(block.Height, block.Time) {
+		return nil
+	}
+
 	cs.scheduleRound0(&cs.RoundState)
 
 	return nil
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/test"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/protoio"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
@@ -137,6 +139,31 @@ func TestStateProposerSelection2(t *testing.T) {
 	}
 }
 
+// the proposers are selected by hash if the consensus params say so
+func TestStateProposerSelectionHash(t *testing.T) {
+	params := test.ConsensusParams()
+	params.Proposer.Selection = cmtproto.ProposerSelectionHash
+	cs1, vss := randStateWithAppImpl(4, kvstore.NewInMemoryApplication(), params)
+	height, round := cs1.Height, cs1.Round
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	selector := cs1.state.ProposerSelector()
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	// everyone just votes nil. we get the proposer drawn for each round
+	for i := int32(0); i < 4; i++ {
+		expected := selector.Proposer(cs1.state.Validators, round+i)
+		rs := cs1.GetRoundState()
+		require.Equal(t, expected.Address, rs.Validators.GetProposer().Address, "round %d", round+i)
+
+		signAddVotes(cs1, cmtproto.PrecommitType, nil, rs.ProposalBlockParts.Header(), true, vss[1:]...)
+		ensureNewRound(newRoundCh, height, round+i+1)
+		incrementRound(vss[1:]...)
+	}
+}
+
 // a non-validator should timeout into the prevote round
 func TestStateEnterProposeNoPrivValidator(t *testing.T) {
 	cs, _ := randState(1)
@@ -187,6 +214,41 @@ func TestStateEnterProposeYesPrivValidator(t *testing.T) {
 	ensureNoNewTimeout(timeoutCh, cs.config.TimeoutPropose.Nanoseconds())
 }
 
+func TestStateTimeoutParams(t *testing.T) {
+	params := test.ConsensusParams()
+	params.Timeout = types.TimeoutParams{
+		Propose:             7 * time.Second,
+		ProposeDelta:        time.Second,
+		Prevote:             2 * time.Second,
+		PrevoteDelta:        time.Second,
+		Precommit:           3 * time.Second,
+		PrecommitDelta:      time.Second,
+		Commit:              4 * time.Second,
+		BypassCommitTimeout: false,
+	}
+	params.Feature.TimeoutParamsEnableHeight = 2
+
+	cs, _ := randStateWithAppImpl(2, kvstore.NewInMemoryApplication(), params)
+	configTimeouts := types.TimeoutParams{
+		Propose:             cs.config.TimeoutPropose,
+		ProposeDelta:        cs.config.TimeoutProposeDelta,
+		Prevote:             cs.config.TimeoutPrevote,
+		PrevoteDelta:        cs.config.TimeoutPrevoteDelta,
+		Precommit:           cs.config.TimeoutPrecommit,
+		PrecommitDelta:      cs.config.TimeoutPrecommitDelta,
+		Commit:              cs.config.TimeoutCommit,
+		BypassCommitTimeout: cs.config.SkipTimeoutCommit,
+	}
+
+	// not enabled yet
+	require.Equal(t, configTimeouts, cs.roundTimeouts())
+	require.Equal(t, params.Timeout, cs.timeouts(2, cs.state.ConsensusParams, cs.Validators))
+
+	// the config overrides the timeouts on the other nodes
+	cs.SetPrivValidator(types.NewMockPV())
+	require.Equal(t, configTimeouts, cs.timeouts(2, cs.state.ConsensusParams, cs.Validators))
+}
+
 func TestStateBadProposal(t *testing.T) {
 	ctx := t.Context()
 
@@ -450,6 +512,164 @@ func TestStateFullRound2(t *testing.T) {
 
 // two validators, 4 rounds.
 // two vals take turns proposing. val1 locks on first one, precommits nil on everything else
+type finalizeBlockCounterApp struct {
+	*kvstore.Application
+
+	mtx   sync.Mutex
+	calls map[int64]int
+}
+
+func (app *finalizeBlockCounterApp) FinalizeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	app.mtx.Lock()
+	app.calls[req.Height]++
+	app.mtx.Unlock()
+	return app.Application.FinalizeBlock(ctx, req)
+}
+
+func (app *finalizeBlockCounterApp) finalizeBlockCalls(height int64) int {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return app.calls[height]
+}
+
+func TestStateOptimisticExecution(t *testing.T) {
+	app := &finalizeBlockCounterApp{
+		Application: kvstore.NewInMemoryApplication(),
+		calls:       make(map[int64]int),
+	}
+	cs1, vss := randStateWithApp(2, app)
+	cs1.config.OptimisticExecution = true
+	vs2 := vss[1]
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	// the proposal is executed before it is decided
+	require.Eventually(t, func() bool { return app.finalizeBlockCalls(height) == 1 }, time.Second, 10*time.Millisecond)
+
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartSetHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+	signAddVotes(cs1, cmtproto.PrevoteType, propBlockHash, propPartSetHeader, false, vs2)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, cmtproto.PrecommitType, propBlockHash, propPartSetHeader, true, vs2)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	// the result of the optimistic execution is committed
+	require.Equal(t, 1, app.finalizeBlockCalls(height))
+}
+
+func TestStateTrace(t *testing.T) {
+	cs1, vss := randState(2)
+	vs2 := vss[1]
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartSetHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+	signAddVotes(cs1, cmtproto.PrevoteType, propBlockHash, propPartSetHeader, false, vs2)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, cmtproto.PrecommitType, propBlockHash, propPartSetHeader, true, vs2)
+	ensurePrecommit(voteCh, height, round)
+	// the next height may start while the last precommit is handled
+	ensureNewRound(newRoundCh, height+1, 0)
+	cs1.mtx.Lock()
+	cs1.mtx.Unlock() //nolint:staticcheck // wait for the message to be handled
+
+	bz, err := cs1.GetHeightTraceJSON(height)
+	require.NoError(t, err)
+	var trace cstypes.HeightTrace
+	require.NoError(t, cmtjson.Unmarshal(bz, &trace))
+	require.Equal(t, height, trace.Height)
+	require.Zero(t, trace.Dropped)
+
+	steps := make(map[string]bool)
+	methods := make(map[string]bool)
+	votes := make(map[string]int)
+	var proposals, parts int
+	for _, ev := range trace.Events {
+		require.False(t, ev.Time.IsZero())
+		switch ev.Type {
+		case cstypes.TraceEventStep:
+			steps[ev.Step] = true
+		case cstypes.TraceEventABCI:
+			methods[ev.Method] = true
+		case cstypes.TraceEventVote:
+			if ev.Added {
+				votes[ev.VoteType]++
+			}
+		case cstypes.TraceEventProposal:
+			proposals++
+		case cstypes.TraceEventBlockPart:
+			parts++
+		}
+	}
+	for _, step := range []cstypes.RoundStepType{
+		cstypes.RoundStepPropose, cstypes.RoundStepPrevote, cstypes.RoundStepPrecommit, cstypes.RoundStepCommit,
+	} {
+		require.True(t, steps[step.String()], step)
+	}
+	require.True(t, methods["prepare_proposal"])
+	require.True(t, methods["process_proposal"])
+	require.True(t, methods["finalize_block"])
+	require.Equal(t, 2, votes[types.PrevoteShortName])
+	require.Equal(t, 2, votes[types.PrecommitShortName])
+	require.Equal(t, 1, proposals)
+	require.Equal(t, int(propPartSetHeader.Total), parts)
+
+	// the old heights aren't recorded
+	_, err = cs1.GetHeightTraceJSON(height - 1)
+	require.Error(t, err)
+}
+
+func TestStateExtendedCommitEvent(t *testing.T) {
+	cs1, vss := randState(2)
+	vs2 := vss[1]
+	height, round := cs1.Height, cs1.Round
+	require.True(t, cs1.state.ConsensusParams.ABCI.VoteExtensionsEnabled(height))
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	extCommitCh := subscribe(cs1.eventBus, types.EventQueryExtendedCommit)
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartSetHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+	signAddVotes(cs1, cmtproto.PrevoteType, propBlockHash, propPartSetHeader, false, vs2)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, cmtproto.PrecommitType, propBlockHash, propPartSetHeader, true, vs2)
+	ensurePrecommit(voteCh, height, round)
+
+	select {
+	case msg := <-extCommitCh:
+		data, ok := msg.Data().(types.EventDataExtendedCommit)
+		require.True(t, ok)
+		require.Equal(t, height, data.Height)
+		require.Equal(t, propBlockHash, data.ExtendedCommit.BlockID.Hash)
+		require.Len(t, data.ExtendedCommit.ExtendedSignatures, 2)
+		for _, sig := range data.ExtendedCommit.ExtendedSignatures {
+			require.NotEmpty(t, sig.ExtensionSignature)
+		}
+		require.Equal(t, data.ExtendedCommit, cs1.blockStore.LoadBlockExtendedCommit(height))
+	case <-time.After(ensureTimeout):
+		t.Fatal("timed out waiting for the extended commit event")
+	}
+}
+
 func TestStateLockNoPOL(t *testing.T) {
 	ctx := t.Context()
 
@@ -0,0 +1,59 @@
+package consensus
+
+import (
+	"fmt"
+	"time"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+)
+
+// maxTraceEvents is the maximum number of events recorded per height, which
+// bounds the memory used by the traces when e.g. the rounds of a height keep
+// failing.
+const maxTraceEvents = 10000
+
+// GetHeightTraceJSON returns a json of the timeline of the consensus events of
+// the height, if it is one of the last heights recorded, see
+// config.ConsensusConfig.TraceHeights.
+func (cs *State) GetHeightTraceJSON(height int64) ([]byte, error) {
+	trace, ok := cs.traces.Trace(height)
+	if !ok {
+		return nil, fmt.Errorf("no trace of height %d, only the last %d heights are recorded",
+			height, cs.config.TraceHeights)
+	}
+	return cmtjson.Marshal(trace)
+}
+
+// recordTrace adds the event to the trace of the height, if it is the current
+// height or the last one, as the messages of older heights are ignored.
+// Must be called with cs.mtx held.
+func (cs *State) recordTrace(height int64, ev cstypes.TraceEvent) {
+	if height < cs.Height-1 || height > cs.Height {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = cs.now()
+	}
+	cs.traces.Record(height, ev)
+}
+
+// traceABCI records the duration of a call to the ABCI method which started at
+// start. Must be called with cs.mtx held.
+func (cs *State) traceABCI(height int64, round int32, method string, start time.Time) {
+	now := cs.now()
+	cs.recordTrace(height, cstypes.TraceEvent{
+		Time:     now,
+		Type:     cstypes.TraceEventABCI,
+		Round:    round,
+		Method:   method,
+		Duration: now.Sub(start),
+	})
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -46,6 +46,7 @@ type HeightVoteSet struct {
 	round             int32                  // max tracked round
 	roundVoteSets     map[int32]RoundVoteSet // keys: [0...round]
 	peerCatchupRounds map[p2p.ID][]int32     // keys: peer.ID; values: at most 2 rounds
+	signatureCache    *types.SignatureCache  // set on the vote sets, may be nil
 }
 
 func NewHeightVoteSet(chainID string, height int64, valSet *types.ValidatorSet) *HeightVoteSet {
@@ -79,6 +80,19 @@ func (hvs *HeightVoteSet) Reset(height int64, valSet *types.ValidatorSet) {
 	hvs.round = 0
 }
 
+// SetSignatureCache sets the cache of verified signatures of the vote sets of
+// all the rounds, see types.VoteSet.SetSignatureCache. It is kept across
+// Reset.
+func (hvs *HeightVoteSet) SetSignatureCache(cache *types.SignatureCache) {
+	hvs.mtx.Lock()
+	defer hvs.mtx.Unlock()
+	hvs.signatureCache = cache
+	for _, rvs := range hvs.roundVoteSets {
+		rvs.Prevotes.SetSignatureCache(cache)
+		rvs.Precommits.SetSignatureCache(cache)
+	}
+}
+
 func (hvs *HeightVoteSet) Height() int64 {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
@@ -120,6 +134,10 @@ func (hvs *HeightVoteSet) addRound(round int32) {
 	} else {
 		precommits = types.NewVoteSet(hvs.chainID, hvs.height, round, cmtproto.PrecommitType, hvs.valSet)
 	}
+	if hvs.signatureCache != nil {
+		prevotes.SetSignatureCache(hvs.signatureCache)
+		precommits.SetSignatureCache(hvs.signatureCache)
+	}
 	hvs.roundVoteSets[round] = RoundVoteSet{
 		Prevotes:   prevotes,
 		Precommits: precommits,
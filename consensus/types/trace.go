@@ -0,0 +1,130 @@
+package types
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+)
+
+// TraceEventType enumerates the types of the events of a HeightTrace.
+type TraceEventType string
+
+const (
+	// TraceEventStep is a transition to Step of Round.
+	TraceEventStep TraceEventType = "step"
+	// TraceEventTimeout is a timeout of Step of Round which fired after Duration.
+	TraceEventTimeout TraceEventType = "timeout"
+	// TraceEventProposal is the arrival of the proposal of Round from Peer.
+	TraceEventProposal TraceEventType = "proposal"
+	// TraceEventBlockPart is the arrival of the block part Index of the
+	// proposal of Round from Peer.
+	TraceEventBlockPart TraceEventType = "block_part"
+	// TraceEventVote is the arrival of a vote of type VoteType of Round from
+	// Peer, signed by the validator Validator at index Index.
+	TraceEventVote TraceEventType = "vote"
+	// TraceEventABCI is a call to the ABCI Method which returned after Duration.
+	TraceEventABCI TraceEventType = "abci"
+)
+
+// TraceEvent is an event of the timeline of a height. The fields which are set
+// depend on its Type.
+type TraceEvent struct {
+	Time  time.Time      `json:"time"`
+	Type  TraceEventType `json:"type"`
+	Round int32          `json:"round"`
+
+	Step     string        `json:"step,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+	Method   string        `json:"method,omitempty"`
+
+	// Peer is empty for the messages of this node.
+	Peer      string         `json:"peer,omitempty"`
+	VoteType  string         `json:"vote_type,omitempty"`
+	Validator bytes.HexBytes `json:"validator,omitempty"`
+	Index     int32          `json:"index,omitempty"`
+	// Added is false if the message didn't change the round state, e.g. it
+	// was a duplicate.
+	Added bool   `json:"added,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// HeightTrace is the timeline of the consensus events of a height, to analyze
+// e.g. why its rounds failed.
+type HeightTrace struct {
+	Height int64 `json:"height"`
+	// Events are in the order they were recorded, which differs from the order
+	// of their times if e.g. a message is recorded once it is handled.
+	Events []TraceEvent `json:"events"`
+	// Dropped is the number of events which weren't recorded because the
+	// trace had the maximum number of events.
+	Dropped int `json:"dropped"`
+}
+
+// TraceRecorder records the HeightTrace of the last heights, up to a maximum
+// number of heights and of events per height. It is safe for concurrent use.
+type TraceRecorder struct {
+	mtx        sync.Mutex
+	maxHeights int
+	maxEvents  int
+	traces     map[int64]*HeightTrace
+}
+
+// NewTraceRecorder returns a TraceRecorder recording the last maxHeights
+// heights, with at most maxEvents events each.
+func NewTraceRecorder(maxHeights, maxEvents int) *TraceRecorder {
+	return &TraceRecorder{
+		maxHeights: maxHeights,
+		maxEvents:  maxEvents,
+		traces:     make(map[int64]*HeightTrace, maxHeights),
+	}
+}
+
+// Record adds the event to the trace of the height. If the recorder has the
+// maximum number of heights, the trace of the lowest height is evicted, unless
+// the height is lower.
+func (r *TraceRecorder) Record(height int64, ev TraceEvent) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	trace, ok := r.traces[height]
+	if !ok {
+		if r.maxHeights <= 0 {
+			return
+		}
+		if len(r.traces) >= r.maxHeights {
+			lowest := height
+			for h := range r.traces {
+				lowest = min(lowest, h)
+			}
+			if lowest == height {
+				return
+			}
+			delete(r.traces, lowest)
+		}
+		trace = &HeightTrace{Height: height}
+		r.traces[height] = trace
+	}
+
+	if len(trace.Events) >= r.maxEvents {
+		trace.Dropped++
+		return
+	}
+	trace.Events = append(trace.Events, ev)
+}
+
+// Trace returns a copy of the trace of the height, if it is recorded.
+func (r *TraceRecorder) Trace(height int64) (*HeightTrace, bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	trace, ok := r.traces[height]
+	if !ok {
+		return nil, false
+	}
+	return &HeightTrace{
+		Height:  trace.Height,
+		Events:  append([]TraceEvent(nil), trace.Events...),
+		Dropped: trace.Dropped,
+	}, true
+}
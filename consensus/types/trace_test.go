@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceRecorder(t *testing.T) {
+	r := NewTraceRecorder(2, 3)
+
+	for i := 0; i < 4; i++ {
+		r.Record(1, TraceEvent{Type: TraceEventStep, Round: int32(i)})
+	}
+	trace, ok := r.Trace(1)
+	require.True(t, ok)
+	require.EqualValues(t, 1, trace.Height)
+	require.Len(t, trace.Events, 3)
+	require.Equal(t, 1, trace.Dropped)
+
+	// the returned trace is a copy
+	trace.Events[0].Round = 10
+	trace, _ = r.Trace(1)
+	require.EqualValues(t, 0, trace.Events[0].Round)
+
+	// the lowest height is evicted
+	r.Record(2, TraceEvent{Type: TraceEventTimeout})
+	r.Record(3, TraceEvent{Type: TraceEventVote})
+	_, ok = r.Trace(1)
+	require.False(t, ok)
+	trace, ok = r.Trace(3)
+	require.True(t, ok)
+	require.Len(t, trace.Events, 1)
+
+	// unless the height is lower
+	r.Record(1, TraceEvent{Type: TraceEventStep})
+	_, ok = r.Trace(1)
+	require.False(t, ok)
+	_, ok = r.Trace(2)
+	require.True(t, ok)
+}
+
+func TestTraceRecorderDisabled(t *testing.T) {
+	r := NewTraceRecorder(0, 10)
+	r.Record(1, TraceEvent{Type: TraceEventStep})
+	_, ok := r.Trace(1)
+	require.False(t, ok)
+}
@@ -44,12 +44,21 @@ type EndHeightMessage struct {
 	Height int64 `json:"height"`
 }
 
+// CheckpointMarkerMessage marks the point of the WAL up to which the messages
+// of the given height are reflected in the checkpoint of the consensus state
+// with the same height and sequence, see ConsensusConfig.CheckpointInterval.
+type CheckpointMarkerMessage struct {
+	Height   int64  `json:"height"`
+	Sequence uint64 `json:"sequence"`
+}
+
 type WALMessage any
 
 func init() {
 	cmtjson.RegisterType(msgInfo{}, "tendermint/wal/MsgInfo")
 	cmtjson.RegisterType(timeoutInfo{}, "tendermint/wal/TimeoutInfo")
 	cmtjson.RegisterType(EndHeightMessage{}, "tendermint/wal/EndHeightMessage")
+	cmtjson.RegisterType(CheckpointMarkerMessage{}, "tendermint/wal/CheckpointMarkerMessage")
 }
 
 //--------------------------------------------------------
@@ -85,6 +85,7 @@ func TestWALEncoderDecoder(t *testing.T) {
 	now := cmttime.Now()
 	msgs := []TimedWALMessage{
 		{Time: now, Msg: EndHeightMessage{0}},
+		{Time: now, Msg: CheckpointMarkerMessage{Height: 1, Sequence: 3}},
 		{Time: now, Msg: timeoutInfo{Duration: time.Second, Height: 1, Round: 1, Step: types.RoundStepPropose}},
 		{Time: now, Msg: cmttypes.EventDataRoundState{Height: 1, Round: 1, Step: ""}},
 	}
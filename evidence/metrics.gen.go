@@ -0,0 +1,51 @@
+// Code generated by metricsgen. DO NOT EDIT.
+
+package evidence
+
+import (
+	"github.com/go-kit/kit/metrics/discard"
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		NumEvidence: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "num_evidence",
+			Help:      "Number of pending evidence in the pool.",
+		}, labels).With(labelsAndValues...),
+		AddedEvidence: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "added_evidence",
+			Help:      "Number of evidence verified and added to the pending pool.",
+		}, labels).With(labelsAndValues...),
+		CommittedEvidence: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "committed_evidence",
+			Help:      "Number of evidence committed in blocks.",
+		}, labels).With(labelsAndValues...),
+		ExpiredEvidence: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "expired_evidence",
+			Help:      "Number of pending evidence removed from the pool because it expired before being committed.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+func NopMetrics() *Metrics {
+	return &Metrics{
+		NumEvidence:       discard.NewGauge(),
+		AddedEvidence:     discard.NewCounter(),
+		CommittedEvidence: discard.NewCounter(),
+		ExpiredEvidence:   discard.NewCounter(),
+	}
+}
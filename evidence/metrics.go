@@ -0,0 +1,29 @@
+package evidence
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "evidence"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of pending evidence in the pool.
+	NumEvidence metrics.Gauge
+
+	// Number of evidence verified and added to the pending pool.
+	AddedEvidence metrics.Counter
+
+	// Number of evidence committed in blocks.
+	CommittedEvidence metrics.Counter
+
+	// Number of pending evidence removed from the pool because it expired
+	// before being committed.
+	ExpiredEvidence metrics.Counter
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -23,8 +24,9 @@ import (
 )
 
 const (
-	baseKeyCommitted = byte(0x00)
-	baseKeyPending   = byte(0x01)
+	baseKeyCommitted         = byte(0x00)
+	baseKeyPending           = byte(0x01)
+	baseKeyCommittedByHeight = byte(0x02)
 )
 
 // Pool maintains a pool of valid evidence to be broadcasted and committed
@@ -50,11 +52,40 @@ type Pool struct {
 
 	pruningHeight int64
 	pruningTime   time.Time
+
+	// events about pending evidence
+	eventBus types.EvidenceEventPublisher
+
+	metrics *Metrics
+
+	// verified signatures, shared with the rest of the node; may be nil
+	signatureCache *types.SignatureCache
+}
+
+// PoolOption sets an optional parameter on the Pool.
+type PoolOption func(*Pool)
+
+// WithMetrics sets the metrics.
+func WithMetrics(metrics *Metrics) PoolOption {
+	return func(evpool *Pool) { evpool.metrics = metrics }
+}
+
+// WithSignatureCache sets the cache of verified signatures used to verify the
+// votes and commits of evidence.
+func WithSignatureCache(cache *types.SignatureCache) PoolOption {
+	return func(evpool *Pool) { evpool.signatureCache = cache }
+}
+
+// CommittedEvidence is a piece of evidence together with the height of the
+// block it was committed in.
+type CommittedEvidence struct {
+	Height   int64
+	Evidence types.Evidence
 }
 
 // NewPool creates an evidence pool. If using an existing evidence store,
 // it will add all pending evidence to the concurrent list.
-func NewPool(evidenceDB dbm.DB, stateDB sm.Store, blockStore BlockStore) (*Pool, error) {
+func NewPool(evidenceDB dbm.DB, stateDB sm.Store, blockStore BlockStore, options ...PoolOption) (*Pool, error) {
 	state, err := stateDB.Load()
 	if err != nil {
 		return nil, fmt.Errorf("cannot load state: %w", err)
@@ -68,6 +99,12 @@ func NewPool(evidenceDB dbm.DB, stateDB sm.Store, blockStore BlockStore) (*Pool,
 		evidenceStore:   evidenceDB,
 		evidenceList:    clist.New(),
 		consensusBuffer: make([]duplicateVoteSet, 0),
+		eventBus:        types.NopEventBus{},
+		metrics:         NopMetrics(),
+	}
+
+	for _, option := range options {
+		option(pool)
 	}
 
 	// if pending evidence already in db, in event of prior failure, then check for expiration,
@@ -78,6 +115,7 @@ func NewPool(evidenceDB dbm.DB, stateDB sm.Store, blockStore BlockStore) (*Pool,
 		return nil, err
 	}
 	atomic.StoreUint32(&pool.evidenceSize, uint32(len(evList)))
+	pool.metrics.NumEvidence.Set(float64(len(evList)))
 	for _, ev := range evList {
 		pool.evidenceList.PushBack(ev)
 	}
@@ -123,7 +161,7 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 	evpool.updateState(state)
 
 	// move committed evidence out from the pending pool and into the committed pool
-	evpool.markEvidenceAsCommitted(ev)
+	evpool.markEvidenceAsCommitted(ev, state.LastBlockHeight)
 
 	// prune pending evidence when it has expired. This also updates when the next evidence will expire
 	if evpool.Size() > 0 && state.LastBlockHeight > evpool.pruningHeight &&
@@ -169,6 +207,40 @@ func (evpool *Pool) AddEvidence(ev types.Evidence) error {
 	return nil
 }
 
+// CommittedEvidence returns the evidence committed in blocks with heights
+// within [minHeight, maxHeight], ordered by the height of the block.
+func (evpool *Pool) CommittedEvidence(minHeight, maxHeight int64) ([]CommittedEvidence, error) {
+	if minHeight > maxHeight {
+		return nil, fmt.Errorf("min height %d can't be greater than max height %d", minHeight, maxHeight)
+	}
+
+	iter, err := evpool.evidenceStore.Iterator(
+		keyCommittedByHeightPrefix(minHeight),
+		keyCommittedByHeightPrefix(maxHeight+1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %v", err)
+	}
+	defer iter.Close()
+
+	var committed []CommittedEvidence
+	for ; iter.Valid(); iter.Next() {
+		height, err := heightFromKeyCommittedByHeight(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		ev, err := bytesToEv(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		committed = append(committed, CommittedEvidence{Height: height, Evidence: ev})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return committed, nil
+}
+
 // ReportConflictingVotes takes two conflicting votes and forms duplicate vote evidence,
 // adding it eventually to the evidence pool.
 //
@@ -246,6 +318,12 @@ func (evpool *Pool) SetLogger(l log.Logger) {
 	evpool.logger = l
 }
 
+// SetEventBus sets the event bus for publishing evidence related events.
+// If not called, it defaults to types.NopEventBus.
+func (evpool *Pool) SetEventBus(eventBus types.EvidenceEventPublisher) {
+	evpool.eventBus = eventBus
+}
+
 // Size returns the number of evidence in the pool.
 func (evpool *Pool) Size() uint32 {
 	return atomic.LoadUint32(&evpool.evidenceSize)
@@ -311,7 +389,16 @@ func (evpool *Pool) addPendingEvidence(ev types.Evidence) error {
 	if err != nil {
 		return fmt.Errorf("can't persist evidence: %w", err)
 	}
-	atomic.AddUint32(&evpool.evidenceSize, 1)
+	size := atomic.AddUint32(&evpool.evidenceSize, 1)
+	evpool.metrics.NumEvidence.Set(float64(size))
+	evpool.metrics.AddedEvidence.Add(1)
+
+	if err := evpool.eventBus.PublishEventEvidenceAdded(types.EventDataNewEvidence{
+		Evidence: ev,
+		Height:   ev.Height(),
+	}); err != nil {
+		evpool.logger.Error("Failed publishing added evidence", "err", err)
+	}
 	return nil
 }
 
@@ -320,14 +407,15 @@ func (evpool *Pool) removePendingEvidence(evidence types.Evidence) {
 	if err := evpool.evidenceStore.Delete(key); err != nil {
 		evpool.logger.Error("Unable to delete pending evidence", "err", err)
 	} else {
-		atomic.AddUint32(&evpool.evidenceSize, ^uint32(0))
+		size := atomic.AddUint32(&evpool.evidenceSize, ^uint32(0))
+		evpool.metrics.NumEvidence.Set(float64(size))
 		evpool.logger.Debug("Deleted pending evidence", "evidence", evidence)
 	}
 }
 
-// markEvidenceAsCommitted processes all the evidence in the block, marking it as
-// committed and removing it from the pending database.
-func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
+// markEvidenceAsCommitted processes all the evidence in the block at the given
+// height, marking it as committed and removing it from the pending database.
+func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList, height int64) {
 	blockEvidenceMap := make(map[string]struct{}, len(evidence))
 	for _, ev := range evidence {
 		if evpool.isPending(ev) {
@@ -349,6 +437,13 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
 		if err := evpool.evidenceStore.Set(key, evBytes); err != nil {
 			evpool.logger.Error("Unable to save committed evidence", "err", err, "key(height/hash)", key)
 		}
+		evpool.metrics.CommittedEvidence.Add(1)
+
+		// Index the evidence by the height of the block it was committed in,
+		// so that it can be queried without loading blocks.
+		if err := evpool.saveCommittedEvidenceByHeight(ev, height); err != nil {
+			evpool.logger.Error("Unable to index committed evidence", "err", err, "height", height)
+		}
 	}
 
 	// remove committed evidence from the clist
@@ -426,6 +521,7 @@ func (evpool *Pool) removeExpiredPendingEvidence() (int64, time.Time) {
 				ev.Time().Add(evpool.State().ConsensusParams.Evidence.MaxAgeDuration).Add(time.Second)
 		}
 		evpool.removePendingEvidence(ev)
+		evpool.metrics.ExpiredEvidence.Add(1)
 		blockEvidenceMap[evMapKey(ev)] = struct{}{}
 	}
 	// We either have no pending evidence or all evidence has expired
@@ -435,6 +531,20 @@ func (evpool *Pool) removeExpiredPendingEvidence() (int64, time.Time) {
 	return evpool.State().LastBlockHeight, evpool.State().LastBlockTime
 }
 
+func (evpool *Pool) saveCommittedEvidenceByHeight(ev types.Evidence, height int64) error {
+	evpb, err := types.EvidenceToProto(ev)
+	if err != nil {
+		return cmterrors.ErrMsgToProto{MessageName: "Evidence", Err: err}
+	}
+
+	evBytes, err := evpb.Marshal()
+	if err != nil {
+		return fmt.Errorf("unable to marshal evidence: %w", err)
+	}
+
+	return evpool.evidenceStore.Set(keyCommittedByHeight(ev, height), evBytes)
+}
+
 func (evpool *Pool) removeEvidenceFromList(
 	blockEvidenceMap map[string]struct{},
 ) {
@@ -570,6 +680,22 @@ func keyPending(evidence types.Evidence) []byte {
 	return append([]byte{baseKeyPending}, keySuffix(evidence)...)
 }
 
+func keyCommittedByHeight(evidence types.Evidence, height int64) []byte {
+	return append(keyCommittedByHeightPrefix(height), []byte(fmt.Sprintf("/%X", evidence.Hash()))...)
+}
+
+func keyCommittedByHeightPrefix(height int64) []byte {
+	return append([]byte{baseKeyCommittedByHeight}, []byte(bE(height))...)
+}
+
+func heightFromKeyCommittedByHeight(key []byte) (int64, error) {
+	// prefix byte followed by 16 hex characters
+	if len(key) < 17 {
+		return 0, fmt.Errorf("invalid committed evidence key %X", key)
+	}
+	return strconv.ParseInt(string(key[1:17]), 16, 64)
+}
+
 func keySuffix(evidence types.Evidence) []byte {
 	return []byte(fmt.Sprintf("%s/%X", bE(evidence.Height()), evidence.Hash()))
 }
@@ -1,6 +1,7 @@
 package evidence_test
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
@@ -215,6 +216,51 @@ func TestEvidencePoolUpdate(t *testing.T) {
 	if assert.Error(t, err) {
 		assert.Equal(t, "evidence was already committed", err.(*types.ErrInvalidEvidence).Reason.Error())
 	}
+
+	// c) Committed evidence can be queried by the height of the block it was committed in
+	committed, err := pool.CommittedEvidence(height, height+1)
+	require.NoError(t, err)
+	require.Len(t, committed, 1)
+	assert.Equal(t, height+1, committed[0].Height)
+	assert.Equal(t, ev, committed[0].Evidence)
+
+	committed, err = pool.CommittedEvidence(1, height)
+	require.NoError(t, err)
+	assert.Empty(t, committed)
+
+	_, err = pool.CommittedEvidence(height+1, height)
+	assert.Error(t, err)
+}
+
+func TestEvidencePoolPublishesAddedEvidence(t *testing.T) {
+	height := int64(1)
+	pool, val := defaultTestPool(t, height)
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	pool.SetEventBus(eventBus)
+
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryEvidenceAdded)
+	require.NoError(t, err)
+
+	ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(height, defaultEvidenceTime.Add(1*time.Minute),
+		val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(ev))
+
+	select {
+	case msg := <-sub.Out():
+		edt := msg.Data().(types.EventDataNewEvidence)
+		assert.Equal(t, ev, edt.Evidence)
+		assert.Equal(t, height, edt.Height)
+	case <-time.After(time.Second):
+		t.Fatal("did not receive added evidence event after 1 sec.")
+	}
 }
 
 func TestVerifyPendingEvidencePasses(t *testing.T) {
@@ -52,7 +52,7 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 		if err != nil {
 			return err
 		}
-		return VerifyDuplicateVote(ev, state.ChainID, valSet)
+		return verifyDuplicateVote(ev, state.ChainID, valSet, evpool.signatureCache)
 
 	case *types.LightClientAttackEvidence:
 		commonHeader, err := getSignedHeader(evpool.blockStore, evidence.Height())
@@ -86,8 +86,7 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 			}
 		}
 
-		err = VerifyLightClientAttack(ev, commonHeader, trustedHeader, commonVals, state.LastBlockTime,
-			state.ConsensusParams.Evidence.MaxAgeDuration)
+		err = verifyLightClientAttack(ev, commonHeader, trustedHeader, commonVals, evpool.signatureCache)
 		if err != nil {
 			return err
 		}
@@ -117,11 +116,22 @@ func VerifyLightClientAttack(
 ) error {
 	// TODO: Should the current time and trust period be used in this method?
 	// If not, why were the parameters present?
+	return verifyLightClientAttack(e, commonHeader, trustedHeader, commonVals, nil)
+}
 
+// verifyLightClientAttack is VerifyLightClientAttack, with the signatures of
+// the conflicting commit looked up in and added to the cache.
+func verifyLightClientAttack(
+	e *types.LightClientAttackEvidence,
+	commonHeader, trustedHeader *types.SignedHeader,
+	commonVals *types.ValidatorSet,
+	verifiedSignatureCache *types.SignatureCache,
+) error {
 	// In the case of lunatic attack there will be a different commonHeader height. Therefore the node perform a single
 	// verification jump between the common header and the conflicting one
 	if commonHeader.Height != e.ConflictingBlock.Height {
-		err := commonVals.VerifyCommitLightTrustingAllSignatures(trustedHeader.ChainID, e.ConflictingBlock.Commit, light.DefaultTrustLevel)
+		err := commonVals.VerifyCommitLightTrustingAllSignaturesWithCache(trustedHeader.ChainID, e.ConflictingBlock.Commit,
+			light.DefaultTrustLevel, verifiedSignatureCache)
 		if err != nil {
 			return fmt.Errorf("skipping verification of conflicting block failed: %w", err)
 		}
@@ -133,8 +143,8 @@ func VerifyLightClientAttack(
 	}
 
 	// Verify that the 2/3+ commits from the conflicting validator set were for the conflicting header
-	if err := e.ConflictingBlock.ValidatorSet.VerifyCommitLightAllSignatures(trustedHeader.ChainID, e.ConflictingBlock.Commit.BlockID,
-		e.ConflictingBlock.Height, e.ConflictingBlock.Commit); err != nil {
+	if err := e.ConflictingBlock.ValidatorSet.VerifyCommitLightAllSignaturesWithCache(trustedHeader.ChainID, e.ConflictingBlock.Commit.BlockID,
+		e.ConflictingBlock.Height, e.ConflictingBlock.Commit, verifiedSignatureCache); err != nil {
 		return fmt.Errorf("invalid commit from conflicting block: %w", err)
 	}
 
@@ -166,6 +176,17 @@ func VerifyLightClientAttack(
 //   - the block ID's must be different
 //   - The signatures must both be valid
 func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, valSet *types.ValidatorSet) error {
+	return verifyDuplicateVote(e, chainID, valSet, nil)
+}
+
+// verifyDuplicateVote is VerifyDuplicateVote, with the signatures of the votes
+// looked up in and added to the cache.
+func verifyDuplicateVote(
+	e *types.DuplicateVoteEvidence,
+	chainID string,
+	valSet *types.ValidatorSet,
+	verifiedSignatureCache *types.SignatureCache,
+) error {
 	_, val := valSet.GetByAddress(e.VoteA.ValidatorAddress)
 	if val == nil {
 		return fmt.Errorf("address %X was not a validator at height %d", e.VoteA.ValidatorAddress, e.Height())
@@ -214,14 +235,12 @@ func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, valSet
 			e.TotalVotingPower, valSet.TotalVotingPower())
 	}
 
-	va := e.VoteA.ToProto()
-	vb := e.VoteB.ToProto()
 	// Signatures must be valid
-	if !pubKey.VerifySignature(types.VoteSignBytes(chainID, va), e.VoteA.Signature) {
-		return fmt.Errorf("verifying VoteA: %w", types.ErrVoteInvalidSignature)
+	if err := e.VoteA.VerifyWithCache(chainID, pubKey, verifiedSignatureCache); err != nil {
+		return fmt.Errorf("verifying VoteA: %w", err)
 	}
-	if !pubKey.VerifySignature(types.VoteSignBytes(chainID, vb), e.VoteB.Signature) {
-		return fmt.Errorf("verifying VoteB: %w", types.ErrVoteInvalidSignature)
+	if err := e.VoteB.VerifyWithCache(chainID, pubKey, verifiedSignatureCache); err != nil {
+		return fmt.Errorf("verifying VoteB: %w", err)
 	}
 
 	return nil
@@ -14,6 +14,7 @@ import (
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/light/provider"
 	"github.com/cometbft/cometbft/light/store"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -125,6 +126,17 @@ func MaxBlockLag(d time.Duration) Option {
 	}
 }
 
+// ProposerSelection option makes the light client verify that the verified
+// headers were proposed by the proposers selected by the given strategy of the
+// ProposerParams of the chain. Only the proposers selected by hash can be
+// verified from a header, see types.ValidatorSet.VerifyProposer.
+// Default: round-robin, whose proposers aren't verified.
+func ProposerSelection(selection cmtproto.ProposerSelection) Option {
+	return func(c *Client) {
+		c.proposerSelection = selection
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -138,6 +150,8 @@ type Client struct {
 	maxRetryAttempts uint16 // see MaxRetryAttempts option
 	maxClockDrift    time.Duration
 	maxBlockLag      time.Duration
+	// see ProposerSelection option
+	proposerSelection cmtproto.ProposerSelection
 
 	// Mutex for locking during changes of the light clients providers
 	providerMutex cmtsync.Mutex
@@ -600,6 +614,9 @@ func (c *Client) verifyLightBlock(ctx context.Context, newLightBlock *types.Ligh
 		}
 		err = verifyFunc(ctx, closestBlock, newLightBlock, now)
 	}
+	if err == nil {
+		err = c.verifyProposer(newLightBlock)
+	}
 	if err != nil {
 		c.logger.Error("Can't verify", "err", err)
 		return err
@@ -609,6 +626,19 @@ func (c *Client) verifyLightBlock(ctx context.Context, newLightBlock *types.Ligh
 	return c.updateTrustedLightBlock(newLightBlock)
 }
 
+// verifyProposer verifies the proposer of the light block, whose validator set
+// is trusted, see ProposerSelection option.
+func (c *Client) verifyProposer(lb *types.LightBlock) error {
+	if c.proposerSelection == cmtproto.ProposerSelectionRoundRobin {
+		return nil
+	}
+	selector := types.NewProposerSelector(types.ProposerParams{Selection: c.proposerSelection}, lb.LastBlockID.Hash)
+	if err := lb.ValidatorSet.VerifyProposer(selector, lb.Commit.Round, lb.ProposerAddress); err != nil {
+		return ErrInvalidHeader{Reason: err}
+	}
+	return nil
+}
+
 // see VerifyHeader
 func (c *Client) verifySequential(
 	ctx context.Context,
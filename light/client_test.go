@@ -18,6 +18,7 @@ import (
 	"github.com/cometbft/cometbft/light/provider"
 	mockp "github.com/cometbft/cometbft/light/provider/mock"
 	dbs "github.com/cometbft/cometbft/light/store/db"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -1210,3 +1211,55 @@ func TestClientErrorsDifferentProposerPriorities(t *testing.T) {
 	// witness left in the list
 	assert.EqualValues(t, 2, len(c.Witnesses()))
 }
+
+func TestClient_ProposerSelection(t *testing.T) {
+	params := types.ProposerParams{Selection: cmtproto.ProposerSelectionHash}
+	// the headers are signed in round 1
+	proposer := types.NewProposerSelector(params, h1.Hash()).Proposer(vals2, 1)
+	other := vals2.Validators[0].Address
+	if other.String() == proposer.Address.String() {
+		other = vals2.Validators[1].Address
+	}
+
+	testCases := []struct {
+		name     string
+		proposer types.Address
+		err      bool
+	}{
+		{"selected proposer", proposer.Address, false},
+		{"other proposer", other, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := *h2.Header
+			header.ProposerAddress = tc.proposer
+			signedHeader := &types.SignedHeader{Header: &header, Commit: keys.signHeader(&header, vals2, 0, len(keys))}
+			node := mockp.New(
+				chainID,
+				map[int64]*types.SignedHeader{1: h1, 2: signedHeader},
+				map[int64]*types.ValidatorSet{1: vals, 2: vals2},
+			)
+
+			c, err := light.NewClient(
+				ctx,
+				chainID,
+				trustOptions,
+				node,
+				[]provider.Provider{node},
+				dbs.New(dbm.NewMemDB(), chainID),
+				light.SequentialVerification(),
+				light.ProposerSelection(params.Selection),
+				light.Logger(log.TestingLogger()),
+			)
+			require.NoError(t, err)
+
+			_, err = c.VerifyLightBlockAtHeight(ctx, 2, bTime.Add(2*time.Hour))
+			if tc.err {
+				var errInvalidHeader light.ErrInvalidHeader
+				require.ErrorAs(t, err, &errInvalidHeader)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
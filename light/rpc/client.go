@@ -226,6 +226,14 @@ func (c *Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusSta
 	return c.next.ConsensusState(ctx)
 }
 
+func (c *Client) ConsensusTrace(ctx context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.next.ConsensusTrace(ctx, height)
+}
+
+func (c *Client) ValidatorStats(ctx context.Context, address cmtbytes.HexBytes) (*ctypes.ResultValidatorStats, error) {
+	return c.next.ValidatorStats(ctx, address)
+}
+
 func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	res, err := c.next.ConsensusParams(ctx, height)
 	if err != nil {
@@ -470,6 +478,39 @@ func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommi
 	}, nil
 }
 
+// ExtendedCommit calls rpcclient#ExtendedCommit and then verifies that the
+// extended commit is for the trusted block of its height.
+//
+// NOTE: the vote extensions and their signatures aren't verified.
+func (c *Client) ExtendedCommit(ctx context.Context, height *int64) (*ctypes.ResultExtendedCommit, error) {
+	res, err := c.next.ExtendedCommit(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.ExtendedCommit == nil {
+		return nil, errors.New("missing extended commit")
+	}
+	if res.ExtendedCommit.Height <= 0 {
+		return nil, errNegOrZeroHeight
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.ExtendedCommit.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the extended commit is for the trusted block.
+	if !bytes.Equal(l.Hash(), res.ExtendedCommit.BlockID.Hash) {
+		return nil, fmt.Errorf("extended commit block hash %X does not match trusted block hash %X",
+			res.ExtendedCommit.BlockID.Hash, l.Hash())
+	}
+
+	return res, nil
+}
+
 // Tx calls rpcclient#Tx method and then verifies the proof if such was
 // requested.
 func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
@@ -547,6 +588,14 @@ func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*cty
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+func (c *Client) PendingEvidence(ctx context.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.next.PendingEvidence(ctx, limit)
+}
+
+func (c *Client) CommittedEvidence(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultCommittedEvidence, error) {
+	return c.next.CommittedEvidence(ctx, minHeight, maxHeight)
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int,
 ) (out <-chan ctypes.ResultEvent, err error) {
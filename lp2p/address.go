@@ -1,6 +1,7 @@
 package lp2p
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net"
 	"net/url"
@@ -28,8 +29,9 @@ func IDFromPrivateKey(cosmosPK cmcrypto.PrivKey) (peer.ID, error) {
 }
 
 // AddressToMultiAddr converts a `listenAddress` to a multiaddr for the given transport
-// Currently, only QUIC is supported. Example:
-// "tcp://1.1.1.1:5678" yields to "/ip4/1.1.1.1/udp/5678/quic-v1"
+// (QUIC or TCP). Example:
+// "tcp://1.1.1.1:5678" yields to "/ip4/1.1.1.1/udp/5678/quic-v1" with QUIC
+// and to "/ip4/1.1.1.1/tcp/5678" with TCP
 func AddressToMultiAddr(addr string, transport string) (ma.Multiaddr, error) {
 	if !strings.Contains(addr, "://") {
 		addr = "tcp://" + addr
@@ -45,15 +47,28 @@ func AddressToMultiAddr(addr string, transport string) (ma.Multiaddr, error) {
 		return nil, fmt.Errorf("port is empty")
 	case transport == TransportQUIC:
 		return addrToQuicMultiaddr(parts, layer4UDP)
+	case transport == TransportTCP:
+		return addrToTCPMultiaddr(parts)
 	}
 
 	return nil, fmt.Errorf("unsupported transport: %s", transport)
 }
 
-func AddrInfoFromHostAndID(host, id string) (peer.AddrInfo, error) {
-	addr, err := AddressToMultiAddr(host, TransportQUIC)
-	if err != nil {
-		return peer.AddrInfo{}, fmt.Errorf("failed to convert host to multiaddr: %w", err)
+// AddrInfoFromHostAndID returns the addr info of a peer listening on host
+// with each of the given transports (QUIC if none is given).
+func AddrInfoFromHostAndID(host, id string, transports ...string) (peer.AddrInfo, error) {
+	if len(transports) == 0 {
+		transports = []string{TransportQUIC}
+	}
+
+	addrs := make([]ma.Multiaddr, 0, len(transports))
+	for _, transport := range transports {
+		addr, err := AddressToMultiAddr(host, transport)
+		if err != nil {
+			return peer.AddrInfo{}, fmt.Errorf("failed to convert host to multiaddr: %w", err)
+		}
+
+		addrs = append(addrs, addr)
 	}
 
 	peerID, err := peer.Decode(id)
@@ -61,7 +76,65 @@ func AddrInfoFromHostAndID(host, id string) (peer.AddrInfo, error) {
 		return peer.AddrInfo{}, fmt.Errorf("failed to decode id: %w", err)
 	}
 
-	return peer.AddrInfo{ID: peerID, Addrs: []ma.Multiaddr{addr}}, nil
+	return peer.AddrInfo{ID: peerID, Addrs: addrs}, nil
+}
+
+// AddrInfoFromString parses a peer address, either as a multiaddr ending with
+// the peer ID (e.g. "/ip4/1.2.3.4/udp/26656/quic-v1/p2p/<peer_id>") or in the
+// legacy "<peer_id>@host:port" format, converted to a multiaddr for each of the
+// given transports (QUIC if none is given).
+// Legacy node IDs (hex-encoded address of the node key) are not supported,
+// as the node key can't be derived from them.
+func AddrInfoFromString(addr string, transports ...string) (peer.AddrInfo, error) {
+	if strings.HasPrefix(addr, "/") {
+		addrInfo, err := peer.AddrInfoFromString(addr)
+		if err != nil {
+			return peer.AddrInfo{}, fmt.Errorf("%w: %q: %w", ErrUnsupportedPeerFormat, addr, err)
+		}
+
+		return *addrInfo, nil
+	}
+
+	// "tcp://<peer_id>@host:port" -> "<peer_id>@host:port"
+	if i := strings.Index(addr, "://"); i >= 0 {
+		addr = addr[i+len("://"):]
+	}
+
+	id, host, ok := strings.Cut(addr, "@")
+	if !ok {
+		return peer.AddrInfo{}, fmt.Errorf("%w: %q: expected a multiaddr or <peer_id>@host:port", ErrUnsupportedPeerFormat, addr)
+	}
+
+	addrInfo, err := AddrInfoFromHostAndID(host, id, transports...)
+	if err != nil {
+		return peer.AddrInfo{}, fmt.Errorf("%w: %q: %w", ErrUnsupportedPeerFormat, addr, err)
+	}
+
+	return addrInfo, nil
+}
+
+// PeerIDFromString decodes a libp2p peer ID.
+func PeerIDFromString(id string) (peer.ID, error) {
+	peerID, err := peer.Decode(id)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q: %w", ErrUnsupportedPeerFormat, id, err)
+	}
+
+	return peerID, nil
+}
+
+// IsLegacyPeerAddr reports whether addr is a legacy node ID (hex-encoded
+// address of the node key), alone or as "<id>@host:port". Such addresses are
+// used by the MConnection stack and can't be used by libp2p.
+func IsLegacyPeerAddr(addr string) bool {
+	if i := strings.Index(addr, "://"); i >= 0 {
+		addr = addr[i+len("://"):]
+	}
+	id, _, _ := strings.Cut(addr, "@")
+
+	idBytes, err := hex.DecodeString(id)
+
+	return err == nil && len(idBytes) == p2p.IDByteLength
 }
 
 // IsDNSAddr checks if the given multiaddr is a DNS address.
@@ -118,6 +191,22 @@ func addrToQuicMultiaddr(parts *url.URL, layer4 string) (ma.Multiaddr, error) {
 	return ma.NewMultiaddr(raw)
 }
 
+// addrToTCPMultiaddr converts a given address to a TCP multiaddr
+// example: "tcp://192.0.2.0:65432" -> "/ip4/192.0.2.0/tcp/65432"
+func addrToTCPMultiaddr(parts *url.URL) (ma.Multiaddr, error) {
+	hostname := parts.Hostname()
+
+	networkProto := "dns"
+	if ip := net.ParseIP(hostname); ip != nil {
+		networkProto = "ip6"
+		if ip.To4() != nil {
+			networkProto = "ip4"
+		}
+	}
+
+	return ma.NewMultiaddr(fmt.Sprintf("/%s/%s/%s/%s", networkProto, hostname, TransportTCP, parts.Port()))
+}
+
 // netAddressFromPeer converts a peer.AddrInfo to a p2p.NetAddress
 func netAddressFromPeer(addrInfo peer.AddrInfo) (*p2p.NetAddress, error) {
 	if len(addrInfo.Addrs) == 0 {
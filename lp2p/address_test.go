@@ -59,6 +59,24 @@ func TestAddressToMultiAddr(t *testing.T) {
 			transport: TransportQUIC,
 			want:      "/dns/localhost/udp/5678/quic-v1",
 		},
+		{
+			name:      "tcp",
+			addr:      "tcp://1.1.1.1:5678",
+			transport: TransportTCP,
+			want:      "/ip4/1.1.1.1/tcp/5678",
+		},
+		{
+			name:      "tcp hostname",
+			addr:      "localhost:5678",
+			transport: TransportTCP,
+			want:      "/dns/localhost/tcp/5678",
+		},
+		{
+			name:        "unsupported transport",
+			addr:        "1.1.1.1:5678",
+			transport:   "ws",
+			errContains: "unsupported transport",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			got, err := AddressToMultiAddr(tt.addr, tt.transport)
@@ -168,6 +186,80 @@ func TestAddrInfoFromHostAndID(t *testing.T) {
 	}
 }
 
+func TestAddrInfoFromString(t *testing.T) {
+	const id = "12D3KooWRqqKwyNnjwukrxXTUXLiNK838WN5tc8Nk2DnMVPbpVPV"
+
+	for _, tt := range []struct {
+		name        string
+		addr        string
+		wantAddr    string
+		errContains string
+	}{
+		{
+			name:     "multiaddr",
+			addr:     "/ip4/1.2.3.4/udp/26656/quic-v1/p2p/" + id,
+			wantAddr: "/ip4/1.2.3.4/udp/26656/quic-v1",
+		},
+		{
+			name:     "dns multiaddr",
+			addr:     "/dns/node0.example.com/udp/26656/quic-v1/p2p/" + id,
+			wantAddr: "/dns/node0.example.com/udp/26656/quic-v1",
+		},
+		{
+			name:     "legacy format",
+			addr:     id + "@1.2.3.4:26656",
+			wantAddr: "/ip4/1.2.3.4/udp/26656/quic-v1",
+		},
+		{
+			name:     "legacy format with protocol",
+			addr:     "tcp://" + id + "@1.2.3.4:26656",
+			wantAddr: "/ip4/1.2.3.4/udp/26656/quic-v1",
+		},
+		{
+			name:        "multiaddr without peer ID",
+			addr:        "/ip4/1.2.3.4/udp/26656/quic-v1",
+			errContains: ErrUnsupportedPeerFormat.Error(),
+		},
+		{
+			name:        "legacy node ID",
+			addr:        "d51fb70907db1c6c2d5237e78379b25cf1a37ab4@1.2.3.4:26656",
+			errContains: "failed to decode id",
+		},
+		{
+			name:        "no peer ID",
+			addr:        "1.2.3.4:26656",
+			errContains: ErrUnsupportedPeerFormat.Error(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			addrInfo, err := AddrInfoFromString(tt.addr)
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrUnsupportedPeerFormat)
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, id, addrInfo.ID.String())
+			require.Len(t, addrInfo.Addrs, 1)
+			require.Equal(t, tt.wantAddr, addrInfo.Addrs[0].String())
+		})
+	}
+}
+
+func TestAddrInfoFromStringTransports(t *testing.T) {
+	const id = "12D3KooWRqqKwyNnjwukrxXTUXLiNK838WN5tc8Nk2DnMVPbpVPV"
+
+	addrInfo, err := AddrInfoFromString(id+"@1.2.3.4:26656", TransportTCP, TransportQUIC)
+	require.NoError(t, err)
+	require.Equal(t, "/ip4/1.2.3.4/tcp/26656, /ip4/1.2.3.4/udp/26656/quic-v1", multiAddrStr(addrInfo.Addrs))
+
+	// multiaddrs are used as is
+	addrInfo, err = AddrInfoFromString("/ip4/1.2.3.4/udp/26656/quic-v1/p2p/"+id, TransportTCP)
+	require.NoError(t, err)
+	require.Equal(t, "/ip4/1.2.3.4/udp/26656/quic-v1", multiAddrStr(addrInfo.Addrs))
+}
+
 func TestNetAddressFromPeer(t *testing.T) {
 	peerID, err := IDFromPrivateKey(ed25519.GenPrivKey())
 	require.NoError(t, err)
@@ -282,6 +374,22 @@ func makeTestHostForAddress(t *testing.T, port int) *Host {
 	return host
 }
 
+func TestIsLegacyPeerAddr(t *testing.T) {
+	const (
+		legacyID = "d51fb70907db1c6c2d5237e78379b25cf1a37ab4"
+		id       = "12D3KooWRqqKwyNnjwukrxXTUXLiNK838WN5tc8Nk2DnMVPbpVPV"
+	)
+
+	require.True(t, IsLegacyPeerAddr(legacyID))
+	require.True(t, IsLegacyPeerAddr(legacyID+"@1.2.3.4:26656"))
+	require.True(t, IsLegacyPeerAddr("tcp://"+legacyID+"@1.2.3.4:26656"))
+
+	require.False(t, IsLegacyPeerAddr(id))
+	require.False(t, IsLegacyPeerAddr(id+"@1.2.3.4:26656"))
+	require.False(t, IsLegacyPeerAddr("/ip4/1.2.3.4/udp/26656/quic-v1/p2p/"+id))
+	require.False(t, IsLegacyPeerAddr("d51fb709@1.2.3.4:26656"))
+}
+
 func TestIsDNSAddr(t *testing.T) {
 	for _, tc := range []struct {
 		name   string
@@ -0,0 +1,216 @@
+package lp2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/flowrate"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/go-kit/kit/metrics"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// bandwidthChunkSize is the maximum number of bytes read or written at once
+// by a rate limited stream, so that large payloads are throttled smoothly.
+const bandwidthChunkSize = 4096
+
+// rate limit directions (metric labels)
+const (
+	directionSend = "send"
+	directionRecv = "recv"
+)
+
+// BandwidthLimiter limits the send and receive rates of the streams, per peer
+// and per channel (see config.LibP2PBandwidth), and accounts the bytes sent
+// and received over each protocol. Safe for concurrent use.
+type BandwidthLimiter struct {
+	cfg      config.LibP2PBandwidth
+	channels map[byte]config.LibP2PChannelBandwidth
+
+	mu    sync.Mutex
+	peers map[peer.ID]*peerBandwidth
+}
+
+// peerBandwidth tracks the transfer rates with a peer.
+type peerBandwidth struct {
+	send *flowrate.Monitor
+	recv *flowrate.Monitor
+
+	channels map[byte]*peerBandwidth
+}
+
+// rateLimiter is a transfer rate monitor with its rate limit.
+type rateLimiter struct {
+	monitor *flowrate.Monitor
+	rate    int64
+}
+
+// NewBandwidthLimiter creates a new BandwidthLimiter.
+func NewBandwidthLimiter(cfg config.LibP2PBandwidth) *BandwidthLimiter {
+	channels := make(map[byte]config.LibP2PChannelBandwidth, len(cfg.Channels))
+	for _, ch := range cfg.Channels {
+		channels[byte(ch.Channel)] = ch
+	}
+
+	return &BandwidthLimiter{
+		cfg:      cfg,
+		channels: channels,
+		peers:    make(map[peer.ID]*peerBandwidth),
+	}
+}
+
+// Enabled returns true if any rate limit is set.
+func (b *BandwidthLimiter) Enabled() bool {
+	return b.cfg.Enabled()
+}
+
+// Wrap returns the stream of the given channel with the rate limits applied.
+// Bytes read and written are accounted in the protocol metrics.
+func (b *BandwidthLimiter) Wrap(s network.Stream, chID byte, m *p2p.Metrics) network.Stream {
+	protocolID := string(ProtocolID(chID))
+
+	bs := &bandwidthStream{
+		Stream:    s,
+		sendBytes: m.ProtocolSendBytesTotal.With("protocol", protocolID),
+		recvBytes: m.ProtocolReceiveBytesTotal.With("protocol", protocolID),
+		sendWait:  m.ProtocolRateLimitWaitSeconds.With("protocol", protocolID, "direction", directionSend),
+		recvWait:  m.ProtocolRateLimitWaitSeconds.With("protocol", protocolID, "direction", directionRecv),
+	}
+
+	if b.Enabled() {
+		bs.send, bs.recv = b.limiters(s.Conn().RemotePeer(), chID)
+	}
+
+	return bs
+}
+
+// RemovePeer forgets the transfer rates with the peer.
+func (b *BandwidthLimiter) RemovePeer(id peer.ID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.peers, id)
+}
+
+// limiters returns the send and receive rate limiters of the peer's channel.
+func (b *BandwidthLimiter) limiters(id peer.ID, chID byte) (send, recv []rateLimiter) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	pb, ok := b.peers[id]
+	if !ok {
+		pb = newPeerBandwidth()
+		b.peers[id] = pb
+	}
+
+	send, recv = appendLimiters(send, recv, pb, b.cfg.SendRate, b.cfg.RecvRate)
+
+	ch, ok := b.channels[chID]
+	if !ok {
+		return send, recv
+	}
+
+	cb, ok := pb.channels[chID]
+	if !ok {
+		cb = newPeerBandwidth()
+		pb.channels[chID] = cb
+	}
+
+	return appendLimiters(send, recv, cb, ch.SendRate, ch.RecvRate)
+}
+
+func newPeerBandwidth() *peerBandwidth {
+	return &peerBandwidth{
+		send:     flowrate.New(0, 0),
+		recv:     flowrate.New(0, 0),
+		channels: make(map[byte]*peerBandwidth),
+	}
+}
+
+func appendLimiters(send, recv []rateLimiter, pb *peerBandwidth, sendRate, recvRate int64) ([]rateLimiter, []rateLimiter) {
+	if sendRate > 0 {
+		send = append(send, rateLimiter{monitor: pb.send, rate: sendRate})
+	}
+
+	if recvRate > 0 {
+		recv = append(recv, rateLimiter{monitor: pb.recv, rate: recvRate})
+	}
+
+	return send, recv
+}
+
+// bandwidthStream is a rate limited and accounted stream.
+type bandwidthStream struct {
+	network.Stream
+
+	send []rateLimiter
+	recv []rateLimiter
+
+	sendBytes metrics.Counter
+	recvBytes metrics.Counter
+	sendWait  metrics.Counter
+	recvWait  metrics.Counter
+}
+
+func (s *bandwidthStream) Write(p []byte) (int, error) {
+	written := 0
+
+	for written < len(p) {
+		want := min(len(p)-written, bandwidthChunkSize)
+		allowed := waitRateLimits(s.send, want, s.sendWait)
+
+		n, err := s.Stream.Write(p[written : written+allowed])
+		written += n
+
+		updateRateLimits(s.send, n)
+		s.sendBytes.Add(float64(n))
+
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+func (s *bandwidthStream) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return s.Stream.Read(p)
+	}
+
+	want := min(len(p), bandwidthChunkSize)
+	allowed := waitRateLimits(s.recv, want, s.recvWait)
+
+	n, err := s.Stream.Read(p[:allowed])
+
+	updateRateLimits(s.recv, n)
+	s.recvBytes.Add(float64(n))
+
+	return n, err
+}
+
+// waitRateLimits blocks until some bytes can be transferred
+// and returns how many (at most want).
+func waitRateLimits(limiters []rateLimiter, want int, waitSeconds metrics.Counter) int {
+	if len(limiters) == 0 {
+		return want
+	}
+
+	start := time.Now()
+
+	for _, l := range limiters {
+		want = l.monitor.Limit(want, l.rate, true)
+	}
+
+	waitSeconds.Add(time.Since(start).Seconds())
+
+	return want
+}
+
+func updateRateLimits(limiters []rateLimiter, n int) {
+	for _, l := range limiters {
+		l.monitor.Update(n)
+	}
+}
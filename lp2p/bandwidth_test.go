@@ -0,0 +1,178 @@
+package lp2p
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBandwidthLimiter(t *testing.T) {
+	const (
+		peerID      = peer.ID("peer")
+		mempoolCh   = byte(0x30)
+		consensusCh = byte(0x20)
+	)
+
+	cfg := config.LibP2PBandwidth{
+		SendRate: 100_000,
+		Channels: []config.LibP2PChannelBandwidth{
+			{Channel: int(mempoolCh), SendRate: 10_000, RecvRate: 20_000},
+		},
+	}
+
+	t.Run("limiters", func(t *testing.T) {
+		// ARRANGE
+		b := NewBandwidthLimiter(cfg)
+
+		// ACT
+		mempoolSend, mempoolRecv := b.limiters(peerID, mempoolCh)
+		consensusSend, consensusRecv := b.limiters(peerID, consensusCh)
+
+		// ASSERT
+		require.True(t, b.Enabled())
+
+		// the peer's send rate applies to all channels
+		require.Len(t, mempoolSend, 2)
+		require.Len(t, consensusSend, 1)
+		require.Same(t, mempoolSend[0].monitor, consensusSend[0].monitor)
+		require.Equal(t, int64(100_000), mempoolSend[0].rate)
+		require.Equal(t, int64(10_000), mempoolSend[1].rate)
+
+		// only the mempool channel limits the receive rate
+		require.Len(t, mempoolRecv, 1)
+		require.Equal(t, int64(20_000), mempoolRecv[0].rate)
+		require.Empty(t, consensusRecv)
+
+		// the monitors are forgotten once the peer is removed
+		b.RemovePeer(peerID)
+		afterRemoval, _ := b.limiters(peerID, consensusCh)
+		require.NotSame(t, consensusSend[0].monitor, afterRemoval[0].monitor)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		b := NewBandwidthLimiter(config.DefaultLibP2PBandwidth())
+		require.False(t, b.Enabled())
+	})
+
+	t.Run("throttlesAndAccounts", func(t *testing.T) {
+		// ARRANGE
+		const rate = 20_000
+
+		var (
+			b         = NewBandwidthLimiter(config.LibP2PBandwidth{SendRate: rate})
+			sendBytes = generic.NewCounter("send")
+			recvBytes = generic.NewCounter("recv")
+			sendWait  = generic.NewCounter("send_wait")
+			recvWait  = generic.NewCounter("recv_wait")
+			buf       = &bytes.Buffer{}
+			payload   = bytes.Repeat([]byte{1}, rate/2)
+		)
+
+		send, recv := b.limiters(peerID, mempoolCh)
+
+		s := &bandwidthStream{
+			Stream:    &bufferStream{buf: buf},
+			send:      send,
+			recv:      recv,
+			sendBytes: sendBytes,
+			recvBytes: recvBytes,
+			sendWait:  sendWait,
+			recvWait:  recvWait,
+		}
+
+		// ACT
+		start := time.Now()
+		n, err := s.Write(payload)
+		elapsed := time.Since(start)
+		written := bytes.Clone(buf.Bytes())
+
+		readBuf := make([]byte, len(payload))
+		read, readErr := s.Read(readBuf)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, len(payload), n)
+		require.Equal(t, payload, written)
+		require.GreaterOrEqual(t, elapsed, 300*time.Millisecond)
+
+		require.NoError(t, readErr)
+		require.Equal(t, bandwidthChunkSize, read)
+
+		require.Equal(t, float64(len(payload)), sendBytes.Value())
+		require.Equal(t, float64(read), recvBytes.Value())
+		require.Greater(t, sendWait.Value(), 0.0)
+		require.Zero(t, recvWait.Value())
+	})
+}
+
+func TestSwitchBandwidth(t *testing.T) {
+	// ARRANGE
+	const channelID = 0xF1
+
+	channelDescriptor := &conn.ChannelDescriptor{
+		ID:                  channelID,
+		Priority:            1,
+		RecvMessageCapacity: 1 << 20,
+		MessageType:         &types.RequestEcho{},
+	}
+
+	// Given a host receiving at most 50kB/s on the channel
+	hosts := makeTestHosts(t, 2, withLogging(), withModifiedConfig(func(cfg *config.LibP2PConfig) {
+		cfg.Bandwidth.Channels = []config.LibP2PChannelBandwidth{
+			{Channel: channelID, RecvRate: 50_000},
+		}
+	}))
+
+	switchMaker := func(host *Host) (*Switch, *reactorMock) {
+		reactor := newReactorMock([]*conn.ChannelDescriptor{channelDescriptor}, host.Logger())
+		sw, err := NewSwitch(nil, host, []SwitchReactor{{Name: "echo", Reactor: reactor}}, p2p.NopMetrics(), host.Logger())
+		require.NoError(t, err)
+
+		return sw, reactor
+	}
+
+	switchA, _ := switchMaker(hosts[0])
+	switchB, reactorB := switchMaker(hosts[1])
+
+	connectSwitches(t, []*Switch{switchA, switchB})
+
+	peerB := switchA.Peers().Get(peerIDToKey(hosts[1].ID()))
+	require.NotNil(t, peerB)
+
+	// ACT
+	// 4 messages of 25kB
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		require.True(t, peerB.Send(p2p.Envelope{
+			ChannelID: channelID,
+			Message:   &types.RequestEcho{Message: string(bytes.Repeat([]byte{'a'}, 25_000))},
+		}))
+	}
+
+	// ASSERT
+	require.Eventually(t, func() bool {
+		return len(reactorB.receivedEnvelopes()) == 4
+	}, 10*time.Second, 50*time.Millisecond)
+
+	// 100kB at 50kB/s
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+// bufferStream is a stream reading and writing a buffer.
+type bufferStream struct {
+	network.Stream
+	buf *bytes.Buffer
+}
+
+func (s *bufferStream) Write(p []byte) (int, error) { return s.buf.Write(p) }
+
+func (s *bufferStream) Read(p []byte) (int, error) { return s.buf.Read(p) }
@@ -0,0 +1,759 @@
+package lp2p
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/log"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+)
+
+// BridgeSwitch is a p2p.Switcher running the legacy (MConnection) switch and
+// the libp2p switch simultaneously, so that a network can migrate to libp2p
+// incrementally: nodes running the bridge talk to legacy nodes over
+// MConnection and to migrated nodes over libp2p.
+//
+// Each reactor is added to both switches through adapters, and sees a unified
+// peer set. A node connected over both stacks is identified by its CometBFT
+// node ID (both stacks derive their IDs from the same node key) and presented
+// to reactors once: the first connection is active, the other one is kept on
+// standby and promoted if the active one drops. Messages received over the
+// standby connection are delivered as if received from the active peer.
+//
+// Peer addresses and IDs are routed to the stack matching their format:
+// "<hex id>@host:port" to the legacy switch; multiaddrs and libp2p peer IDs
+// to the libp2p switch.
+type BridgeSwitch struct {
+	service.BaseService
+
+	legacy *p2p.Switch
+	lp2p   *Switch
+
+	peers *bridgePeerSet
+
+	mu       sync.RWMutex
+	reactors map[string]*bridgeReactor
+}
+
+var (
+	_ p2p.Switcher   = (*BridgeSwitch)(nil)
+	_ p2p.PeerBanner = (*BridgeSwitch)(nil)
+)
+
+// stacks of the bridge switch
+const (
+	bridgeStackLegacy = "legacy"
+	bridgeStackLibP2P = "lp2p"
+)
+
+// NewBridgeSwitch constructs a new BridgeSwitch. The legacy switch must not
+// have reactors other than PEX, which only runs on the legacy stack.
+func NewBridgeSwitch(
+	legacy *p2p.Switch,
+	nodeInfo p2p.NodeInfo,
+	host *Host,
+	reactors []SwitchReactor,
+	metrics *p2p.Metrics,
+	logger log.Logger,
+) (*BridgeSwitch, error) {
+	bs := &BridgeSwitch{
+		legacy:   legacy,
+		peers:    newBridgePeerSet(),
+		reactors: make(map[string]*bridgeReactor, len(reactors)),
+	}
+
+	bs.BaseService = *service.NewBaseService(logger, "Bridge Switch", bs)
+
+	lp2pReactors := make([]SwitchReactor, 0, len(reactors))
+
+	for _, item := range reactors {
+		br := bs.newBridgeReactor(item.Name, item.Reactor)
+
+		legacy.AddReactor(item.Name, br.adapter(bridgeStackLegacy))
+		lp2pReactors = append(lp2pReactors, SwitchReactor{Name: item.Name, Reactor: br.adapter(bridgeStackLibP2P)})
+	}
+
+	lp2pSwitch, err := NewSwitch(nodeInfo, host, lp2pReactors, metrics, logger.With("stack", "lp2p"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create libp2p switch")
+	}
+
+	bs.lp2p = lp2pSwitch
+
+	return bs, nil
+}
+
+// Legacy returns the legacy switch.
+func (bs *BridgeSwitch) Legacy() *p2p.Switch { return bs.legacy }
+
+// LibP2P returns the libp2p switch.
+func (bs *BridgeSwitch) LibP2P() *Switch { return bs.lp2p }
+
+//--------------------------------
+// BaseService methods
+//--------------------------------
+
+func (bs *BridgeSwitch) OnStart() error {
+	bs.Logger.Info("Starting bridge switch")
+
+	// reactors are shared by both switches, which only start their adapters
+	for name, r := range bs.reactorsCopy() {
+		if err := r.reactor.Start(); err != nil {
+			return fmt.Errorf("failed to start reactor %s: %w", name, err)
+		}
+	}
+
+	if err := bs.legacy.Start(); err != nil {
+		return fmt.Errorf("failed to start legacy switch: %w", err)
+	}
+
+	if err := bs.lp2p.Start(); err != nil {
+		return fmt.Errorf("failed to start libp2p switch: %w", err)
+	}
+
+	return nil
+}
+
+func (bs *BridgeSwitch) OnStop() {
+	bs.Logger.Info("Stopping bridge switch")
+
+	if err := bs.lp2p.Stop(); err != nil {
+		bs.Logger.Error("Failed to stop libp2p switch", "err", err)
+	}
+
+	if err := bs.legacy.Stop(); err != nil {
+		bs.Logger.Error("Failed to stop legacy switch", "err", err)
+	}
+
+	for name, r := range bs.reactorsCopy() {
+		if err := r.reactor.Stop(); err != nil {
+			bs.Logger.Error("Failed to stop reactor", "name", name, "err", err)
+		}
+	}
+}
+
+func (bs *BridgeSwitch) NodeInfo() p2p.NodeInfo {
+	return bs.legacy.NodeInfo()
+}
+
+func (bs *BridgeSwitch) Log() log.Logger {
+	return bs.Logger
+}
+
+//--------------------------------
+// ReactorManager methods
+//--------------------------------
+
+func (bs *BridgeSwitch) Reactor(name string) (p2p.Reactor, bool) {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	r, ok := bs.reactors[name]
+	if !ok {
+		return nil, false
+	}
+
+	return r.reactor, true
+}
+
+// AddReactor adds a reactor to both switches, each through its own adapter.
+// Note the libp2p switch ignores reactors added after its creation for now,
+// so until it supports them, such a reactor only sees the peers connected
+// over the legacy stack. Reactors passed to NewBridgeSwitch see both.
+func (bs *BridgeSwitch) AddReactor(name string, reactor p2p.Reactor) p2p.Reactor {
+	br := bs.newBridgeReactor(name, reactor)
+
+	bs.legacy.AddReactor(name, br.adapter(bridgeStackLegacy))
+	bs.lp2p.AddReactor(name, br.adapter(bridgeStackLibP2P))
+
+	return reactor
+}
+
+func (bs *BridgeSwitch) RemoveReactor(name string, reactor p2p.Reactor) {
+	bs.mu.Lock()
+	br, ok := bs.reactors[name]
+	delete(bs.reactors, name)
+	bs.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	bs.legacy.RemoveReactor(name, br.adapters[bridgeStackLegacy])
+	bs.lp2p.RemoveReactor(name, br.adapters[bridgeStackLibP2P])
+}
+
+func (bs *BridgeSwitch) newBridgeReactor(name string, reactor p2p.Reactor) *bridgeReactor {
+	br := &bridgeReactor{
+		name:     name,
+		reactor:  reactor,
+		peers:    bs.peers,
+		active:   make(map[p2p.ID]*bridgeReactorPeer),
+		adapters: make(map[string]*bridgeReactorAdapter, 2),
+	}
+
+	bs.mu.Lock()
+	bs.reactors[name] = br
+	bs.mu.Unlock()
+
+	reactor.SetSwitch(bs)
+
+	return br
+}
+
+func (bs *BridgeSwitch) reactorsCopy() map[string]*bridgeReactor {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	reactors := make(map[string]*bridgeReactor, len(bs.reactors))
+	for name, r := range bs.reactors {
+		reactors[name] = r
+	}
+
+	return reactors
+}
+
+//--------------------------------
+// PeerManager methods
+//--------------------------------
+
+// Peers returns the deduplicated peers of both switches.
+func (bs *BridgeSwitch) Peers() p2p.IPeerSet {
+	return bs.peers
+}
+
+// NumPeers returns the number of deduplicated peers. Dialing peers are only counted on the legacy switch.
+func (bs *BridgeSwitch) NumPeers() (outbound, inbound, dialing int) {
+	bs.peers.ForEach(func(p p2p.Peer) {
+		if p.IsOutbound() {
+			outbound++
+		} else {
+			inbound++
+		}
+	})
+
+	_, _, dialing = bs.legacy.NumPeers()
+
+	return outbound, inbound, dialing
+}
+
+// MaxNumOutboundPeers returns the legacy switch's limit, used by PEX.
+func (bs *BridgeSwitch) MaxNumOutboundPeers() int {
+	return bs.legacy.MaxNumOutboundPeers()
+}
+
+func (bs *BridgeSwitch) AddPersistentPeers(addrs []string) error {
+	legacy, lp2p := splitBridgeAddrs(addrs)
+
+	if err := bs.legacy.AddPersistentPeers(legacy); err != nil {
+		return err
+	}
+
+	return bs.lp2p.AddPersistentPeers(lp2p)
+}
+
+func (bs *BridgeSwitch) AddPrivatePeerIDs(ids []string) error {
+	legacy, lp2p := splitBridgeAddrs(ids)
+
+	if err := bs.legacy.AddPrivatePeerIDs(legacy); err != nil {
+		return err
+	}
+
+	return bs.lp2p.AddPrivatePeerIDs(lp2p)
+}
+
+func (bs *BridgeSwitch) AddUnconditionalPeerIDs(ids []string) error {
+	legacy, lp2p := splitBridgeAddrs(ids)
+
+	if err := bs.legacy.AddUnconditionalPeerIDs(legacy); err != nil {
+		return err
+	}
+
+	return bs.lp2p.AddUnconditionalPeerIDs(lp2p)
+}
+
+func (bs *BridgeSwitch) DialPeerWithAddress(addr *p2p.NetAddress) error {
+	return bs.legacy.DialPeerWithAddress(addr)
+}
+
+func (bs *BridgeSwitch) DialPeersAsync(peers []string) error {
+	legacy, lp2p := splitBridgeAddrs(peers)
+
+	if err := bs.legacy.DialPeersAsync(legacy); err != nil {
+		return err
+	}
+
+	return bs.lp2p.DialPeersAsync(lp2p)
+}
+
+func (bs *BridgeSwitch) StopPeerForError(peer p2p.Peer, reason any) {
+	bs.switchOf(peer).StopPeerForError(peer, reason)
+}
+
+func (bs *BridgeSwitch) StopPeerGracefully(peer p2p.Peer) {
+	bs.switchOf(peer).StopPeerGracefully(peer)
+}
+
+func (bs *BridgeSwitch) IsDialingOrExistingAddress(addr *p2p.NetAddress) bool {
+	return bs.legacy.IsDialingOrExistingAddress(addr)
+}
+
+func (bs *BridgeSwitch) IsPeerPersistent(addr *p2p.NetAddress) bool {
+	return bs.legacy.IsPeerPersistent(addr) || bs.lp2p.IsPeerPersistent(addr)
+}
+
+func (bs *BridgeSwitch) IsPeerUnconditional(id p2p.ID) bool {
+	if isLegacyID(string(id)) {
+		return bs.legacy.IsPeerUnconditional(id)
+	}
+
+	return bs.lp2p.IsPeerUnconditional(id)
+}
+
+func (bs *BridgeSwitch) MarkPeerAsGood(peer p2p.Peer) {
+	bs.switchOf(peer).MarkPeerAsGood(peer)
+}
+
+// BannedPeers returns the peers banned by the libp2p switch.
+func (bs *BridgeSwitch) BannedPeers() []p2p.BannedPeer {
+	return bs.lp2p.BannedPeers()
+}
+
+// UnbanPeer lifts the ban of a peer banned by the libp2p switch.
+func (bs *BridgeSwitch) UnbanPeer(id p2p.ID) (bool, error) {
+	return bs.lp2p.UnbanPeer(id)
+}
+
+// switchOf returns the switch the peer is connected to.
+func (bs *BridgeSwitch) switchOf(peer p2p.Peer) p2p.Switcher {
+	if _, ok := peer.(*Peer); ok {
+		return bs.lp2p
+	}
+
+	return bs.legacy
+}
+
+//--------------------------------
+// Broadcaster methods
+//--------------------------------
+
+func (bs *BridgeSwitch) BroadcastAsync(e p2p.Envelope) {
+	bs.Logger.Debug("BroadcastAsync", "channel", e.ChannelID)
+
+	bs.peers.ForEach(func(p p2p.Peer) {
+		go p.Send(e)
+	})
+}
+
+func (bs *BridgeSwitch) TryBroadcast(e p2p.Envelope) {
+	bs.Logger.Debug("TryBroadcast", "channel", e.ChannelID)
+
+	bs.peers.ForEach(func(p p2p.Peer) {
+		go p.TrySend(e)
+	})
+}
+
+// splitBridgeAddrs splits peer addresses or IDs into legacy and libp2p ones.
+func splitBridgeAddrs(addrs []string) (legacy, lp2p []string) {
+	for _, addr := range addrs {
+		if isLegacyAddr(addr) {
+			legacy = append(legacy, addr)
+		} else {
+			lp2p = append(lp2p, addr)
+		}
+	}
+
+	return legacy, lp2p
+}
+
+// isLegacyAddr checks if the address (or ID) is a legacy "[scheme://]<hex id>[@host:port]" one.
+func isLegacyAddr(addr string) bool {
+	if strings.HasPrefix(addr, "/") {
+		return false
+	}
+
+	if i := strings.Index(addr, "://"); i >= 0 {
+		addr = addr[i+3:]
+	}
+
+	id, _, _ := strings.Cut(addr, "@")
+
+	return isLegacyID(id)
+}
+
+func isLegacyID(id string) bool {
+	bz, err := hex.DecodeString(id)
+
+	return err == nil && len(bz) == p2p.IDByteLength
+}
+
+//--------------------------------
+// Peer set
+//--------------------------------
+
+// bridgePeerSet is the deduplicated peer set of the bridge switch.
+// Peers are keyed by their CometBFT node ID.
+type bridgePeerSet struct {
+	mu    sync.RWMutex
+	slots map[p2p.ID]*bridgeSlot
+
+	// keys maps peer IDs (legacy or libp2p) to CometBFT node IDs
+	keys map[p2p.ID]p2p.ID
+}
+
+// bridgeSlot holds the connections to a node.
+type bridgeSlot struct {
+	active  p2p.Peer
+	standby p2p.Peer
+
+	// added peers have been started by their switch
+	added map[p2p.Peer]struct{}
+}
+
+var _ p2p.IPeerSet = (*bridgePeerSet)(nil)
+
+func newBridgePeerSet() *bridgePeerSet {
+	return &bridgePeerSet{
+		slots: make(map[p2p.ID]*bridgeSlot),
+		keys:  make(map[p2p.ID]p2p.ID),
+	}
+}
+
+// key returns the CometBFT node ID of the peer.
+func (ps *bridgePeerSet) key(p p2p.Peer) p2p.ID {
+	ps.mu.RLock()
+	key, ok := ps.keys[p.ID()]
+	ps.mu.RUnlock()
+
+	if ok {
+		return key
+	}
+
+	return bridgeKey(p.ID())
+}
+
+// admit registers the peer and returns true if it's the active connection to the node.
+// Idempotent.
+func (ps *bridgePeerSet) admit(p p2p.Peer) bool {
+	key := ps.key(p)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	ps.keys[p.ID()] = key
+
+	slot, ok := ps.slots[key]
+	switch {
+	case !ok:
+		ps.slots[key] = &bridgeSlot{active: p, added: make(map[p2p.Peer]struct{})}
+		return true
+	case slot.active == p:
+		return true
+	case slot.standby == p:
+		return false
+	case slot.active == nil:
+		slot.active = p
+		return true
+	default:
+		slot.standby = p
+		return false
+	}
+}
+
+// markAdded marks the peer as started by its switch.
+func (ps *bridgePeerSet) markAdded(p p2p.Peer) {
+	key := ps.key(p)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if slot, ok := ps.slots[key]; ok {
+		slot.added[p] = struct{}{}
+	}
+}
+
+// remove removes the peer, promoting the standby connection if the peer was active.
+// Idempotent.
+func (ps *bridgePeerSet) remove(p p2p.Peer) {
+	key := ps.key(p)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	slot, ok := ps.slots[key]
+	if !ok {
+		return
+	}
+
+	delete(slot.added, p)
+
+	switch p {
+	case slot.active:
+		slot.active, slot.standby = slot.standby, nil
+	case slot.standby:
+		slot.standby = nil
+	default:
+		return
+	}
+
+	delete(ps.keys, p.ID())
+
+	if slot.active == nil {
+		delete(ps.slots, key)
+	}
+}
+
+// active returns the active connection to the node and whether it was started.
+func (ps *bridgePeerSet) active(key p2p.ID) (p2p.Peer, bool) {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	slot, ok := ps.slots[key]
+	if !ok || slot.active == nil {
+		return nil, false
+	}
+
+	_, added := slot.added[slot.active]
+
+	return slot.active, added
+}
+
+func (ps *bridgePeerSet) Has(key p2p.ID) bool {
+	return ps.Get(key) != nil
+}
+
+// Get returns the active peer of the node. The key is either a legacy or a libp2p peer ID.
+func (ps *bridgePeerSet) Get(key p2p.ID) p2p.Peer {
+	p, _ := ps.active(bridgeKey(key))
+	return p
+}
+
+func (ps *bridgePeerSet) Copy() []p2p.Peer {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	peers := make([]p2p.Peer, 0, len(ps.slots))
+	for _, slot := range ps.slots {
+		peers = append(peers, slot.active)
+	}
+
+	return peers
+}
+
+func (ps *bridgePeerSet) Size() int {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	return len(ps.slots)
+}
+
+func (ps *bridgePeerSet) ForEach(fn func(p2p.Peer)) {
+	for _, p := range ps.Copy() {
+		fn(p)
+	}
+}
+
+func (ps *bridgePeerSet) Random() p2p.Peer {
+	peers := ps.Copy()
+	if len(peers) == 0 {
+		return nil
+	}
+
+	return peers[cmtrand.Int()%len(peers)]
+}
+
+// bridgeKey converts a libp2p peer ID to the CometBFT node ID.
+// Legacy IDs (and IDs that can't be converted) are returned as is.
+func bridgeKey(id p2p.ID) p2p.ID {
+	if isLegacyID(string(id)) {
+		return id
+	}
+
+	pid, err := peer.Decode(string(id))
+	if err != nil {
+		return id
+	}
+
+	key, err := CometIDFromPeerID(pid)
+	if err != nil {
+		return id
+	}
+
+	return key
+}
+
+//--------------------------------
+// Reactors
+//--------------------------------
+
+// bridgeReactor connects a reactor to both switches.
+// It keeps the reactor's view of the active peer of each node.
+type bridgeReactor struct {
+	name    string
+	reactor p2p.Reactor
+	peers   *bridgePeerSet
+
+	mu     sync.RWMutex
+	active map[p2p.ID]*bridgeReactorPeer
+
+	adapters map[string]*bridgeReactorAdapter
+}
+
+type bridgeReactorPeer struct {
+	peer  p2p.Peer
+	added bool
+}
+
+// adapter returns the reactor adapter added to the switch of the given stack.
+func (br *bridgeReactor) adapter(stack string) *bridgeReactorAdapter {
+	a := &bridgeReactorAdapter{bridge: br}
+	a.BaseReactor = *p2p.NewBaseReactor(fmt.Sprintf("Bridge(%s/%s)", br.name, stack), a)
+
+	br.adapters[stack] = a
+
+	return a
+}
+
+func (br *bridgeReactor) initPeer(p p2p.Peer) p2p.Peer {
+	if !br.peers.admit(p) {
+		// standby connection
+		return p
+	}
+
+	key := br.peers.key(p)
+
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	// the previous connection was replaced before its removal reached this reactor
+	if prev, ok := br.active[key]; ok && prev.peer != p {
+		br.reactor.RemovePeer(prev.peer, "replaced by a new connection")
+	}
+
+	br.active[key] = &bridgeReactorPeer{peer: p}
+
+	return br.reactor.InitPeer(p)
+}
+
+func (br *bridgeReactor) addPeer(p p2p.Peer) {
+	br.peers.markAdded(p)
+
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	rp, ok := br.active[br.peers.key(p)]
+	if !ok || rp.peer != p || rp.added {
+		return
+	}
+
+	rp.added = true
+	br.reactor.AddPeer(p)
+}
+
+func (br *bridgeReactor) removePeer(p p2p.Peer, reason any) {
+	key := br.peers.key(p)
+
+	br.peers.remove(p)
+
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	rp, ok := br.active[key]
+	if !ok || rp.peer != p {
+		// standby connection
+		return
+	}
+
+	delete(br.active, key)
+	br.reactor.RemovePeer(p, reason)
+
+	// promote the standby connection, if any
+	next, added := br.peers.active(key)
+	if next == nil {
+		return
+	}
+
+	br.active[key] = &bridgeReactorPeer{peer: next, added: added}
+	br.reactor.InitPeer(next)
+
+	// otherwise, added once started by its switch
+	if added {
+		br.reactor.AddPeer(next)
+	}
+}
+
+// activePeer returns the active peer of the node the given peer is connected to.
+func (br *bridgeReactor) activePeer(p p2p.Peer) (p2p.Peer, bool) {
+	key := br.peers.key(p)
+
+	br.mu.RLock()
+	defer br.mu.RUnlock()
+
+	rp, ok := br.active[key]
+	if !ok {
+		return nil, false
+	}
+
+	return rp.peer, true
+}
+
+func (br *bridgeReactor) receive(e p2p.Envelope) {
+	src, ok := br.activePeer(e.Src)
+	if !ok {
+		// should not happen
+		return
+	}
+
+	e.Src = src
+	br.reactor.Receive(e)
+}
+
+func (br *bridgeReactor) filterMsgBytes(chID byte, src p2p.Peer, msgBytes []byte) error {
+	f, ok := br.reactor.(p2p.MsgBytesFilter)
+	if !ok {
+		return nil
+	}
+
+	if active, ok := br.activePeer(src); ok {
+		src = active
+	}
+
+	return f.FilterMsgBytes(chID, src, msgBytes)
+}
+
+// bridgeReactorAdapter is the reactor added to the legacy or libp2p switch.
+// Its lifecycle (Start/Stop/SetSwitch) is independent of the bridged reactor,
+// which is started by the bridge switch.
+type bridgeReactorAdapter struct {
+	p2p.BaseReactor
+
+	bridge *bridgeReactor
+}
+
+var (
+	_ p2p.Reactor        = (*bridgeReactorAdapter)(nil)
+	_ p2p.MsgBytesFilter = (*bridgeReactorAdapter)(nil)
+)
+
+func (a *bridgeReactorAdapter) GetChannels() []*conn.ChannelDescriptor {
+	return a.bridge.reactor.GetChannels()
+}
+
+func (a *bridgeReactorAdapter) InitPeer(p p2p.Peer) p2p.Peer { return a.bridge.initPeer(p) }
+
+func (a *bridgeReactorAdapter) AddPeer(p p2p.Peer) { a.bridge.addPeer(p) }
+
+func (a *bridgeReactorAdapter) RemovePeer(p p2p.Peer, reason any) { a.bridge.removePeer(p, reason) }
+
+func (a *bridgeReactorAdapter) Receive(e p2p.Envelope) { a.bridge.receive(e) }
+
+func (a *bridgeReactorAdapter) FilterMsgBytes(chID byte, src p2p.Peer, msgBytes []byte) error {
+	return a.bridge.filterMsgBytes(chID, src, msgBytes)
+}
@@ -0,0 +1,286 @@
+package lp2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/cometbft/cometbft/p2p/mock"
+	"github.com/cometbft/cometbft/test/utils"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeSwitch(t *testing.T) {
+	const channelID = 0xF1
+
+	channelDescriptor := &conn.ChannelDescriptor{
+		ID:                  channelID,
+		Priority:            1,
+		RecvMessageCapacity: 1024,
+		MessageType:         &types.RequestEcho{},
+	}
+
+	t.Run("Dedup", func(t *testing.T) {
+		// ARRANGE
+		var (
+			bs      = newTestBridgeSwitch()
+			reactor = newReactorMock([]*conn.ChannelDescriptor{channelDescriptor}, log.NewNopLogger())
+			br      = bs.newBridgeReactor("echo", reactor)
+			legacy  = br.adapter(bridgeStackLegacy)
+			libp2p  = br.adapter(bridgeStackLibP2P)
+		)
+
+		// Given a node connected over both stacks
+		legacyPeer, lp2pPeer := newBridgeTestPeers(t)
+		key := legacyPeer.ID()
+
+		// ACT
+		legacy.InitPeer(legacyPeer)
+		legacy.AddPeer(legacyPeer)
+
+		libp2p.InitPeer(lp2pPeer)
+		libp2p.AddPeer(lp2pPeer)
+
+		libp2p.Receive(p2p.Envelope{Src: lp2pPeer, ChannelID: channelID, Message: &types.RequestEcho{}})
+
+		// ASSERT
+		// the reactor only sees the first connection
+		require.Equal(t, []p2p.Peer{legacyPeer}, reactor.initPeers)
+		require.Equal(t, []p2p.Peer{legacyPeer}, reactor.addPeers)
+
+		require.Equal(t, 1, bs.Peers().Size())
+		require.Equal(t, legacyPeer, bs.Peers().Get(key))
+		require.Equal(t, legacyPeer, bs.Peers().Get(lp2pPeer.ID()))
+
+		// messages received over the standby connection come from the active peer
+		received := reactor.receivedEnvelopes()
+		require.Len(t, received, 1)
+		require.Equal(t, legacyPeer, received[0].Src)
+	})
+
+	t.Run("PromoteStandby", func(t *testing.T) {
+		// ARRANGE
+		var (
+			bs      = newTestBridgeSwitch()
+			reactor = newReactorMock([]*conn.ChannelDescriptor{channelDescriptor}, log.NewNopLogger())
+			br      = bs.newBridgeReactor("echo", reactor)
+			legacy  = br.adapter(bridgeStackLegacy)
+			libp2p  = br.adapter(bridgeStackLibP2P)
+		)
+
+		legacyPeer, lp2pPeer := newBridgeTestPeers(t)
+		key := legacyPeer.ID()
+
+		legacy.InitPeer(legacyPeer)
+		legacy.AddPeer(legacyPeer)
+		libp2p.InitPeer(lp2pPeer)
+		libp2p.AddPeer(lp2pPeer)
+
+		// ACT (1): the active connection drops
+		legacy.RemovePeer(legacyPeer, "connection closed")
+
+		// ASSERT (1): the standby connection is promoted
+		require.Equal(t, []p2p.Peer{legacyPeer}, reactor.removedPeers)
+		require.Equal(t, []p2p.Peer{legacyPeer, lp2pPeer}, reactor.initPeers)
+		require.Equal(t, []p2p.Peer{legacyPeer, lp2pPeer}, reactor.addPeers)
+		require.Equal(t, lp2pPeer, bs.Peers().Get(key))
+
+		// ACT (2): the last connection drops
+		libp2p.RemovePeer(lp2pPeer, "connection closed")
+
+		// ASSERT (2)
+		require.Equal(t, []p2p.Peer{legacyPeer, lp2pPeer}, reactor.removedPeers)
+		require.Equal(t, 0, bs.Peers().Size())
+		require.Nil(t, bs.Peers().Get(key))
+	})
+
+	t.Run("PromoteStandbyBeforeAdded", func(t *testing.T) {
+		// ARRANGE
+		var (
+			bs      = newTestBridgeSwitch()
+			reactor = newReactorMock([]*conn.ChannelDescriptor{channelDescriptor}, log.NewNopLogger())
+			br      = bs.newBridgeReactor("echo", reactor)
+			legacy  = br.adapter(bridgeStackLegacy)
+			libp2p  = br.adapter(bridgeStackLibP2P)
+		)
+
+		// Given the libp2p connection is initialized but not started yet
+		legacyPeer, lp2pPeer := newBridgeTestPeers(t)
+
+		legacy.InitPeer(legacyPeer)
+		legacy.AddPeer(legacyPeer)
+		libp2p.InitPeer(lp2pPeer)
+
+		// ACT
+		legacy.RemovePeer(legacyPeer, "connection closed")
+		promotedBeforeAdded := append([]p2p.Peer(nil), reactor.addPeers...)
+
+		libp2p.AddPeer(lp2pPeer)
+
+		// ASSERT
+		// the promoted peer is only added once started by its switch
+		require.Equal(t, []p2p.Peer{legacyPeer}, promotedBeforeAdded)
+		require.Equal(t, []p2p.Peer{legacyPeer, lp2pPeer}, reactor.addPeers)
+	})
+
+	t.Run("LegacyAndLibP2PPeers", func(t *testing.T) {
+		// ARRANGE
+		ports := utils.GetFreePorts(t, 2)
+
+		// Given a legacy node
+		reactorLegacy := newReactorMock([]*conn.ChannelDescriptor{channelDescriptor}, log.NewNopLogger())
+		legacyNode := p2p.MakeSwitch(config.DefaultP2PConfig(), 0, func(_ int, sw *p2p.Switch) *p2p.Switch {
+			sw.AddReactor("echo", reactorLegacy)
+			return sw
+		})
+
+		require.NoError(t, legacyNode.Start())
+		t.Cleanup(func() { _ = legacyNode.Stop() })
+
+		// Given a libp2p node
+		hostLibP2P := makeTestHost(t, ports[0], withLogging())
+		reactorLibP2P := newReactorMock([]*conn.ChannelDescriptor{channelDescriptor}, hostLibP2P.Logger())
+
+		libp2pNode, err := NewSwitch(
+			nil,
+			hostLibP2P,
+			[]SwitchReactor{{Name: "echo", Reactor: reactorLibP2P}},
+			p2p.NopMetrics(),
+			hostLibP2P.Logger(),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, libp2pNode.Start())
+		t.Cleanup(func() { _ = libp2pNode.Stop() })
+
+		// Given a bridge node
+		var (
+			bridgeHost    = makeTestHost(t, ports[1], withLogging())
+			reactorBridge = newReactorMock([]*conn.ChannelDescriptor{channelDescriptor}, bridgeHost.Logger())
+			bridge        *BridgeSwitch
+		)
+
+		p2p.MakeSwitch(config.DefaultP2PConfig(), 1, func(_ int, sw *p2p.Switch) *p2p.Switch {
+			bridge, err = NewBridgeSwitch(
+				sw,
+				nil,
+				bridgeHost,
+				[]SwitchReactor{{Name: "echo", Reactor: reactorBridge}},
+				p2p.NopMetrics(),
+				bridgeHost.Logger(),
+			)
+			require.NoError(t, err)
+
+			return sw
+		})
+
+		require.NoError(t, bridge.Start())
+		t.Cleanup(func() { _ = bridge.Stop() })
+
+		libp2pAddrInfo := hostLibP2P.AddrInfo()
+		libp2pAddrs, err := peer.AddrInfoToP2pAddrs(&libp2pAddrInfo)
+		require.NoError(t, err)
+
+		// ACT
+		// the bridge dials both nodes, each over its own stack
+		err = bridge.DialPeersAsync([]string{
+			legacyNode.NetAddress().String(),
+			libp2pAddrs[0].String(),
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			return bridge.Peers().Size() == 2
+		}, 5*time.Second, 50*time.Millisecond)
+
+		bridge.BroadcastAsync(p2p.Envelope{
+			ChannelID: channelID,
+			Message:   &types.RequestEcho{Message: "hello"},
+		})
+
+		legacyNode.BroadcastAsync(p2p.Envelope{
+			ChannelID: channelID,
+			Message:   &types.RequestEcho{Message: "from legacy"},
+		})
+
+		// ASSERT
+		received := func(reactor *reactorMock, n int) func() bool {
+			return func() bool { return len(reactor.receivedEnvelopes()) == n }
+		}
+
+		require.Eventually(t, received(reactorLegacy, 1), 5*time.Second, 50*time.Millisecond)
+		require.Eventually(t, received(reactorLibP2P, 1), 5*time.Second, 50*time.Millisecond)
+		require.Eventually(t, received(reactorBridge, 1), 5*time.Second, 50*time.Millisecond)
+
+		require.Equal(t, "hello", reactorLegacy.receivedEnvelopes()[0].Message.(*types.RequestEcho).Message)
+		require.Equal(t, "hello", reactorLibP2P.receivedEnvelopes()[0].Message.(*types.RequestEcho).Message)
+		require.Equal(t, legacyNode.NodeInfo().ID(), reactorBridge.receivedEnvelopes()[0].Src.ID())
+
+		outbound, inbound, _ := bridge.NumPeers()
+		require.Equal(t, 2, outbound)
+		require.Equal(t, 0, inbound)
+
+		require.NotNil(t, bridge.Peers().Get(legacyNode.NodeInfo().ID()))
+		require.NotNil(t, bridge.Peers().Get(peerIDToKey(hostLibP2P.ID())))
+	})
+}
+
+func TestSplitBridgeAddrs(t *testing.T) {
+	var (
+		legacyID = p2p.PubKeyToID(ed25519.GenPrivKey().PubKey())
+		peerID   = "12D3KooWJx9i35Vx1h6T6nVqQz4YW1r2J1Y2P2nY3N4N5N6N7N8N9N0"
+	)
+
+	addrs := []string{
+		string(legacyID),
+		string(legacyID) + "@1.2.3.4:26656",
+		"tcp://" + string(legacyID) + "@1.2.3.4:26656",
+		peerID,
+		peerID + "@1.2.3.4:26656",
+		"/ip4/1.2.3.4/udp/26656/quic-v1/p2p/" + peerID,
+	}
+
+	legacy, libp2p := splitBridgeAddrs(addrs)
+
+	require.Equal(t, addrs[:3], legacy)
+	require.Equal(t, addrs[3:], libp2p)
+}
+
+func newTestBridgeSwitch() *BridgeSwitch {
+	bs := &BridgeSwitch{
+		peers:    newBridgePeerSet(),
+		reactors: make(map[string]*bridgeReactor),
+	}
+	bs.BaseService.Logger = log.NewNopLogger()
+
+	return bs
+}
+
+// bridgeTestPeer is a mock peer with a given ID.
+type bridgeTestPeer struct {
+	*mock.Peer
+	id p2p.ID
+}
+
+func (p *bridgeTestPeer) ID() p2p.ID { return p.id }
+
+// newBridgeTestPeers returns the legacy and libp2p peers of the same node.
+func newBridgeTestPeers(t *testing.T) (legacyPeer, lp2pPeer p2p.Peer) {
+	t.Helper()
+
+	pk := ed25519.GenPrivKey()
+
+	pid, err := IDFromPrivateKey(pk)
+	require.NoError(t, err)
+
+	legacyPeer = &bridgeTestPeer{Peer: mock.NewPeer(nil), id: p2p.PubKeyToID(pk.PubKey())}
+	lp2pPeer = &bridgeTestPeer{Peer: mock.NewPeer(nil), id: peerIDToKey(pid)}
+
+	return legacyPeer, lp2pPeer
+}
@@ -0,0 +1,300 @@
+package lp2p
+
+import (
+	"net"
+	"sync/atomic"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// ConnGater rejects connections to and from banned peers and limits the
+// connected peers. Bans are enforced when `lp2p.scoring.ban_threshold` is positive.
+// Peer limits are only enabled when `lp2p.limits.mode = "custom"`:
+//   - max_peers caps the number of peers, reserved_peer_slots of them
+//     being only usable by persistent peers;
+//   - max_inbound_peers and max_outbound_peers cap the peers in each direction;
+//   - max_peers_per_ip and max_peers_per_subnet cap the peers sharing an IP or subnet.
+//
+// Unconditional peers are never limited, and persistent peers are exempt from
+// all limits but max_peers. As peers are only identified once the connection
+// is secured, inbound connections are checked in InterceptSecured.
+//
+// The host is injected after host creation because libp2p requires the
+// connection gater option during `libp2p.New(...)`, before the host exists.
+type ConnGater struct {
+	host   *Host
+	scorer *PeerScorer
+
+	maxPeers          int
+	maxInboundPeers   int
+	maxOutboundPeers  int
+	reservedPeerSlots int
+	maxPeersPerIP     int
+	maxPeersPerSubnet int
+
+	// peerOpts resolves whether a peer is persistent or unconditional.
+	// Set by the switch; defaults to the host's bootstrap peers.
+	peerOpts atomic.Pointer[func(peer.ID) PeerAddOptions]
+}
+
+var _ connmgr.ConnectionGater = (*ConnGater)(nil)
+
+// subnet prefix lengths for max_peers_per_subnet
+const (
+	subnetBitsIPv4 = 24
+	subnetBitsIPv6 = 64
+)
+
+// ConnectionGaterFromConfig creates a connection gater from the given config or returns false if disabled.
+func ConnectionGaterFromConfig(cfg config.LibP2PConfig, host *Host, scorer *PeerScorer) (*ConnGater, bool) {
+	var (
+		limitsEnabled  = cfg.Limits.Mode == config.LibP2PLimitsModeCustom
+		scoringEnabled = scorer != nil && scorer.Enabled()
+	)
+
+	if !limitsEnabled && !scoringEnabled {
+		return nil, false
+	}
+
+	cg := &ConnGater{host: host}
+
+	if limitsEnabled {
+		cg.maxPeers = cfg.Limits.MaxPeers
+		cg.maxInboundPeers = cfg.Limits.MaxInboundPeers
+		cg.maxOutboundPeers = cfg.Limits.MaxOutboundPeers
+		cg.reservedPeerSlots = cfg.Limits.ReservedPeerSlots
+		cg.maxPeersPerIP = cfg.Limits.MaxPeersPerIP
+		cg.maxPeersPerSubnet = cfg.Limits.MaxPeersPerSubnet
+	}
+
+	if scoringEnabled {
+		cg.scorer = scorer
+	}
+
+	return cg, true
+}
+
+// SetHost sets the host for the connection gater. The host is injected after creation
+// because libp2p requires the connection gater option during libp2p.New, before the host exists.
+func (c *ConnGater) SetHost(host *Host) { c.host = host }
+
+// SetPeerOptsFunc sets the function resolving whether a peer is persistent or unconditional.
+func (c *ConnGater) SetPeerOptsFunc(fn func(peer.ID) PeerAddOptions) { c.peerOpts.Store(&fn) }
+
+// MaxOutboundPeers returns the maximum number of outbound peers, or 0 if unlimited.
+func (c *ConnGater) MaxOutboundPeers() int {
+	if c.maxOutboundPeers > 0 {
+		return c.maxOutboundPeers
+	}
+
+	return c.maxPeers
+}
+
+// InterceptAccept is called when a peer attempts to connect. The peer is not
+// identified yet, so the limits are enforced once the connection is secured.
+func (c *ConnGater) InterceptAccept(network.ConnMultiaddrs) bool {
+	return c.host != nil
+}
+
+func (c *ConnGater) InterceptAddrDial(pid peer.ID, addr multiaddr.Multiaddr) bool {
+	return c.allowPeer(pid, "InterceptAddrDial") &&
+		c.allowConn(network.DirOutbound, pid, addr, "InterceptAddrDial")
+}
+
+func (c *ConnGater) InterceptPeerDial(pid peer.ID) bool {
+	return c.allowPeer(pid, "InterceptPeerDial") &&
+		c.allowConn(network.DirOutbound, pid, nil, "InterceptPeerDial")
+}
+
+// InterceptSecured is called once the remote peer is authenticated.
+// It returns false to reject the connection if the peer is banned or exceeds the peer limits.
+func (c *ConnGater) InterceptSecured(dir network.Direction, pid peer.ID, addrs network.ConnMultiaddrs) bool {
+	return c.allowPeer(pid, "InterceptSecured") &&
+		c.allowConn(dir, pid, addrs.RemoteMultiaddr(), "InterceptSecured")
+}
+
+func (c *ConnGater) InterceptUpgraded(network.Conn) (allow bool, reason control.DisconnectReason) {
+	return true, 0
+}
+
+// allowPeer returns false if the peer is banned.
+func (c *ConnGater) allowPeer(pid peer.ID, caller string) bool {
+	if c.scorer == nil || !c.scorer.IsBanned(pid) {
+		return true
+	}
+
+	if c.host != nil {
+		c.host.logger.Debug("Rejecting banned peer", "caller", caller, "peer_id", pid.String())
+	}
+
+	return false
+}
+
+// allowConn checks whether a new connection with the peer fits into the peer limits.
+// remoteAddr is optional.
+func (c *ConnGater) allowConn(dir network.Direction, pid peer.ID, remoteAddr multiaddr.Multiaddr, caller string) bool {
+	if c.host == nil {
+		return false
+	}
+
+	if c.maxPeers == 0 {
+		return true
+	}
+
+	// additional connections to connected peers are always allowed
+	if c.host.Network().Connectedness(pid) == network.Connected {
+		return true
+	}
+
+	opts := c.peerOptions(pid)
+	if opts.Unconditional {
+		return true
+	}
+
+	var (
+		stats = c.connStats()
+
+		// only persistent peers can use the reserved slots
+		maxPeers = c.maxPeers - c.reservedPeerSlots
+	)
+
+	if opts.Persistent {
+		maxPeers = c.maxPeers
+	}
+
+	reject := func(limit string, current, max int) bool {
+		c.host.logger.Info(
+			"Rejecting peer due to "+limit+" limit",
+			"caller", caller,
+			"peer_id", pid.String(),
+			"direction", dir.String(),
+			"current_peers", current,
+			"max_peers", max,
+		)
+
+		return false
+	}
+
+	if stats.total >= maxPeers {
+		return reject("max peers", stats.total, maxPeers)
+	}
+
+	if opts.Persistent {
+		return true
+	}
+
+	switch {
+	case dir == network.DirInbound && c.maxInboundPeers > 0 && stats.inbound >= c.maxInboundPeers:
+		return reject("max inbound peers", stats.inbound, c.maxInboundPeers)
+	case dir == network.DirOutbound && c.maxOutboundPeers > 0 && stats.outbound >= c.maxOutboundPeers:
+		return reject("max outbound peers", stats.outbound, c.maxOutboundPeers)
+	}
+
+	if remoteAddr == nil {
+		return true
+	}
+
+	ip, err := manet.ToIP(remoteAddr)
+	if err != nil {
+		// e.g. DNS addresses, checked once resolved
+		return true
+	}
+
+	if n := stats.perIP[ip.String()]; c.maxPeersPerIP > 0 && n >= c.maxPeersPerIP {
+		return reject("max peers per IP", n, c.maxPeersPerIP)
+	}
+
+	if n := stats.perSubnet[subnetKey(ip)]; c.maxPeersPerSubnet > 0 && n >= c.maxPeersPerSubnet {
+		return reject("max peers per subnet", n, c.maxPeersPerSubnet)
+	}
+
+	return true
+}
+
+// connStats counts connected peers. Unconditional peers are not counted.
+// Persistent peers only count towards the total.
+type connStats struct {
+	total     int
+	inbound   int
+	outbound  int
+	perIP     map[string]int
+	perSubnet map[string]int
+}
+
+func (c *ConnGater) connStats() connStats {
+	stats := connStats{
+		perIP:     make(map[string]int),
+		perSubnet: make(map[string]int),
+	}
+
+	seen := make(map[peer.ID]struct{})
+
+	for _, conn := range c.host.Network().Conns() {
+		pid := conn.RemotePeer()
+
+		// the direction and address of a peer are those of its first connection
+		if _, ok := seen[pid]; ok {
+			continue
+		}
+
+		seen[pid] = struct{}{}
+
+		opts := c.peerOptions(pid)
+		if opts.Unconditional {
+			continue
+		}
+
+		stats.total++
+
+		if opts.Persistent {
+			continue
+		}
+
+		switch conn.Stat().Direction {
+		case network.DirInbound:
+			stats.inbound++
+		case network.DirOutbound:
+			stats.outbound++
+		}
+
+		if ip, err := manet.ToIP(conn.RemoteMultiaddr()); err == nil {
+			stats.perIP[ip.String()]++
+			stats.perSubnet[subnetKey(ip)]++
+		}
+	}
+
+	return stats
+}
+
+func (c *ConnGater) peerOptions(pid peer.ID) PeerAddOptions {
+	if fn := c.peerOpts.Load(); fn != nil {
+		return (*fn)(pid)
+	}
+
+	bp, _ := c.host.BootstrapPeer(pid)
+
+	return PeerAddOptions{
+		Private:       bp.Private,
+		Persistent:    bp.Persistent,
+		Unconditional: bp.Unconditional,
+	}
+}
+
+// subnetKey returns the /24 (IPv4) or /64 (IPv6) subnet of the IP.
+func subnetKey(ip net.IP) string {
+	bits, size := subnetBitsIPv6, 128
+
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits, size = ip4, subnetBitsIPv4, 32
+	}
+
+	mask := net.CIDRMask(bits, size)
+
+	return (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()
+}
@@ -0,0 +1,183 @@
+package lp2p
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/test/utils"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnGaterLimits(t *testing.T) {
+	const (
+		waitTimeout  = 2 * time.Second
+		waitInterval = 50 * time.Millisecond
+	)
+
+	ctx := context.Background()
+
+	// makeHosts creates a host with custom limits and n other hosts
+	makeHosts := func(t *testing.T, n int, limits func(*config.LibP2PLimits)) (*Host, []*Host) {
+		ports := utils.GetFreePorts(t, n+1)
+
+		gated := makeTestHost(t, ports[0], withLogging(), withModifiedConfig(func(cfg *config.LibP2PConfig) {
+			cfg.Limits.Mode = config.LibP2PLimitsModeCustom
+			cfg.Limits.MaxPeers = 10
+			cfg.Limits.MaxPeerStreams = 10
+			limits(&cfg.Limits)
+		}))
+
+		others := make([]*Host, 0, n)
+		for _, port := range ports[1:] {
+			others = append(others, makeTestHost(t, port, withLogging()))
+		}
+
+		return gated, others
+	}
+
+	// connected checks that the gated host is eventually connected (or not) to the peer
+	connected := func(t *testing.T, gated, other *Host, expected bool) {
+		require.Eventually(t, func() bool {
+			return (gated.Network().Connectedness(other.ID()) == network.Connected) == expected
+		}, waitTimeout, waitInterval)
+	}
+
+	// markPeers marks the given peers as persistent or unconditional
+	markPeers := func(gated *Host, persistent, unconditional []*Host) {
+		opts := make(map[peer.ID]PeerAddOptions)
+		for _, h := range persistent {
+			opts[h.ID()] = PeerAddOptions{Persistent: true}
+		}
+		for _, h := range unconditional {
+			opts[h.ID()] = PeerAddOptions{Unconditional: true}
+		}
+
+		gated.ConnGater().SetPeerOptsFunc(func(id peer.ID) PeerAddOptions { return opts[id] })
+	}
+
+	t.Run("inboundQuota", func(t *testing.T) {
+		// ARRANGE
+		gated, hosts := makeHosts(t, 3, func(l *config.LibP2PLimits) {
+			l.MaxInboundPeers = 1
+		})
+
+		require.NoError(t, hosts[0].Connect(ctx, gated.AddrInfo()))
+		connected(t, gated, hosts[0], true)
+
+		// ACT
+		_ = hosts[1].Connect(ctx, gated.AddrInfo())
+		errOutbound := gated.Connect(ctx, hosts[2].AddrInfo())
+
+		// ASSERT
+		connected(t, gated, hosts[1], false)
+		require.NoError(t, errOutbound)
+		connected(t, gated, hosts[2], true)
+	})
+
+	t.Run("outboundQuota", func(t *testing.T) {
+		// ARRANGE
+		gated, hosts := makeHosts(t, 3, func(l *config.LibP2PLimits) {
+			l.MaxOutboundPeers = 1
+		})
+
+		require.NoError(t, gated.Connect(ctx, hosts[0].AddrInfo()))
+
+		// ACT
+		errOutbound := gated.Connect(ctx, hosts[1].AddrInfo())
+		errInbound := hosts[2].Connect(ctx, gated.AddrInfo())
+
+		// ASSERT
+		require.Error(t, errOutbound)
+		require.NoError(t, errInbound)
+		connected(t, gated, hosts[2], true)
+		require.Equal(t, 1, gated.ConnGater().MaxOutboundPeers())
+	})
+
+	t.Run("reservedSlots", func(t *testing.T) {
+		// ARRANGE
+		gated, hosts := makeHosts(t, 3, func(l *config.LibP2PLimits) {
+			l.MaxPeers = 2
+			l.ReservedPeerSlots = 1
+		})
+
+		// Given host 2 is persistent
+		markPeers(gated, hosts[2:], nil)
+
+		require.NoError(t, hosts[0].Connect(ctx, gated.AddrInfo()))
+		connected(t, gated, hosts[0], true)
+
+		// ACT
+		_ = hosts[1].Connect(ctx, gated.AddrInfo())
+		errPersistent := gated.Connect(ctx, hosts[2].AddrInfo())
+
+		// ASSERT
+		connected(t, gated, hosts[1], false)
+		require.NoError(t, errPersistent)
+		connected(t, gated, hosts[2], true)
+	})
+
+	t.Run("unconditional", func(t *testing.T) {
+		// ARRANGE
+		gated, hosts := makeHosts(t, 2, func(l *config.LibP2PLimits) {
+			l.MaxPeers = 1
+		})
+
+		// Given host 1 is unconditional
+		markPeers(gated, nil, hosts[1:])
+
+		require.NoError(t, hosts[0].Connect(ctx, gated.AddrInfo()))
+		connected(t, gated, hosts[0], true)
+
+		// ACT
+		err := hosts[1].Connect(ctx, gated.AddrInfo())
+
+		// ASSERT
+		require.NoError(t, err)
+		connected(t, gated, hosts[1], true)
+		require.Len(t, gated.Network().Peers(), 2)
+	})
+
+	t.Run("perIP", func(t *testing.T) {
+		// ARRANGE
+		// all hosts listen on 127.0.0.1
+		gated, hosts := makeHosts(t, 3, func(l *config.LibP2PLimits) {
+			l.MaxPeersPerIP = 1
+			l.MaxPeersPerSubnet = 1
+		})
+
+		// Given host 2 is persistent
+		markPeers(gated, hosts[2:], nil)
+
+		require.NoError(t, hosts[0].Connect(ctx, gated.AddrInfo()))
+		connected(t, gated, hosts[0], true)
+
+		// ACT
+		_ = hosts[1].Connect(ctx, gated.AddrInfo())
+		_ = hosts[2].Connect(ctx, gated.AddrInfo())
+
+		// ASSERT
+		connected(t, gated, hosts[1], false)
+		connected(t, gated, hosts[2], true)
+	})
+}
+
+func TestSubnetKey(t *testing.T) {
+	for _, tt := range []struct {
+		ip   string
+		want string
+	}{
+		{ip: "1.2.3.4", want: "1.2.3.0/24"},
+		{ip: "1.2.3.200", want: "1.2.3.0/24"},
+		{ip: "::ffff:1.2.3.4", want: "1.2.3.0/24"},
+		{ip: "2001:db8:1:2:3:4:5:6", want: "2001:db8:1:2::/64"},
+	} {
+		t.Run(tt.ip, func(t *testing.T) {
+			require.Equal(t, tt.want, subnetKey(net.ParseIP(tt.ip)))
+		})
+	}
+}
@@ -11,14 +11,11 @@ import (
 	cmcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/libp2p/go-libp2p"
-	"github.com/libp2p/go-libp2p/core/connmgr"
-	"github.com/libp2p/go-libp2p/core/control"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
-	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	multiaddr "github.com/multiformats/go-multiaddr"
 )
 
@@ -30,9 +27,21 @@ type Host struct {
 
 	config config.LibP2PConfig
 
+	// transports enabled transports in dial preference order
+	transports []string
+
 	// bootstrapPeers are initial peers specified in the address book
 	bootstrapPeers map[peer.ID]BootstrapPeer
 
+	// scorer scores and bans misbehaving peers
+	scorer *PeerScorer
+
+	// bandwidth rate limits and accounts the streams
+	bandwidth *BandwidthLimiter
+
+	// connGater is nil if neither limits nor scoring are enabled
+	connGater *ConnGater
+
 	logger log.Logger
 
 	peerFailureHandlers []func(id peer.ID, err error)
@@ -65,9 +74,19 @@ func NewHost(config *config.P2PConfig, nodeKey cmcrypto.PrivKey, logger log.Logg
 		return nil, fmt.Errorf("failed to convert private key to libp2p: %w", err)
 	}
 
-	listenAddr, err := AddressToMultiAddr(config.ListenAddress, TransportQUIC)
+	transports, err := TransportsFromConfig(config.LibP2PConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert %q to multiaddr: %w", config.ListenAddress, err)
+		return nil, fmt.Errorf("invalid transports: %w", err)
+	}
+
+	listenAddrs, err := listenAddrsFromConfig(config, transports)
+	if err != nil {
+		return nil, err
+	}
+
+	transportOpts, err := transportOptions(transports, config.LibP2PConfig.Security)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure transports: %w", err)
 	}
 
 	bootstrapPeers, err := BootstrapPeersFromConfig(config.LibP2PConfig)
@@ -78,8 +97,10 @@ func NewHost(config *config.P2PConfig, nodeKey cmcrypto.PrivKey, logger log.Logg
 		logger.Info("No bootstrap peers provided in the config")
 	}
 
+	scorer := NewPeerScorer(config.LibP2PConfig.Scoring)
+
 	// host will be set later
-	connGater, connGaterEnabled := ConnectionGaterFromConfig(config.LibP2PConfig, nil)
+	connGater, connGaterEnabled := ConnectionGaterFromConfig(config.LibP2PConfig, nil, scorer)
 
 	resourceManager, _, err := ResourceManagerFromConfig(config.LibP2PConfig)
 	if err != nil {
@@ -89,25 +110,31 @@ func NewHost(config *config.P2PConfig, nodeKey cmcrypto.PrivKey, logger log.Logg
 	// todo: add support for libp2p.BandwidthReporter()
 	opts := []libp2p.Option{
 		libp2p.Identity(privateKey),
-		libp2p.ListenAddrs(listenAddr),
+		libp2p.ListenAddrs(listenAddrs...),
 		libp2p.UserAgent("cometbft"),
 		libp2p.Ping(true),
-		libp2p.Transport(quic.NewTransport),
 		libp2p.ResourceManager(resourceManager),
 	}
 
+	opts = append(opts, transportOpts...)
+
 	if connGaterEnabled {
 		opts = append(opts, libp2p.ConnectionGater(connGater))
 	}
 
-	// We listen on `listenAddr` but advertise `externalAddr` to peers
-	if config.ExternalAddress != "" {
-		externalAddr, err := AddressToMultiAddr(config.ExternalAddress, TransportQUIC)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert %q to multiaddr: %w", config.ExternalAddress, err)
+	// We listen on `listenAddrs` but advertise `externalAddrs` to peers
+	if externalAddress := config.LibP2PExternalAddress(); externalAddress != "" {
+		externalAddrs := make([]multiaddr.Multiaddr, 0, len(transports))
+		for _, transport := range transports {
+			externalAddr, err := AddressToMultiAddr(externalAddress, transport)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert %q to multiaddr: %w", externalAddress, err)
+			}
+
+			externalAddrs = append(externalAddrs, externalAddr)
 		}
 
-		opts = append(opts, withAddressFactory(externalAddr))
+		opts = append(opts, withAddressFactory(externalAddrs...))
 	}
 
 	host, err := libp2p.New(opts...)
@@ -118,12 +145,16 @@ func NewHost(config *config.P2PConfig, nodeKey cmcrypto.PrivKey, logger log.Logg
 	h := &Host{
 		Host:           host,
 		config:         config.LibP2PConfig,
+		transports:     transports,
 		bootstrapPeers: bootstrapPeers,
+		scorer:         scorer,
+		bandwidth:      NewBandwidthLimiter(config.LibP2PConfig.Bandwidth),
 		logger:         logger,
 	}
 
 	if connGaterEnabled {
 		connGater.SetHost(h)
+		h.connGater = connGater
 	}
 
 	return h, nil
@@ -133,6 +164,26 @@ func (h *Host) AddrInfo() peer.AddrInfo {
 	return peer.AddrInfo{ID: h.ID(), Addrs: h.Addrs()}
 }
 
+// Transports returns the enabled transports in dial preference order.
+func (h *Host) Transports() []string {
+	return h.transports
+}
+
+// Scorer returns the scorer of misbehaving peers.
+func (h *Host) Scorer() *PeerScorer {
+	return h.scorer
+}
+
+// Bandwidth returns the rate limiter of the streams.
+func (h *Host) Bandwidth() *BandwidthLimiter {
+	return h.bandwidth
+}
+
+// ConnGater returns the connection gater or nil if disabled.
+func (h *Host) ConnGater() *ConnGater {
+	return h.connGater
+}
+
 func (h *Host) BootstrapPeers() map[peer.ID]BootstrapPeer {
 	return h.bootstrapPeers
 }
@@ -166,11 +217,18 @@ func (h *Host) EmitPeerFailure(id peer.ID, err error) {
 	}
 }
 
+// BootstrapPeersFromConfig returns the bootstrap peers of the config,
+// reachable with each of the configured transports.
 func BootstrapPeersFromConfig(config config.LibP2PConfig) (map[peer.ID]BootstrapPeer, error) {
+	transports, err := TransportsFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	peers := make(map[peer.ID]BootstrapPeer, len(config.BootstrapPeers))
 
 	for _, bp := range config.BootstrapPeers {
-		addr, err := AddrInfoFromHostAndID(bp.Host, bp.ID)
+		addr, err := AddrInfoFromHostAndID(bp.Host, bp.ID, transports...)
 		if err != nil {
 			return nil, fmt.Errorf("[%s, %s]: %w", bp.Host, bp.ID, err)
 		}
@@ -190,6 +248,35 @@ func BootstrapPeersFromConfig(config config.LibP2PConfig) (map[peer.ID]Bootstrap
 	return peers, nil
 }
 
+// listenAddrsFromConfig returns the listen address multiaddr of each transport
+// (p2p.laddr, or bridge.laddr in bridge mode),
+// followed by the additional listen addresses.
+func listenAddrsFromConfig(config *config.P2PConfig, transports []string) ([]multiaddr.Multiaddr, error) {
+	addrs := make([]multiaddr.Multiaddr, 0, len(transports)+len(config.LibP2PConfig.ListenAddresses))
+
+	listenAddress := config.LibP2PListenAddress()
+
+	for _, transport := range transports {
+		addr, err := AddressToMultiAddr(listenAddress, transport)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %q to multiaddr: %w", listenAddress, err)
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	for _, raw := range config.LibP2PConfig.ListenAddresses {
+		addr, err := multiaddr.NewMultiaddr(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse listen address %q: %w", raw, err)
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
 // ResourceManagerFromConfig creates a resource manager from the given config.
 func ResourceManagerFromConfig(cfg config.LibP2PConfig) (network.ResourceManager, rcmgr.Limiter, error) {
 	if cfg.Limits.Mode == config.LibP2PLimitsModeDisabled {
@@ -245,72 +332,3 @@ func ResourceManagerFromConfig(cfg config.LibP2PConfig) (network.ResourceManager
 
 	return nil, nil, fmt.Errorf("unknown limits mode: %q", cfg.Limits.Mode)
 }
-
-// ConnGater limits the number of simultaneously connected peers.
-// It is only enabled when `lp2p.limits.mode = "custom"` and uses
-// `lp2p.limits.max_peers` as the cap.
-//
-// The host is injected after host creation because libp2p requires the
-// connection gater option during `libp2p.New(...)`, before the host exists.
-type ConnGater struct {
-	host     *Host
-	maxPeers int
-}
-
-var _ connmgr.ConnectionGater = (*ConnGater)(nil)
-
-// ConnectionGaterFromConfig creates a connection gater from the given config or returns false if disabled.
-func ConnectionGaterFromConfig(cfg config.LibP2PConfig, host *Host) (*ConnGater, bool) {
-	if cfg.Limits.Mode != config.LibP2PLimitsModeCustom {
-		return nil, false
-	}
-
-	return &ConnGater{
-		host:     host,
-		maxPeers: cfg.Limits.MaxPeers,
-	}, true
-}
-
-// SetHost sets the host for the connection gater. The host is injected after creation
-// because libp2p requires the connection gater option during libp2p.New, before the host exists.
-func (c *ConnGater) SetHost(host *Host) { c.host = host }
-
-// InterceptAccept is called when a peer attempts to connect. It returns false to reject the connection
-// if the peer count has reached max_peers.
-func (c *ConnGater) InterceptAccept(network.ConnMultiaddrs) bool {
-	return c.allowMorePeers("caller", "InterceptAccept")
-}
-
-func (c *ConnGater) InterceptAddrDial(pid peer.ID, _ multiaddr.Multiaddr) bool {
-	return c.allowMorePeers("caller", "InterceptAddrDial", "peer_id", pid.String())
-}
-
-func (c *ConnGater) InterceptPeerDial(pid peer.ID) bool {
-	return c.allowMorePeers("caller", "InterceptPeerDial", "peer_id", pid.String())
-}
-
-func (c *ConnGater) InterceptSecured(network.Direction, peer.ID, network.ConnMultiaddrs) bool {
-	return true
-}
-
-func (c *ConnGater) InterceptUpgraded(network.Conn) (allow bool, reason control.DisconnectReason) {
-	return true, 0
-}
-
-func (c *ConnGater) allowMorePeers(labels ...any) bool {
-	if c.host == nil {
-		return false
-	}
-
-	current := len(c.host.Network().Peers())
-
-	if current < c.maxPeers {
-		return true
-	}
-
-	labels = append(labels, "current_peers", current, "max_peers", c.maxPeers)
-
-	c.host.logger.Info("Rejecting peer due to max peers limit", labels...)
-
-	return false
-}
@@ -219,9 +219,10 @@ func TestHostConnGater(t *testing.T) {
 		// ARRANGE
 		cfg := config.DefaultP2PConfig().LibP2PConfig
 		cfg.Limits.Mode = config.LibP2PLimitsModeDefault
+		cfg.Scoring.BanThreshold = 0
 
 		// ACT
-		connGater, enabled := ConnectionGaterFromConfig(cfg, nil)
+		connGater, enabled := ConnectionGaterFromConfig(cfg, nil, NewPeerScorer(cfg.Scoring))
 
 		// ASSERT
 		require.Nil(t, connGater)
@@ -232,15 +233,71 @@ func TestHostConnGater(t *testing.T) {
 		// ARRANGE
 		cfg := config.DefaultP2PConfig().LibP2PConfig
 		cfg.Limits.Mode = config.LibP2PLimitsModeDisabled
+		cfg.Scoring.BanThreshold = 0
 
 		// ACT
-		connGater, enabled := ConnectionGaterFromConfig(cfg, nil)
+		connGater, enabled := ConnectionGaterFromConfig(cfg, nil, NewPeerScorer(cfg.Scoring))
 
 		// ASSERT
 		require.Nil(t, connGater)
 		require.False(t, enabled)
 	})
 
+	t.Run("scoring", func(t *testing.T) {
+		// ARRANGE
+		cfg := config.DefaultP2PConfig().LibP2PConfig
+		cfg.Limits.Mode = config.LibP2PLimitsModeDefault
+
+		// ACT
+		connGater, enabled := ConnectionGaterFromConfig(cfg, nil, NewPeerScorer(cfg.Scoring))
+
+		// ASSERT
+		require.True(t, enabled)
+		require.NotNil(t, connGater.scorer)
+		require.Zero(t, connGater.maxPeers)
+	})
+
+	t.Run("rejectBannedPeer", func(t *testing.T) {
+		// ARRANGE
+		const (
+			waitTimeout  = 2 * time.Second
+			waitInterval = 50 * time.Millisecond
+		)
+
+		var (
+			ctx   = context.Background()
+			ports = utils.GetFreePorts(t, 3)
+
+			host1 = makeTestHost(t, ports[0], withLogging())
+			host2 = makeTestHost(t, ports[1], withLogging())
+			host3 = makeTestHost(t, ports[2], withLogging())
+		)
+
+		// given host2 is banned by host1
+		host1.Scorer().Ban(host2.ID(), time.Minute, "test")
+
+		// ACT
+		// note the inbound err might be nil because the handshake completes before the connection is rejected.
+		_ = host2.Connect(ctx, host1.AddrInfo())
+		errOutbound := host1.Connect(ctx, host2.AddrInfo())
+		errOther := host3.Connect(ctx, host1.AddrInfo())
+
+		// ASSERT
+		require.Error(t, errOutbound)
+		require.NoError(t, errOther)
+		require.Eventually(t, func() bool {
+			return host1.Network().Connectedness(host2.ID()) != network.Connected &&
+				host1.Network().Connectedness(host3.ID()) == network.Connected
+		}, waitTimeout, waitInterval)
+
+		// ACT
+		// lift the ban
+		require.True(t, host1.Scorer().Unban(host2.ID()))
+
+		// ASSERT
+		require.NoError(t, host1.Connect(ctx, host2.AddrInfo()))
+	})
+
 	t.Run("rejectThirdPeer", func(t *testing.T) {
 		// ARRANGE
 		const (
@@ -197,7 +197,8 @@ func (p *Peer) send(e p2p.Envelope) (err error) {
 		return fmt.Errorf("failed to open stream %s: %w", protocolID, err)
 	}
 
-	return StreamWriteClose(s, payload)
+	// blocks while the send rate limits are exceeded
+	return StreamWriteClose(p.host.Bandwidth().Wrap(s, e.ChannelID, p.metrics), payload)
 }
 
 func (p *Peer) handleSendErr(err error) {
@@ -216,9 +217,28 @@ func (p *Peer) NodeInfo() p2p.NodeInfo {
 	return p2p.DefaultNodeInfo{
 		DefaultNodeID: p.ID(),
 		ListenAddr:    p.netAddr.DialString(),
+		Channels:      p.channels(),
 	}
 }
 
+// channels returns the channels supported by the peer, as announced by the
+// identify protocol. Empty until the peer is identified.
+func (p *Peer) channels() []byte {
+	protocols, err := p.host.Peerstore().GetProtocols(p.addrInfo.ID)
+	if err != nil {
+		return nil
+	}
+
+	channels := make([]byte, 0, len(protocols))
+	for _, protocolID := range protocols {
+		if channelID, ok := channelIDFromProtocolID(protocolID); ok {
+			channels = append(channels, channelID)
+		}
+	}
+
+	return channels
+}
+
 // RemoteIP returns the remote IP address of the peer derived from its address info.
 func (p *Peer) RemoteIP() net.IP {
 	return p.netAddr.IP
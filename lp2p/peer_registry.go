@@ -0,0 +1,84 @@
+package lp2p
+
+import (
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// peerRegistry keeps the peers marked as persistent, private or unconditional
+// at runtime (e.g. from `persistent_peers` or the `dial_peers` RPC route).
+// Unlike bootstrap peers, they can be added while the switch is running.
+type peerRegistry struct {
+	mu            sync.RWMutex
+	persistent    map[peer.ID]peer.AddrInfo
+	private       map[peer.ID]struct{}
+	unconditional map[peer.ID]struct{}
+}
+
+func newPeerRegistry() *peerRegistry {
+	return &peerRegistry{
+		persistent:    make(map[peer.ID]peer.AddrInfo),
+		private:       make(map[peer.ID]struct{}),
+		unconditional: make(map[peer.ID]struct{}),
+	}
+}
+
+// addPersistent marks the peers as persistent. The last address added for a
+// peer is used to reconnect to it.
+func (r *peerRegistry) addPersistent(addrInfos ...peer.AddrInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, addrInfo := range addrInfos {
+		r.persistent[addrInfo.ID] = addrInfo
+	}
+}
+
+func (r *peerRegistry) addPrivate(ids ...peer.ID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		r.private[id] = struct{}{}
+	}
+}
+
+func (r *peerRegistry) addUnconditional(ids ...peer.ID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		r.unconditional[id] = struct{}{}
+	}
+}
+
+// persistentAddrInfo returns the address of a persistent peer.
+func (r *peerRegistry) persistentAddrInfo(id peer.ID) (peer.AddrInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	addrInfo, ok := r.persistent[id]
+	return addrInfo, ok
+}
+
+func (r *peerRegistry) isPersistent(id peer.ID) bool {
+	_, ok := r.persistentAddrInfo(id)
+	return ok
+}
+
+func (r *peerRegistry) isPrivate(id peer.ID) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.private[id]
+	return ok
+}
+
+func (r *peerRegistry) isUnconditional(id peer.ID) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.unconditional[id]
+	return ok
+}
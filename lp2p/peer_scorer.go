@@ -0,0 +1,217 @@
+package lp2p
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// maxScoredPeers is the number of scored peers above which
+// the scores that have decayed to (almost) zero are pruned.
+const maxScoredPeers = 1024
+
+// minScore is the score below which a peer's score is considered zero.
+const minScore = 0.01
+
+// PeerScorer scores peers based on the errors reported by reactors and bans
+// the peers whose score reaches the ban threshold. Scores decay exponentially
+// over time, so occasional errors don't lead to a ban. Safe for concurrent use.
+type PeerScorer struct {
+	cfg config.LibP2PScoring
+
+	mu     sync.Mutex
+	scores map[peer.ID]peerScore
+	bans   map[peer.ID]peerBan
+
+	// for testing
+	now func() time.Time
+}
+
+type peerScore struct {
+	value     float64
+	updatedAt time.Time
+}
+
+type peerBan struct {
+	until  time.Time
+	reason string
+}
+
+// NewPeerScorer creates a new PeerScorer.
+func NewPeerScorer(cfg config.LibP2PScoring) *PeerScorer {
+	return &PeerScorer{
+		cfg:    cfg,
+		scores: make(map[peer.ID]peerScore),
+		bans:   make(map[peer.ID]peerBan),
+		now:    time.Now,
+	}
+}
+
+// Enabled returns true if the scorer bans peers.
+func (s *PeerScorer) Enabled() bool {
+	return s.cfg.Enabled()
+}
+
+// ErrorPenalty returns the penalty for an error reported by a reactor.
+func (s *PeerScorer) ErrorPenalty() float64 {
+	return s.cfg.ErrorPenalty
+}
+
+// MalformedPenalty returns the penalty for a malformed or oversized message.
+func (s *PeerScorer) MalformedPenalty() float64 {
+	return s.cfg.MalformedPenalty
+}
+
+// Penalize adds the penalty to the peer's score and bans the peer if the score
+// reaches the ban threshold. Returns true if the peer is banned.
+func (s *PeerScorer) Penalize(id peer.ID, penalty float64, reason string) bool {
+	if !s.Enabled() {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	if s.isBanned(id, now) {
+		return true
+	}
+
+	score := s.decayedScore(id, now) + penalty
+	s.scores[id] = peerScore{value: score, updatedAt: now}
+
+	s.prune(now)
+
+	if score < s.cfg.BanThreshold {
+		return false
+	}
+
+	s.ban(id, now.Add(s.cfg.BanDuration), reason)
+
+	return true
+}
+
+// Score returns the current (decayed) score of the peer.
+func (s *PeerScorer) Score(id peer.ID) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.decayedScore(id, s.now())
+}
+
+// Ban bans the peer for the given duration.
+func (s *PeerScorer) Ban(id peer.ID, duration time.Duration, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ban(id, s.now().Add(duration), reason)
+}
+
+// Unban lifts the peer's ban and resets its score.
+// Returns false if the peer was not banned.
+func (s *PeerScorer) Unban(id peer.ID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	banned := s.isBanned(id, s.now())
+
+	delete(s.bans, id)
+	delete(s.scores, id)
+
+	return banned
+}
+
+// IsBanned checks if the peer is currently banned.
+func (s *PeerScorer) IsBanned(id peer.ID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.isBanned(id, s.now())
+}
+
+// Bans returns the currently banned peers, sorted by ID.
+func (s *PeerScorer) Bans() []p2p.BannedPeer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	bans := make([]p2p.BannedPeer, 0, len(s.bans))
+
+	for id, ban := range s.bans {
+		if !now.Before(ban.until) {
+			delete(s.bans, id)
+			continue
+		}
+
+		bans = append(bans, p2p.BannedPeer{
+			ID:     peerIDToKey(id),
+			Until:  ban.until,
+			Reason: ban.reason,
+		})
+	}
+
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].ID < bans[j].ID
+	})
+
+	return bans
+}
+
+func (s *PeerScorer) ban(id peer.ID, until time.Time, reason string) {
+	s.bans[id] = peerBan{until: until, reason: reason}
+
+	// the score is reset, so the peer starts from scratch once the ban expires
+	delete(s.scores, id)
+}
+
+func (s *PeerScorer) isBanned(id peer.ID, now time.Time) bool {
+	ban, ok := s.bans[id]
+	if !ok {
+		return false
+	}
+
+	if !now.Before(ban.until) {
+		delete(s.bans, id)
+		return false
+	}
+
+	return true
+}
+
+func (s *PeerScorer) decayedScore(id peer.ID, now time.Time) float64 {
+	score, ok := s.scores[id]
+	if !ok {
+		return 0
+	}
+
+	return decay(score.value, now.Sub(score.updatedAt), s.cfg.DecayHalfLife)
+}
+
+// prune removes the scores that have decayed to (almost) zero
+// once the number of scored peers grows too large.
+func (s *PeerScorer) prune(now time.Time) {
+	if len(s.scores) <= maxScoredPeers {
+		return
+	}
+
+	for id, score := range s.scores {
+		if decay(score.value, now.Sub(score.updatedAt), s.cfg.DecayHalfLife) < minScore {
+			delete(s.scores, id)
+		}
+	}
+}
+
+// decay halves the score every halfLife. A zero halfLife disables decay.
+func decay(score float64, elapsed, halfLife time.Duration) float64 {
+	if halfLife <= 0 || elapsed <= 0 {
+		return score
+	}
+
+	return score * math.Pow(0.5, float64(elapsed)/float64(halfLife))
+}
@@ -0,0 +1,142 @@
+package lp2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerScorer(t *testing.T) {
+	const (
+		peerA = peer.ID("peer-a")
+		peerB = peer.ID("peer-b")
+	)
+
+	cfg := config.LibP2PScoring{
+		BanThreshold:     100,
+		ErrorPenalty:     25,
+		MalformedPenalty: 50,
+		DecayHalfLife:    time.Minute,
+		BanDuration:      time.Hour,
+	}
+
+	newScorer := func(cfg config.LibP2PScoring) (*PeerScorer, *time.Time) {
+		now := time.Now()
+		s := NewPeerScorer(cfg)
+		s.now = func() time.Time { return now }
+
+		return s, &now
+	}
+
+	t.Run("Ban", func(t *testing.T) {
+		// ARRANGE
+		s, now := newScorer(cfg)
+
+		// ACT
+		for i := 0; i < 3; i++ {
+			require.False(t, s.Penalize(peerA, cfg.ErrorPenalty, "invalid vote"))
+		}
+
+		banned := s.Penalize(peerA, cfg.ErrorPenalty, "invalid vote")
+
+		// ASSERT
+		require.True(t, banned)
+		require.True(t, s.IsBanned(peerA))
+		require.False(t, s.IsBanned(peerB))
+
+		bans := s.Bans()
+		require.Len(t, bans, 1)
+		require.Equal(t, peerIDToKey(peerA), bans[0].ID)
+		require.Equal(t, now.Add(cfg.BanDuration), bans[0].Until)
+		require.Equal(t, "invalid vote", bans[0].Reason)
+
+		// the score is reset on ban
+		require.Zero(t, s.Score(peerA))
+	})
+
+	t.Run("Decay", func(t *testing.T) {
+		// ARRANGE
+		s, now := newScorer(cfg)
+
+		require.False(t, s.Penalize(peerA, cfg.MalformedPenalty, "oversized message"))
+		require.Equal(t, cfg.MalformedPenalty, s.Score(peerA))
+
+		// ACT
+		*now = now.Add(cfg.DecayHalfLife)
+
+		// ASSERT
+		require.InDelta(t, cfg.MalformedPenalty/2, s.Score(peerA), 1e-9)
+
+		// occasional errors don't lead to a ban
+		for i := 0; i < 10; i++ {
+			require.False(t, s.Penalize(peerA, cfg.MalformedPenalty, "oversized message"))
+			*now = now.Add(cfg.DecayHalfLife)
+		}
+	})
+
+	t.Run("BanExpires", func(t *testing.T) {
+		// ARRANGE
+		s, now := newScorer(cfg)
+		s.Ban(peerA, time.Minute, "test")
+		require.True(t, s.IsBanned(peerA))
+
+		// ACT
+		*now = now.Add(time.Minute)
+
+		// ASSERT
+		require.False(t, s.IsBanned(peerA))
+		require.Empty(t, s.Bans())
+	})
+
+	t.Run("Unban", func(t *testing.T) {
+		// ARRANGE
+		s, _ := newScorer(cfg)
+		s.Ban(peerA, time.Minute, "test")
+
+		// ACT
+		unbanned := s.Unban(peerA)
+
+		// ASSERT
+		require.True(t, unbanned)
+		require.False(t, s.IsBanned(peerA))
+		require.False(t, s.Unban(peerA))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		// ARRANGE
+		disabled := cfg
+		disabled.BanThreshold = 0
+
+		s, _ := newScorer(disabled)
+
+		// ACT
+		banned := s.Penalize(peerA, 1000, "invalid block")
+
+		// ASSERT
+		require.False(t, s.Enabled())
+		require.False(t, banned)
+		require.False(t, s.IsBanned(peerA))
+		require.Zero(t, s.Score(peerA))
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		// ARRANGE
+		s, now := newScorer(cfg)
+
+		for i := 0; i < maxScoredPeers; i++ {
+			s.Penalize(peer.ID(fmt.Sprintf("peer-%d", i)), cfg.ErrorPenalty, "invalid vote")
+		}
+
+		// ACT
+		*now = now.Add(100 * cfg.DecayHalfLife)
+		s.Penalize(peerA, cfg.ErrorPenalty, "invalid vote")
+
+		// ASSERT
+		require.Len(t, s.scores, 1)
+		require.Equal(t, cfg.ErrorPenalty, s.Score(peerA))
+	})
+}
@@ -139,6 +139,8 @@ func (ps *PeerSet) Remove(key p2p.ID, opts PeerRemovalOptions) error {
 		return errors.New("peer not found")
 	}
 
+	ps.host.Bandwidth().RemovePeer(id)
+
 	if err := p.Stop(); err != nil {
 		return errors.Wrap(err, "failed to stop peer")
 	}
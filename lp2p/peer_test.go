@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
@@ -115,6 +116,32 @@ func TestPeer(t *testing.T) {
 		require.Equal(t, expected, actual)
 	})
 
+	t.Run("Channels", func(t *testing.T) {
+		// ARRANGE
+		var (
+			ctx   = context.Background()
+			hosts = makeTestHosts(t, 2)
+			hostA = hosts[0]
+			hostB = hosts[1]
+		)
+
+		// given hostB handling channel 0xaa
+		hostB.SetStreamHandler(ProtocolID(0xaa), func(stream network.Stream) { _ = stream.Close() })
+
+		// ACT
+		err := hostA.Connect(ctx, hostB.AddrInfo())
+		require.NoError(t, err)
+
+		peerB, err := NewPeer(hostA, hostB.AddrInfo(), p2p.NopMetrics(), false, false, false)
+		require.NoError(t, err)
+
+		// ASSERT
+		// the channels are known once hostB is identified
+		require.Eventually(t, func() bool {
+			return peerB.NodeInfo().(p2p.DefaultNodeInfo).HasChannel(0xaa)
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
 	t.Run("Send", func(t *testing.T) {
 		// ARRANGE
 		var (
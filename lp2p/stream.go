@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +24,9 @@ const TimeoutStream = 10 * time.Second
 // Protocols should configure their own maximum size.
 const MaxStreamSize = 4 * (1 << 20)
 
+// ErrPayloadTooLarge is returned when reading a payload larger than the maximum size.
+var ErrPayloadTooLarge = errors.New("payload is too large")
+
 // ProtocolID returns the protocol ID for a given channel
 // Byte is used for compatibility with the original CometBFT implementation.
 func ProtocolID(channelID byte) protocol.ID {
@@ -31,6 +35,22 @@ func ProtocolID(channelID byte) protocol.ID {
 	)
 }
 
+// channelIDFromProtocolID returns the channel of the given protocol ID,
+// if it's a CometBFT channel protocol (see ProtocolID).
+func channelIDFromProtocolID(protocolID protocol.ID) (byte, bool) {
+	hex, ok := strings.CutPrefix(string(protocolID), ProtocolIDPrefix+"/channel/0x")
+	if !ok {
+		return 0, false
+	}
+
+	channelID, err := strconv.ParseUint(hex, 16, 8)
+	if err != nil {
+		return 0, false
+	}
+
+	return byte(channelID), true
+}
+
 // StreamWrite sends payload over a stream w/o waiting for a response.
 // Only guarantees that the recipient will receive the bytes (no "message processed" guarantee).
 // It doesn't control stream's lifecycle, so it's up to the caller to close the stream.
@@ -110,7 +130,7 @@ func StreamReadSized(s network.Stream, maxSize uint64) ([]byte, error) {
 	}
 
 	if payloadSize > payloadLimit {
-		return nil, errors.Errorf("payload is too large (got %d, max %d)", payloadSize, payloadLimit)
+		return nil, fmt.Errorf("%w (got %d, max %d)", ErrPayloadTooLarge, payloadSize, payloadLimit)
 	}
 
 	payload, err := readExactly(reader, payloadSize)
@@ -234,6 +234,20 @@ func TestProtocolID(t *testing.T) {
 		{channel: 0xff, expected: "/p2p/cometbft/1.0.0/channel/0xff"},
 	} {
 		require.Equal(t, protocol.ID(tt.expected), ProtocolID(tt.channel))
+
+		channelID, ok := channelIDFromProtocolID(ProtocolID(tt.channel))
+		require.True(t, ok)
+		require.Equal(t, tt.channel, channelID)
+	}
+
+	for _, protocolID := range []protocol.ID{
+		"/ipfs/id/1.0.0",
+		"/p2p/cometbft/1.0.0/channel/",
+		"/p2p/cometbft/1.0.0/channel/0x1ff",
+		"/p2p/cometbft/1.0.0/channel/0xzz",
+	} {
+		_, ok := channelIDFromProtocolID(protocolID)
+		require.False(t, ok, protocolID)
 	}
 }
 
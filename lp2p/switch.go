@@ -31,6 +31,9 @@ type Switch struct {
 	host    *Host
 	peerSet *PeerSet
 
+	// peers marked as persistent, private or unconditional at runtime
+	peerRegistry *peerRegistry
+
 	reactors *reactorSet
 
 	metrics *p2p.Metrics
@@ -50,7 +53,10 @@ type SwitchReactor struct {
 
 const MaxReconnectBackoff = 5 * time.Minute
 
-var _ p2p.Switcher = (*Switch)(nil)
+var (
+	_ p2p.Switcher   = (*Switch)(nil)
+	_ p2p.PeerBanner = (*Switch)(nil)
+)
 
 var ErrUnsupportedPeerFormat = errors.New("unsupported peer format")
 
@@ -65,8 +71,9 @@ func NewSwitch(
 	s := &Switch{
 		nodeInfo: nodeInfo,
 
-		host:    host,
-		peerSet: NewPeerSet(host, metrics, logger),
+		host:         host,
+		peerSet:      NewPeerSet(host, metrics, logger),
+		peerRegistry: newPeerRegistry(),
 
 		metrics: metrics,
 
@@ -78,6 +85,11 @@ func NewSwitch(
 
 	s.reactors = newReactorSet(s)
 
+	// let the connection gater know about persistent and unconditional peers
+	if connGater := host.ConnGater(); connGater != nil {
+		connGater.SetPeerOptsFunc(s.peerAddOpts)
+	}
+
 	for _, item := range reactors {
 		if err := s.reactors.Add(item.Reactor, item.Name); err != nil {
 			return nil, errors.Wrapf(err, "failed to add %q reactor", item.Name)
@@ -104,11 +116,12 @@ func (s *Switch) OnStart() error {
 		return fmt.Errorf("failed to start reactors: %w", err)
 	}
 
-	// 2. register peer failure handler
+	// 2. register peer failure handler.
+	// Dial failures are not misbehavior, so the peer is not penalized.
 	s.host.AddPeerFailureHandler(func(id peer.ID, err error) {
 		key := peerIDToKey(id)
 		peer := s.peerSet.Get(key)
-		s.StopPeerForError(peer, err)
+		s.stopPeer(peer, err, 0)
 	})
 
 	// at this point the switch is considered active.
@@ -131,7 +144,7 @@ func (s *Switch) OnStart() error {
 			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 			defer cancel()
 
-			opts := s.bootstrapPeerOpts(bp)
+			opts := s.peerAddOpts(bp.AddrInfo.ID)
 
 			if err := s.bootstrapPeer(ctx, bp.AddrInfo, opts); err != nil {
 				s.Logger.Error(
@@ -205,8 +218,17 @@ func (s *Switch) Peers() p2p.IPeerSet {
 	return s.peerSet
 }
 
+// NumPeers returns the number of connected peers by the direction of their first connection.
 func (s *Switch) NumPeers() (outbound, inbound, dialing int) {
+	seen := make(map[peer.ID]struct{})
+
 	for _, c := range s.host.Network().Conns() {
+		if _, ok := seen[c.RemotePeer()]; ok {
+			continue
+		}
+
+		seen[c.RemotePeer()] = struct{}{}
+
 		switch c.Stat().Direction {
 		case network.DirInbound:
 			inbound++
@@ -220,16 +242,58 @@ func (s *Switch) NumPeers() (outbound, inbound, dialing int) {
 	return outbound, inbound, dialing
 }
 
+// MaxNumOutboundPeers returns the outbound peers quota of the connection gater, or 0 if unlimited.
 func (s *Switch) MaxNumOutboundPeers() int {
-	// used only by PEX
-	s.logUnimplemented("MaxNumOutboundPeers")
+	if connGater := s.host.ConnGater(); connGater != nil {
+		return connGater.MaxOutboundPeers()
+	}
 
 	return 0
 }
 
-func (s *Switch) AddPersistentPeers(addrs []string) error    { return ErrUnsupportedPeerFormat }
-func (s *Switch) AddPrivatePeerIDs(ids []string) error       { return ErrUnsupportedPeerFormat }
-func (s *Switch) AddUnconditionalPeerIDs(ids []string) error { return ErrUnsupportedPeerFormat }
+// AddPersistentPeers marks the given peers as persistent, so that the switch
+// reconnects to them when they disconnect. Addresses are either multiaddrs
+// ending with the peer ID or "<peer_id>@host:port" (see AddrInfoFromString).
+// It does not dial the peers.
+func (s *Switch) AddPersistentPeers(addrs []string) error {
+	s.Logger.Info("Adding persistent peers", "addrs", addrs)
+
+	addrInfos, err := addrInfosFromStrings(addrs, s.host.Transports())
+	if err != nil {
+		return err
+	}
+
+	s.peerRegistry.addPersistent(addrInfos...)
+
+	return nil
+}
+
+// AddPrivatePeerIDs marks the peers with the given libp2p peer IDs as private.
+func (s *Switch) AddPrivatePeerIDs(ids []string) error {
+	peerIDs, err := peerIDsFromStrings(ids)
+	if err != nil {
+		return err
+	}
+
+	s.peerRegistry.addPrivate(peerIDs...)
+
+	return nil
+}
+
+// AddUnconditionalPeerIDs marks the peers with the given libp2p peer IDs as
+// unconditional.
+func (s *Switch) AddUnconditionalPeerIDs(ids []string) error {
+	s.Logger.Info("Adding unconditional peer ids", "ids", ids)
+
+	peerIDs, err := peerIDsFromStrings(ids)
+	if err != nil {
+		return err
+	}
+
+	s.peerRegistry.addUnconditional(peerIDs...)
+
+	return nil
+}
 
 func (s *Switch) DialPeerWithAddress(_ *p2p.NetAddress) error {
 	// used only by PEX
@@ -238,8 +302,31 @@ func (s *Switch) DialPeerWithAddress(_ *p2p.NetAddress) error {
 	return nil
 }
 
+// DialPeersAsync dials the given peers in the background. Addresses have the
+// same format as in AddPersistentPeers. Peers that fail to connect are retried
+// only if they are persistent.
 func (s *Switch) DialPeersAsync(peers []string) error {
-	s.logUnimplemented("DialPeersAsync", "peers", peers)
+	addrInfos, err := addrInfosFromStrings(peers, s.host.Transports())
+	if err != nil {
+		return err
+	}
+
+	if !s.isActive() {
+		return errors.New("switch is not running")
+	}
+
+	for _, addrInfo := range addrInfos {
+		if addrInfo.ID == s.host.ID() {
+			s.Logger.Info("Ignoring connection to self")
+			continue
+		}
+
+		if s.peerSet.Has(peerIDToKey(addrInfo.ID)) {
+			continue
+		}
+
+		go s.dialPeer(addrInfo)
+	}
 
 	return nil
 }
@@ -249,7 +336,19 @@ func (s *Switch) StopPeerGracefully(_ p2p.Peer) {
 	s.logUnimplemented("StopPeerGracefully")
 }
 
+// StopPeerForError disconnects the peer and penalizes its score, unless the error is transient.
+// Peers whose score reaches the ban threshold are banned and not reconnected until the ban expires.
 func (s *Switch) StopPeerForError(peer p2p.Peer, reason any) {
+	penalty := s.host.Scorer().ErrorPenalty()
+	if _, ok := p2p.TransientErrorFromAny(reason); ok {
+		penalty = 0
+	}
+
+	s.stopPeer(peer, reason, penalty)
+}
+
+// stopPeer disconnects the peer and penalizes it with the given penalty (if any).
+func (s *Switch) stopPeer(peer p2p.Peer, reason any, penalty float64) {
 	// should not happen
 	p, ok := peer.(*Peer)
 	if !ok {
@@ -275,12 +374,31 @@ func (s *Switch) StopPeerForError(peer p2p.Peer, reason any) {
 		s.Logger.Error("Failed to close peer", "peer_id", pid, "err", err)
 	}
 
+	banned := s.penalizePeer(p.addrInfo.ID, penalty, reason)
+
 	// reconnect logic
-	shouldReconnect := false
+	var (
+		shouldReconnect = false
+		addrInfo        = p.AddrInfo()
+		opts            = s.peerAddOpts(p.addrInfo.ID)
+	)
+
+	// peers might have been marked as persistent after they connected
+	opts.Persistent = opts.Persistent || p.IsPersistent()
+	opts.Unconditional = opts.Unconditional || p.IsUnconditional()
+	opts.Private = opts.Private || p.IsPrivate()
+
+	// prefer the address the peer was marked as persistent with
+	if ai, ok := s.peerRegistry.persistentAddrInfo(p.addrInfo.ID); ok {
+		addrInfo = ai
+	}
 
-	if p.IsPersistent() {
+	if opts.Persistent {
+		// banned persistent peers are reconnected once the ban expires
 		shouldReconnect = true
-		s.Logger.Debug("Will reconnect to peer", "peer_id", pid, "err", reason)
+		s.Logger.Debug("Will reconnect to peer", "peer_id", pid, "err", reason, "banned", banned)
+	} else if banned {
+		s.Logger.Debug("Will not reconnect to banned peer", "peer_id", pid, "err", reason)
 	} else if errTransient, ok := p2p.TransientErrorFromAny(reason); ok {
 		shouldReconnect = true
 		s.Logger.Debug("Will reconnect to peer after transient error", "peer_id", pid, "err", errTransient.Err)
@@ -290,14 +408,28 @@ func (s *Switch) StopPeerForError(peer p2p.Peer, reason any) {
 		return
 	}
 
-	go s.reconnectPeer(p.AddrInfo(), MaxReconnectBackoff, PeerAddOptions{
-		Persistent:    p.IsPersistent(),
-		Unconditional: p.IsUnconditional(),
-		Private:       p.IsPrivate(),
-		OnBeforeStart: s.reactors.InitPeer,
-		OnAfterStart:  s.reactors.AddPeer,
-		OnStartFailed: s.reactors.RemovePeer,
-	})
+	go s.reconnectPeer(addrInfo, MaxReconnectBackoff, opts)
+}
+
+// BannedPeers returns the currently banned peers.
+func (s *Switch) BannedPeers() []p2p.BannedPeer {
+	return s.host.Scorer().Bans()
+}
+
+// UnbanPeer lifts the ban of a peer and resets its score.
+// Returns false if the peer was not banned.
+func (s *Switch) UnbanPeer(key p2p.ID) (bool, error) {
+	id, err := peer.Decode(string(key))
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid peer id %q", key)
+	}
+
+	unbanned := s.host.Scorer().Unban(id)
+	if unbanned {
+		s.Logger.Info("Unbanned peer", "peer_id", key)
+	}
+
+	return unbanned, nil
 }
 
 func (s *Switch) IsDialingOrExistingAddress(addr *p2p.NetAddress) bool {
@@ -306,21 +438,29 @@ func (s *Switch) IsDialingOrExistingAddress(addr *p2p.NetAddress) bool {
 }
 
 func (s *Switch) IsPeerPersistent(netAddr *p2p.NetAddress) bool {
-	p := s.peerSet.Get(netAddr.ID)
-	if p == nil {
+	if p := s.peerSet.Get(netAddr.ID); p != nil && p.(*Peer).IsPersistent() {
+		return true
+	}
+
+	id, err := peer.Decode(string(netAddr.ID))
+	if err != nil {
 		return false
 	}
 
-	return p.(*Peer).IsPersistent()
+	return s.peerAddOpts(id).Persistent
 }
 
-func (s *Switch) IsPeerUnconditional(id p2p.ID) bool {
-	p := s.peerSet.Get(id)
-	if p == nil {
+func (s *Switch) IsPeerUnconditional(key p2p.ID) bool {
+	if p := s.peerSet.Get(key); p != nil && p.(*Peer).IsUnconditional() {
+		return true
+	}
+
+	id, err := peer.Decode(string(key))
+	if err != nil {
 		return false
 	}
 
-	return p.(*Peer).IsUnconditional()
+	return s.peerAddOpts(id).Unconditional
 }
 
 func (s *Switch) MarkPeerAsGood(_ p2p.Peer) {
@@ -397,10 +537,18 @@ func (s *Switch) handleStream(stream network.Stream) {
 		return
 	}
 
-	// 2. Read the stream so we can "release" it on another end (with a maximum size)
-	payload, err := StreamReadSizedClose(stream, proto.maxMessageSize())
+	// 2. Read the stream so we can "release" it on another end (with a maximum size).
+	// Blocks while the receive rate limits are exceeded.
+	limitedStream := s.host.Bandwidth().Wrap(stream, proto.descriptor.ID, s.metrics)
+
+	payload, err := StreamReadSizedClose(limitedStream, proto.maxMessageSize())
 	if err != nil {
 		s.Logger.Error("Failed to read payload", "protocol", protocolID, "err", err)
+
+		if errors.Is(err, ErrPayloadTooLarge) {
+			s.stopPeerForMalformedMsg(peerID, err)
+		}
+
 		return
 	}
 
@@ -429,7 +577,7 @@ func (s *Switch) handleStream(stream network.Stream) {
 	msg, err := unmarshalProto(proto.descriptor, payload)
 	if err != nil {
 		s.Logger.Error("Failed to unmarshal message", "protocol", protocolID, "err", err)
-		s.StopPeerForError(peer, err)
+		s.stopPeer(peer, err, s.host.Scorer().MalformedPenalty())
 		return
 	}
 
@@ -466,6 +614,43 @@ func (s *Switch) handleStream(stream network.Stream) {
 	s.reactors.Receive(reactor.name, messageType, envelope, priority)
 }
 
+// stopPeerForMalformedMsg disconnects the peer that sent a malformed message
+// and penalizes it. The peer might not be provisioned yet, in which case only
+// its score is updated.
+func (s *Switch) stopPeerForMalformedMsg(id peer.ID, err error) {
+	penalty := s.host.Scorer().MalformedPenalty()
+
+	if peer := s.peerSet.Get(peerIDToKey(id)); peer != nil {
+		s.stopPeer(peer, err, penalty)
+		return
+	}
+
+	if s.penalizePeer(id, penalty, err) {
+		_ = s.host.Network().ClosePeer(id)
+	}
+}
+
+// penalizePeer adds the penalty to the peer's score. Unconditional peers are never penalized.
+// Returns true if the peer is banned.
+func (s *Switch) penalizePeer(id peer.ID, penalty float64, reason any) bool {
+	scorer := s.host.Scorer()
+
+	if !scorer.Enabled() || s.IsPeerUnconditional(peerIDToKey(id)) {
+		return false
+	}
+
+	if penalty <= 0 {
+		return scorer.IsBanned(id)
+	}
+
+	banned := scorer.Penalize(id, penalty, fmt.Sprintf("%v", reason))
+	if banned {
+		s.Logger.Info("Banned peer", "peer_id", id.String(), "reason", reason)
+	}
+
+	return banned
+}
+
 func (s *Switch) resolvePeer(id peer.ID, connRemoteAddr ma.Multiaddr) (p2p.Peer, error) {
 	key := peerIDToKey(id)
 
@@ -486,25 +671,8 @@ func (s *Switch) resolvePeer(id peer.ID, connRemoteAddr ma.Multiaddr) (p2p.Peer,
 		}
 	}
 
-	var isPrivate, isPersistent, isUnconditional bool
-
-	// check if this is a bootstrap peer
-	bp, ok := s.host.BootstrapPeer(id)
-	if ok {
-		isPrivate = bp.Private
-		isPersistent = bp.Persistent
-		isUnconditional = bp.Unconditional
-	}
-
 	// let's try to provision it
-	opts := PeerAddOptions{
-		Private:       isPrivate,
-		Persistent:    isPersistent,
-		Unconditional: isUnconditional,
-		OnBeforeStart: s.reactors.InitPeer,
-		OnAfterStart:  s.reactors.AddPeer,
-		OnStartFailed: s.reactors.RemovePeer,
-	}
+	opts := s.peerAddOpts(id)
 
 	peer, err := s.peerSet.Add(addrInfo, opts)
 	switch {
@@ -523,18 +691,23 @@ func (s *Switch) resolvePeer(id peer.ID, connRemoteAddr ma.Multiaddr) (p2p.Peer,
 	}
 }
 
-func (s *Switch) bootstrapPeerOpts(bp BootstrapPeer) PeerAddOptions {
+// peerAddOpts returns the options to add the given peer with, combining its
+// bootstrap peer flags (if any) with the flags set at runtime.
+func (s *Switch) peerAddOpts(id peer.ID) PeerAddOptions {
+	bp, _ := s.host.BootstrapPeer(id)
+
 	return PeerAddOptions{
-		Private:       bp.Private,
-		Persistent:    bp.Persistent,
-		Unconditional: bp.Unconditional,
+		Private:       bp.Private || s.peerRegistry.isPrivate(id),
+		Persistent:    bp.Persistent || s.peerRegistry.isPersistent(id),
+		Unconditional: bp.Unconditional || s.peerRegistry.isUnconditional(id),
 		OnBeforeStart: s.reactors.InitPeer,
 		OnAfterStart:  s.reactors.AddPeer,
 		OnStartFailed: s.reactors.RemovePeer,
 	}
 }
 
-// bootstrapPeer connects a bootstrap peer to the host. should be used ONLY during switch start.
+// bootstrapPeer connects a peer to the host and adds it to the peer set.
+// Used for bootstrap peers during switch start and for peers dialed at runtime.
 func (s *Switch) bootstrapPeer(ctx context.Context, addrInfo peer.AddrInfo, opts PeerAddOptions) error {
 	if addrInfo.ID == s.host.ID() {
 		s.Logger.Info("Ignoring connection to self")
@@ -579,6 +752,26 @@ func (s *Switch) bootstrapPeer(ctx context.Context, addrInfo peer.AddrInfo, opts
 	return nil
 }
 
+// dialPeer connects a peer dialed at runtime (blocking).
+// Persistent peers that fail to connect are reconnected in the background.
+func (s *Switch) dialPeer(addrInfo peer.AddrInfo) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	opts := s.peerAddOpts(addrInfo.ID)
+
+	err := s.bootstrapPeer(ctx, addrInfo, opts)
+	if err == nil || errors.Is(err, ErrPeerExists) {
+		return
+	}
+
+	s.Logger.Error("Unable to dial peer", "peer_id", addrInfo.ID.String(), "err", err)
+
+	if opts.Persistent {
+		go s.reconnectPeer(addrInfo, MaxReconnectBackoff, opts)
+	}
+}
+
 // reconnectPeer reconnects persistent peers back to the host.
 // uses exponential backoff to reconnect.
 func (s *Switch) reconnectPeer(addrInfo peer.AddrInfo, backoffMax time.Duration, opts PeerAddOptions) {
@@ -687,3 +880,33 @@ func (s *Switch) pingPeer(addrInfo peer.AddrInfo) {
 func (s *Switch) isActive() bool {
 	return s.active.Load()
 }
+
+func addrInfosFromStrings(addrs []string, transports []string) ([]peer.AddrInfo, error) {
+	addrInfos := make([]peer.AddrInfo, 0, len(addrs))
+
+	for _, addr := range addrs {
+		addrInfo, err := AddrInfoFromString(addr, transports...)
+		if err != nil {
+			return nil, err
+		}
+
+		addrInfos = append(addrInfos, addrInfo)
+	}
+
+	return addrInfos, nil
+}
+
+func peerIDsFromStrings(ids []string) ([]peer.ID, error) {
+	peerIDs := make([]peer.ID, 0, len(ids))
+
+	for _, id := range ids {
+		peerID, err := PeerIDFromString(id)
+		if err != nil {
+			return nil, err
+		}
+
+		peerIDs = append(peerIDs, peerID)
+	}
+
+	return peerIDs, nil
+}
@@ -287,6 +287,191 @@ func TestSwitch(t *testing.T) {
 		require.Contains(t, logBuffer.String(), "Reconnected to peer")
 	})
 
+	t.Run("BanMisbehavingPeer", func(t *testing.T) {
+		// ARRANGE
+		var (
+			ctx       = context.Background()
+			ports     = utils.GetFreePorts(t, 2)
+			logBuffer = &syncBuffer{}
+			logger    = log.NewTMLogger(logBuffer)
+
+			// a single error bans the peer
+			scoring = func(cfg *config.LibP2PConfig) {
+				cfg.Scoring.BanThreshold = cfg.Scoring.ErrorPenalty
+			}
+		)
+
+		// Given 2 hosts: A and B
+		var (
+			hostA = makeTestHost(t, ports[0], withLogging(), withModifiedConfig(scoring))
+			hostB = makeTestHost(t, ports[1], withLogging())
+		)
+
+		// Given switch A
+		switchA, err := NewSwitch(nil, hostA, []SwitchReactor{}, p2p.NopMetrics(), logger.With("switch", "A"))
+		require.NoError(t, err)
+
+		require.NoError(t, switchA.Start())
+		t.Cleanup(func() {
+			_ = switchA.Stop()
+		})
+
+		// Connect A to B
+		err = switchA.bootstrapPeer(ctx, hostB.AddrInfo(), PeerAddOptions{})
+		require.NoError(t, err)
+
+		peerB := switchA.Peers().Get(peerIDToKey(hostB.ID()))
+		require.NotNil(t, peerB)
+
+		// ACT #1: B misbehaves
+		switchA.StopPeerForError(peerB, errors.New("invalid vote"))
+
+		// ASSERT #1: B is removed and banned
+		require.Equal(t, 0, switchA.Peers().Size())
+
+		bans := switchA.BannedPeers()
+		require.Len(t, bans, 1)
+		require.Equal(t, peerIDToKey(hostB.ID()), bans[0].ID)
+		require.Equal(t, "invalid vote", bans[0].Reason)
+		require.True(t, bans[0].Until.After(time.Now()))
+
+		require.Contains(t, logBuffer.String(), "Banned peer")
+		require.Contains(t, logBuffer.String(), "Will not reconnect to banned peer")
+
+		// ASSERT #2: B can't reconnect
+		_ = hostB.Connect(ctx, hostA.AddrInfo())
+		require.Eventually(t, func() bool {
+			return hostA.Network().Connectedness(hostB.ID()) != network.Connected
+		}, 2*time.Second, 50*time.Millisecond)
+
+		err = switchA.bootstrapPeer(ctx, hostB.AddrInfo(), PeerAddOptions{})
+		require.Error(t, err)
+
+		// ACT #2: unban B
+		unbanned, err := switchA.UnbanPeer(peerIDToKey(hostB.ID()))
+		require.NoError(t, err)
+		require.True(t, unbanned)
+
+		unbanned, err = switchA.UnbanPeer(peerIDToKey(hostB.ID()))
+		require.NoError(t, err)
+		require.False(t, unbanned)
+
+		_, err = switchA.UnbanPeer("not-a-peer-id")
+		require.Error(t, err)
+
+		// ASSERT #3: B can connect again
+		require.Empty(t, switchA.BannedPeers())
+		require.NoError(t, switchA.bootstrapPeer(ctx, hostB.AddrInfo(), PeerAddOptions{}))
+		require.Equal(t, 1, switchA.Peers().Size())
+	})
+
+	t.Run("UnconditionalPeerNotBanned", func(t *testing.T) {
+		// ARRANGE
+		ctx := context.Background()
+
+		hosts := makeTestHosts(t, 2, withModifiedConfig(func(cfg *config.LibP2PConfig) {
+			cfg.Scoring.BanThreshold = cfg.Scoring.ErrorPenalty
+		}))
+		hostA, hostB := hosts[0], hosts[1]
+
+		switchA, err := NewSwitch(nil, hostA, []SwitchReactor{}, p2p.NopMetrics(), hostA.Logger())
+		require.NoError(t, err)
+
+		require.NoError(t, switchA.AddUnconditionalPeerIDs([]string{hostB.ID().String()}))
+
+		err = switchA.bootstrapPeer(ctx, hostB.AddrInfo(), PeerAddOptions{})
+		require.NoError(t, err)
+
+		peerB := switchA.Peers().Get(peerIDToKey(hostB.ID()))
+		require.NotNil(t, peerB)
+
+		// ACT
+		switchA.StopPeerForError(peerB, errors.New("invalid vote"))
+
+		// ASSERT
+		require.Empty(t, switchA.BannedPeers())
+		require.False(t, hostA.Scorer().IsBanned(hostB.ID()))
+	})
+
+	t.Run("RuntimePeers", func(t *testing.T) {
+		// ARRANGE
+		var (
+			ports     = utils.GetFreePorts(t, 3)
+			logBuffer = &syncBuffer{}
+			logger    = log.NewTMLogger(logBuffer)
+		)
+
+		// Given 3 hosts: A, B, C with NO bootstrap peers
+		var (
+			hostA = makeTestHost(t, ports[0], withLogging())
+			hostB = makeTestHost(t, ports[1], withLogging())
+			hostC = makeTestHost(t, ports[2], withLogging())
+		)
+
+		// Given a started switch A
+		switchA, err := NewSwitch(
+			nil,
+			hostA,
+			[]SwitchReactor{},
+			p2p.NopMetrics(),
+			logger.With("switch", "A"),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, switchA.Start())
+		t.Cleanup(func() {
+			_ = switchA.Stop()
+		})
+
+		// Given B's address as a multiaddr, and C's in the legacy format
+		var (
+			addrB = fmt.Sprintf("%s/p2p/%s", hostB.AddrInfo().Addrs[0], hostB.ID())
+			addrC = fmt.Sprintf("%s@127.0.0.1:%d", hostC.ID(), ports[2])
+		)
+
+		// ACT #1: Mark B as persistent and C as unconditional, then dial both
+		require.NoError(t, switchA.AddPersistentPeers([]string{addrB}))
+		require.NoError(t, switchA.AddUnconditionalPeerIDs([]string{hostC.ID().String()}))
+		require.NoError(t, switchA.AddPrivatePeerIDs([]string{hostC.ID().String()}))
+		require.NoError(t, switchA.DialPeersAsync([]string{addrB, addrC}))
+
+		// ASSERT #1: Both peers are connected with the runtime flags
+		require.Eventually(t, func() bool {
+			return switchA.Peers().Size() == 2
+		}, 5*time.Second, 50*time.Millisecond, "B and C should be connected")
+
+		peerB := switchA.Peers().Get(peerIDToKey(hostB.ID()))
+		require.NotNil(t, peerB)
+		require.True(t, peerB.(*Peer).IsPersistent())
+		require.True(t, switchA.IsPeerPersistent(peerB.SocketAddr()))
+
+		peerC := switchA.Peers().Get(peerIDToKey(hostC.ID()))
+		require.NotNil(t, peerC)
+		require.False(t, peerC.(*Peer).IsPersistent())
+		require.True(t, peerC.(*Peer).IsPrivate())
+		require.True(t, switchA.IsPeerUnconditional(peerC.ID()))
+
+		// ACT #2: Stop both peers for error
+		switchA.StopPeerForError(peerB, "simulated error")
+		switchA.StopPeerForError(peerC, "simulated error")
+
+		// ASSERT #2: Only the persistent peer B is reconnected
+		require.Eventually(t, func() bool {
+			return switchA.Peers().Has(peerIDToKey(hostB.ID()))
+		}, 10*time.Second, 100*time.Millisecond, "B should be reconnected")
+		require.False(t, switchA.Peers().Has(peerIDToKey(hostC.ID())))
+
+		// ACT #3: Invalid addresses are rejected
+		err = switchA.AddPersistentPeers([]string{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4@127.0.0.1:26656"})
+		require.ErrorIs(t, err, ErrUnsupportedPeerFormat)
+
+		err = switchA.DialPeersAsync([]string{"127.0.0.1:26656"})
+		require.ErrorIs(t, err, ErrUnsupportedPeerFormat)
+
+		err = switchA.AddUnconditionalPeerIDs([]string{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4"})
+		require.ErrorIs(t, err, ErrUnsupportedPeerFormat)
+	})
+
 	t.Run("EnsureScalers", func(t *testing.T) {
 		// ARRANGE
 		var (
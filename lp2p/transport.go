@@ -0,0 +1,134 @@
+package lp2p
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/p2p/muxer/yamux"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	tls "github.com/libp2p/go-libp2p/p2p/security/tls"
+	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// TransportTCP tcp transport, secured with noise or tls and multiplexed with yamux.
+// @see https://docs.libp2p.io/concepts/transports/listen-and-dial
+const TransportTCP = "tcp"
+
+// dialFallbackDelay is the delay before dialing the addresses of the next
+// transport in preference order, if the previous ones haven't connected yet.
+const dialFallbackDelay = 500 * time.Millisecond
+
+// TransportsFromConfig converts the transports of the config to multiaddr
+// transport names, preserving the preference order.
+func TransportsFromConfig(cfg config.LibP2PConfig) ([]string, error) {
+	transports := make([]string, 0, len(cfg.Transports))
+
+	for _, t := range cfg.Transports {
+		switch t {
+		case config.LibP2PTransportQUIC:
+			transports = append(transports, TransportQUIC)
+		case config.LibP2PTransportTCP:
+			transports = append(transports, TransportTCP)
+		default:
+			return nil, fmt.Errorf("unsupported transport: %s", t)
+		}
+	}
+
+	if len(transports) == 0 {
+		return nil, fmt.Errorf("no transports")
+	}
+
+	return transports, nil
+}
+
+// transportOptions returns the libp2p options enabling the given transports
+// and, for TCP, the configured security protocols and yamux.
+func transportOptions(transports []string, security []string) ([]libp2p.Option, error) {
+	opts := []libp2p.Option{}
+
+	for _, t := range transports {
+		switch t {
+		case TransportQUIC:
+			opts = append(opts, libp2p.Transport(quic.NewTransport))
+		case TransportTCP:
+			opts = append(opts, libp2p.Transport(tcp.NewTCPTransport), libp2p.Muxer(yamux.ID, yamux.DefaultTransport))
+
+			if len(security) == 0 {
+				return nil, fmt.Errorf("tcp transport requires a security protocol")
+			}
+
+			for _, sec := range security {
+				switch sec {
+				case config.LibP2PSecurityNoise:
+					opts = append(opts, libp2p.Security(noise.ID, noise.New))
+				case config.LibP2PSecurityTLS:
+					opts = append(opts, libp2p.Security(tls.ID, tls.New))
+				default:
+					return nil, fmt.Errorf("unsupported security protocol: %s", sec)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unsupported transport: %s", t)
+		}
+	}
+
+	opts = append(opts, libp2p.SwarmOpts(swarm.WithDialRanker(newDialRanker(transports))))
+
+	return opts, nil
+}
+
+// newDialRanker returns a dial ranker dialing the addresses of each transport
+// in preference order, falling back to the next transport after dialFallbackDelay.
+// Addresses of disabled transports are not dialed.
+func newDialRanker(transports []string) network.DialRanker {
+	return func(addrs []ma.Multiaddr) []network.AddrDelay {
+		res := make([]network.AddrDelay, 0, len(addrs))
+
+		for i, t := range transports {
+			delay := time.Duration(i) * dialFallbackDelay
+
+			for _, addr := range addrs {
+				if addrTransport(addr) == t {
+					res = append(res, network.AddrDelay{Addr: addr, Delay: delay})
+				}
+			}
+		}
+
+		return res
+	}
+}
+
+// addrTransport returns the transport of the given multiaddr,
+// or "" if it's neither QUIC nor plain TCP.
+func addrTransport(addr ma.Multiaddr) string {
+	var hasTCP, hasQUIC, hasOther bool
+
+	for _, c := range addr {
+		switch c.Protocol().Code {
+		case ma.P_QUIC_V1:
+			hasQUIC = true
+		case ma.P_TCP:
+			hasTCP = true
+		case ma.P_IP4, ma.P_IP6, ma.P_DNS, ma.P_DNS4, ma.P_DNS6, ma.P_UDP, ma.P_P2P:
+		default:
+			hasOther = true
+		}
+	}
+
+	switch {
+	case hasOther:
+		return ""
+	case hasQUIC:
+		return TransportQUIC
+	case hasTCP:
+		return TransportTCP
+	default:
+		return ""
+	}
+}
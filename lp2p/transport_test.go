@@ -0,0 +1,142 @@
+package lp2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/libp2p/go-libp2p/p2p/muxer/yamux"
+	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	tls "github.com/libp2p/go-libp2p/p2p/security/tls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransportsFromConfig(t *testing.T) {
+	cfg := config.DefaultLibP2PConfig()
+
+	transports, err := TransportsFromConfig(cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{TransportQUIC}, transports)
+
+	cfg.Transports = []string{config.LibP2PTransportTCP, config.LibP2PTransportQUIC}
+	transports, err = TransportsFromConfig(cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{TransportTCP, TransportQUIC}, transports)
+
+	cfg.Transports = []string{"websocket"}
+	_, err = TransportsFromConfig(cfg)
+	require.ErrorContains(t, err, "unsupported transport")
+
+	cfg.Transports = nil
+	_, err = TransportsFromConfig(cfg)
+	require.ErrorContains(t, err, "no transports")
+}
+
+func TestDialRanker(t *testing.T) {
+	addrs := mustMultiaddrs(t,
+		"/ip4/1.2.3.4/udp/26656/quic-v1",
+		"/ip4/1.2.3.4/tcp/26656",
+		"/ip4/1.2.3.4/tcp/26656/ws",
+		"/dns/node0.example.com/tcp/26656",
+	)
+
+	t.Run("tcp first", func(t *testing.T) {
+		ranked := newDialRanker([]string{TransportTCP, TransportQUIC})(addrs)
+
+		require.Len(t, ranked, 3)
+		require.Equal(t, "/ip4/1.2.3.4/tcp/26656", ranked[0].Addr.String())
+		require.Equal(t, time.Duration(0), ranked[0].Delay)
+		require.Equal(t, "/dns/node0.example.com/tcp/26656", ranked[1].Addr.String())
+		require.Equal(t, time.Duration(0), ranked[1].Delay)
+		require.Equal(t, "/ip4/1.2.3.4/udp/26656/quic-v1", ranked[2].Addr.String())
+		require.Equal(t, dialFallbackDelay, ranked[2].Delay)
+	})
+
+	t.Run("quic only", func(t *testing.T) {
+		ranked := newDialRanker([]string{TransportQUIC})(addrs)
+
+		require.Len(t, ranked, 1)
+		require.Equal(t, "/ip4/1.2.3.4/udp/26656/quic-v1", ranked[0].Addr.String())
+		require.Equal(t, time.Duration(0), ranked[0].Delay)
+	})
+}
+
+func TestHostTransports(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		transports    []string
+		security      []string
+		wantTransport string
+		wantSecurity  string
+	}{
+		{
+			name:          "quic",
+			transports:    []string{config.LibP2PTransportQUIC},
+			wantTransport: TransportQUIC,
+		},
+		{
+			name:          "tcp with noise",
+			transports:    []string{config.LibP2PTransportTCP},
+			security:      []string{config.LibP2PSecurityNoise},
+			wantTransport: TransportTCP,
+			wantSecurity:  noise.ID,
+		},
+		{
+			name:          "tcp with tls",
+			transports:    []string{config.LibP2PTransportTCP},
+			security:      []string{config.LibP2PSecurityTLS},
+			wantTransport: TransportTCP,
+			wantSecurity:  tls.ID,
+		},
+		{
+			name:          "tcp preferred over quic",
+			transports:    []string{config.LibP2PTransportTCP, config.LibP2PTransportQUIC},
+			security:      []string{config.LibP2PSecurityNoise, config.LibP2PSecurityTLS},
+			wantTransport: TransportTCP,
+			wantSecurity:  noise.ID,
+		},
+		{
+			name:          "quic preferred over tcp",
+			transports:    []string{config.LibP2PTransportQUIC, config.LibP2PTransportTCP},
+			security:      []string{config.LibP2PSecurityNoise},
+			wantTransport: TransportQUIC,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			// Given 2 hosts with the same transports
+			hosts := makeTestHosts(t, 2, withModifiedConfig(func(cfg *config.LibP2PConfig) {
+				cfg.Transports = tt.transports
+				cfg.Security = tt.security
+			}))
+			hostA, hostB := hosts[0], hosts[1]
+
+			// Host B listens with each transport
+			require.Len(t, hostB.AddrInfo().Addrs, len(tt.transports))
+
+			// ACT
+			err := hostA.Connect(ctx, hostB.AddrInfo())
+			require.NoError(t, err)
+
+			// ASSERT
+			conns := hostA.Network().ConnsToPeer(hostB.ID())
+			require.Len(t, conns, 1)
+
+			state := conns[0].ConnState()
+			require.Equal(t, tt.wantTransport, addrTransport(conns[0].RemoteMultiaddr()))
+
+			if tt.wantTransport == TransportTCP {
+				require.EqualValues(t, tt.wantSecurity, state.Security)
+				require.EqualValues(t, yamux.ID, state.StreamMultiplexer)
+			}
+
+			rtt, err := hostA.Ping(ctx, hostB.AddrInfo())
+			require.NoError(t, err)
+			require.Positive(t, rtt)
+		})
+	}
+}
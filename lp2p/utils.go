@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
 )
@@ -29,9 +30,32 @@ func PrivateKeyFromCosmosKey(key cmcrypto.PrivKey) (crypto.PrivKey, error) {
 	}
 }
 
-func withAddressFactory(addr ma.Multiaddr) libp2p.Option {
-	fn := func(addrs []ma.Multiaddr) []ma.Multiaddr {
-		return []ma.Multiaddr{addr}
+// CometIDFromPeerID returns the CometBFT node ID (hex address of the public key)
+// of a libp2p peer ID. The peer ID must embed the public key (e.g. ed25519).
+func CometIDFromPeerID(id peer.ID) (p2p.ID, error) {
+	pk, err := id.ExtractPublicKey()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to extract public key")
+	}
+
+	raw, err := pk.Raw()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get raw public key")
+	}
+
+	switch pk.Type() {
+	case crypto.Ed25519:
+		return p2p.PubKeyToID(ed25519.PubKey(raw)), nil
+	case crypto.Secp256k1:
+		return p2p.PubKeyToID(secp256k1.PubKey(raw)), nil
+	default:
+		return "", fmt.Errorf("unsupported public key type %q", pk.Type())
+	}
+}
+
+func withAddressFactory(addrs ...ma.Multiaddr) libp2p.Option {
+	fn := func([]ma.Multiaddr) []ma.Multiaddr {
+		return addrs
 	}
 
 	return libp2p.AddrsFactory(fn)
@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	"github.com/cometbft/cometbft/abci/types"
+	cmcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 )
@@ -109,3 +111,28 @@ func TestKeyConversion(t *testing.T) {
 		require.Equal(t, "12D3KooWE3h4xxbYockU6Y5EKhffWqo4AtMGwNS3UzmGkEFKGPZK", peerID.String())
 	})
 }
+
+func TestCometIDFromPeerID(t *testing.T) {
+	secret := []byte("lp2p-test-secret")
+
+	for _, tt := range []struct {
+		name string
+		key  cmcrypto.PrivKey
+	}{
+		{name: "ecdsa", key: secp256k1.GenPrivKeySecp256k1(secret)},
+		{name: "eddsa", key: ed25519.GenPrivKeyFromSecret(secret)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			peerID, err := IDFromPrivateKey(tt.key)
+			require.NoError(t, err)
+
+			// ACT
+			id, err := CometIDFromPeerID(peerID)
+
+			// ASSERT
+			require.NoError(t, err)
+			require.Equal(t, p2p.PubKeyToID(tt.key.PubKey()), id)
+		})
+	}
+}
@@ -360,6 +360,15 @@ func (mem *CListMempool) addTx(memTx *mempoolTx) {
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
 }
 
+// GetTxByKey returns the transaction with the given key, if it's in the
+// mempool. Safe for concurrent use.
+func (mem *CListMempool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) {
+	if memTx := mem.getMemTx(txKey); memTx != nil {
+		return memTx.tx, true
+	}
+	return nil, false
+}
+
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
 // Called from:
 //   - Update (lock held) if tx was committed
@@ -665,6 +665,28 @@ func TestMempoolTxsBytes(t *testing.T) {
 	assert.EqualValues(t, 10, mp.SizeBytes())
 }
 
+func TestMempoolGetTxByKey(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	tx := types.Tx(kvstore.NewRandomTx(10))
+	require.NoError(t, mp.CheckTx(tx, nil, TxInfo{}))
+
+	got, ok := mp.GetTxByKey(tx.Key())
+	require.True(t, ok)
+	require.Equal(t, tx, got)
+
+	_, ok = mp.GetTxByKey(types.Tx(kvstore.NewRandomTx(10)).Key())
+	require.False(t, ok)
+
+	require.NoError(t, mp.RemoveTxByKey(tx.Key()))
+	_, ok = mp.GetTxByKey(tx.Key())
+	require.False(t, ok)
+}
+
 func TestMempoolNoCacheOverflow(t *testing.T) {
 	mp, cleanup := newMempoolWithAsyncConnection(t)
 	defer cleanup()
@@ -33,6 +33,7 @@ import (
 	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/liveness"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/null"
 	"github.com/cometbft/cometbft/statesync"
@@ -80,6 +81,8 @@ type Node struct {
 	txIndexer         txindex.TxIndexer
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
+	reindexer         *txindex.Reindexer
+	livenessService   *liveness.Service
 	prometheusSrv     *http.Server
 	pprofSrv          *http.Server
 	pprofLn           net.Listener
@@ -146,6 +149,34 @@ func CustomReactors(reactors map[string]p2p.Reactor) Option {
 	}
 }
 
+// CustomSwitch replaces the node's Switch with the one returned by newSwitch,
+// given the node info and the reactors of the node's Switch, by name (see
+// CustomReactors). The node doesn't listen on a transport then, the custom
+// Switch is responsible for connecting to peers.
+//
+// It is meant to run nodes on a simulated network. Apply it before
+// CustomReactors, whose reactors are otherwise not passed to newSwitch.
+func CustomSwitch(newSwitch func(nodeInfo p2p.NodeInfo, reactors map[string]p2p.Reactor) p2p.Switcher) Option {
+	return func(n *Node) {
+		reactors := make(map[string]p2p.Reactor)
+		for _, name := range []string{"MEMPOOL", "BLOCKSYNC", "CONSENSUS", "EVIDENCE", "PEX", "STATESYNC"} {
+			if reactor, ok := n.sw.Reactor(name); ok {
+				reactors[name] = reactor
+			}
+		}
+		n.sw = newSwitch(n.nodeInfo, reactors)
+		n.transport = nil
+	}
+}
+
+// Clock overrides the clock consensus uses to time rounds and votes, and to
+// validate the time of proposed blocks. It is meant to simulate clock skew.
+func Clock(now func() time.Time) Option {
+	return func(n *Node) {
+		n.consensusState.SetClock(now)
+	}
+}
+
 // StateProvider overrides the state provider used by state sync to retrieve trusted app hashes and
 // build a State object for bootstrapping the node.
 // WARNING: this interface is considered unstable and subject to change.
@@ -327,7 +358,7 @@ func NewNodeWithContext(
 		return nil, err
 	}
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics, bsMetrics, ssMetrics := metricsProvider(genDoc.ChainID)
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics, bsMetrics, ssMetrics, evMetrics := metricsProvider(genDoc.ChainID)
 
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
 	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, abciMetrics)
@@ -350,6 +381,19 @@ func NewNodeWithContext(
 		return nil, err
 	}
 
+	var reindexer *txindex.Reindexer
+	if indexerService != nil {
+		reindexer = txindex.NewReindexer(blockStore, stateStore, txIndexer, blockIndexer)
+		reindexer.SetLogger(logger.With("module", "txindex"))
+	}
+
+	// The LivenessService must also be started before the handshake to count
+	// the replayed blocks.
+	livenessService, err := createAndStartLivenessService(config, stateDB, stateStore, eventBus, smMetrics, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	// If an address is provided, listen on the socket for a connection from an
 	// external signing process.
 	if config.PrivValidatorListenAddr != "" {
@@ -378,7 +422,8 @@ func NewNodeWithContext(
 	// and replays any blocks as necessary to sync CometBFT with the app.
 	consensusLogger := logger.With("module", "consensus")
 	if !stateSync {
-		if err := doHandshake(ctx, stateStore, state, blockStore, genDoc, eventBus, proxyApp, consensusLogger); err != nil {
+		appVersion, err := doHandshake(ctx, stateStore, state, blockStore, genDoc, eventBus, proxyApp, consensusLogger)
+		if err != nil {
 			return nil, err
 		}
 
@@ -389,6 +434,12 @@ func NewNodeWithContext(
 		if err != nil {
 			return nil, fmt.Errorf("cannot load state: %w", err)
 		}
+
+		// Refuse to proceed past a halt until the config or the software
+		// changes.
+		if err := cs.CheckHalt(config.Consensus, stateDB, stateStore, state, appVersion); err != nil {
+			return nil, err
+		}
 	}
 
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
@@ -415,7 +466,12 @@ func NewNodeWithContext(
 	// create mempool with its reactor
 	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, mempoolWaitForSync, memplMetrics, logger)
 
-	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, logger)
+	// signatures verified by consensus, blocksync and the evidence pool, so
+	// that each is verified once
+	signatureCache := types.NewSignatureCache()
+
+	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, eventBus,
+		signatureCache, evMetrics, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -430,6 +486,8 @@ func NewNodeWithContext(
 		blockStore,
 		sm.BlockExecutorWithMetrics(smMetrics),
 		sm.BlockExecutorWithBlockTimeTolerance(config.Consensus.BlockTimeTolerance),
+		sm.BlockExecutorWithExtendedCommitRetainBlocks(config.Storage.ExtendedCommitRetainBlocks),
+		sm.BlockExecutorWithSignatureCache(signatureCache),
 	)
 
 	offlineStateSyncHeight := int64(0)
@@ -457,7 +515,7 @@ func NewNodeWithContext(
 
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
-		privValidator, csMetrics, consensusWaitForSync, eventBus, consensusLogger, offlineStateSyncHeight,
+		privValidator, csMetrics, consensusWaitForSync, eventBus, consensusLogger, offlineStateSyncHeight, stateDB,
 	)
 
 	err = stateStore.SetOfflineStateSyncHeight(0)
@@ -479,7 +537,10 @@ func NewNodeWithContext(
 	// true by default. Otherwise, uses libp2p
 	useCometNetworking := !config.P2P.LibP2PEnabled()
 
-	if config.P2P.PexReactor && !useCometNetworking {
+	// runs both comet p2p and libp2p
+	useBridge := config.P2P.LibP2PBridgeEnabled()
+
+	if config.P2P.PexReactor && !useCometNetworking && !useBridge {
 		config.P2P.PexReactor = false
 		logger.Info("PEX reactor is disabled when using go-libp2p transport")
 	}
@@ -495,8 +556,9 @@ func NewNodeWithContext(
 		p2pLogger = logger.With("module", "p2p")
 	)
 
+	switch {
 	// Comet P2P (default)
-	if useCometNetworking {
+	case useCometNetworking:
 		cometTransport, switcher := createCometTransportWithSwitch(
 			config,
 			nodeInfo,
@@ -547,20 +609,72 @@ func NewNodeWithContext(
 
 		transport = cometTransport
 		sw = switcher
-	} else {
-		reactors := []lp2p.SwitchReactor{
-			{Name: "MEMPOOL", Reactor: mempoolReactor},
-			{Name: "BLOCKSYNC", Reactor: bcReactor},
-			{Name: "CONSENSUS", Reactor: consensusReactor},
-			{Name: "EVIDENCE", Reactor: evidenceReactor},
-			{Name: "STATESYNC", Reactor: stateSyncReactor},
+
+	// Comet P2P and libp2p (migration bridge)
+	case useBridge:
+		reactors := createLibP2PReactors(
+			config,
+			mempoolReactor,
+			bcReactor,
+			stateSyncReactor,
+			consensusReactor,
+			evidenceReactor,
+		)
+
+		cometTransport, bridge, err := createBridgeSwitch(
+			config,
+			nodeInfo,
+			nodeKey,
+			proxyApp,
+			reactors,
+			p2pMetrics,
+			p2pLogger,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = bridge.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
+		if err != nil {
+			return nil, fmt.Errorf("could not add peers from persistent_peers field: %w", err)
+		}
+
+		err = bridge.AddUnconditionalPeerIDs(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+		if err != nil {
+			return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
+		}
+
+		err = bridge.AddPrivatePeerIDs(splitAndTrimEmpty(config.P2P.PrivatePeerIDs, ",", " "))
+		if err != nil {
+			return nil, fmt.Errorf("could not add peer ids from private_peer_ids field: %w", err)
+		}
+
+		// PEX only runs on the legacy stack
+		addrBook, err := createAddrBookAndSetOnSwitch(config, bridge.Legacy(), p2pLogger, nodeKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not create addrbook: %w", err)
 		}
 
-		// drop mempool if nop
-		if config.Mempool.Type == cfg.MempoolTypeNop {
-			reactors = reactors[1:]
+		if config.P2P.PexReactor {
+			_ = createPEXReactorAndAddToSwitch(addrBook, config, bridge.Legacy(), logger)
 		}
 
+		addrBook.AddPrivateIDs(splitAndTrimEmpty(config.P2P.PrivatePeerIDs, ",", " "))
+
+		transport = cometTransport
+		sw = bridge
+
+	// libp2p
+	default:
+		reactors := createLibP2PReactors(
+			config,
+			mempoolReactor,
+			bcReactor,
+			stateSyncReactor,
+			consensusReactor,
+			evidenceReactor,
+		)
+
 		host, err := lp2p.NewHost(config.P2P, nodeKey.PrivKey, p2pLogger)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create libp2p host: %w", err)
@@ -571,6 +685,24 @@ func NewNodeWithContext(
 			return nil, fmt.Errorf("unable to create libp2p switch: %w", err)
 		}
 
+		err = sw.AddPersistentPeers(withoutLegacyPeers(
+			splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "), "persistent_peers", p2pLogger))
+		if err != nil {
+			return nil, fmt.Errorf("could not add peers from persistent_peers field: %w", err)
+		}
+
+		err = sw.AddUnconditionalPeerIDs(withoutLegacyPeers(
+			splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "), "unconditional_peer_ids", p2pLogger))
+		if err != nil {
+			return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
+		}
+
+		err = sw.AddPrivatePeerIDs(withoutLegacyPeers(
+			splitAndTrimEmpty(config.P2P.PrivatePeerIDs, ",", " "), "private_peer_ids", p2pLogger))
+		if err != nil {
+			return nil, fmt.Errorf("could not add peer ids from private_peer_ids field: %w", err)
+		}
+
 		p2pLogger.Info("Using libp2p transport", "host_id", host.ID().String())
 		if state.LastBlockHeight != 0 {
 			p2pLogger.Warn("EXPERIMENTAL: go-libp2p transport is enabled. Only enable this setting if it can be activated simultaneously for all validators on the network and peer IDs have been predetermined and exchanged.")
@@ -602,6 +734,8 @@ func NewNodeWithContext(
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
+		reindexer:        reindexer,
+		livenessService:  livenessService,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
 	}
@@ -740,11 +874,19 @@ func (n *Node) OnStop() {
 	if err := n.eventBus.Stop(); err != nil {
 		n.Logger.Error("Error closing eventBus", "err", err)
 	}
+	if n.reindexer != nil {
+		n.reindexer.Stop()
+	}
 	if n.indexerService != nil {
 		if err := n.indexerService.Stop(); err != nil {
 			n.Logger.Error("Error closing indexerService", "err", err)
 		}
 	}
+	if n.livenessService != nil {
+		if err := n.livenessService.Stop(); err != nil {
+			n.Logger.Error("Error closing livenessService", "err", err)
+		}
+	}
 	// Close the priv validator before stopping the reactors: sw.Stop waits on
 	// the consensus receiveRoutine, which can be stuck retrying a gone remote
 	// signer. Closing aborts that retry loop. (RetrySignerClient is not a
@@ -834,6 +976,7 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 		GenDoc:           n.genesisDoc,
 		TxIndexer:        n.txIndexer,
 		BlockIndexer:     n.blockIndexer,
+		Reindexer:        n.reindexer,
 		ConsensusReactor: n.consensusReactor,
 		MempoolReactor:   n.mempoolReactor,
 		EventBus:         n.eventBus,
@@ -844,6 +987,9 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 
 		Config: *n.config.RPC,
 	}
+	if n.livenessService != nil {
+		rpcCoreEnv.Liveness = n.livenessService.Tracker()
+	}
 	if err := rpcCoreEnv.InitGenesisChunks(); err != nil {
 		return nil, err
 	}
@@ -1035,6 +1181,11 @@ func (n *Node) BlockStore() *store.BlockStore {
 	return n.blockStore
 }
 
+// ConsensusState returns the Node's consensus state.
+func (n *Node) ConsensusState() *cs.State {
+	return n.consensusState
+}
+
 // ConsensusReactor returns the Node's ConsensusReactor.
 func (n *Node) ConsensusReactor() *cs.Reactor {
 	return n.consensusReactor
@@ -24,6 +24,7 @@ import (
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/lp2p"
 	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/pex"
@@ -32,6 +33,7 @@ import (
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/indexer/block"
+	"github.com/cometbft/cometbft/state/liveness"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
@@ -79,12 +81,12 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 }
 
 // MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics)
+type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics, *evidence.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics) {
+	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics, *evidence.Metrics) {
 		if config.Prometheus {
 			return cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
@@ -92,9 +94,10 @@ func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
 				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				proxy.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				blocksync.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				statesync.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				statesync.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				evidence.PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
-		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), proxy.NopMetrics(), blocksync.NopMetrics(), statesync.NopMetrics()
+		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), proxy.NopMetrics(), blocksync.NopMetrics(), statesync.NopMetrics(), evidence.NopMetrics()
 	}
 }
 
@@ -134,6 +137,25 @@ func createAndStartEventBus(logger log.Logger, bufferCapacity int) (*types.Event
 	return eventBus, nil
 }
 
+func createAndStartLivenessService(
+	config *cfg.Config,
+	stateDB dbm.DB,
+	stateStore sm.Store,
+	eventBus *types.EventBus,
+	metrics *sm.Metrics,
+	logger log.Logger,
+) (*liveness.Service, error) {
+	if !config.ValidatorStats.Enabled {
+		return nil, nil
+	}
+	livenessService := liveness.NewService(config.ValidatorStats.Windows, stateDB, stateStore, eventBus, metrics)
+	livenessService.SetLogger(logger.With("module", "liveness"))
+	if err := livenessService.Start(); err != nil {
+		return nil, err
+	}
+	return livenessService, nil
+}
+
 func createAndStartIndexerService(
 	config *cfg.Config,
 	chainID string,
@@ -175,14 +197,14 @@ func doHandshake(
 	eventBus types.BlockEventPublisher,
 	proxyApp proxy.AppConns,
 	consensusLogger log.Logger,
-) error {
+) (appVersion string, err error) {
 	handshaker := cs.NewHandshaker(stateStore, state, blockStore, genDoc)
 	handshaker.SetLogger(consensusLogger)
 	handshaker.SetEventBus(eventBus)
 	if err := handshaker.HandshakeWithContext(ctx, proxyApp); err != nil {
-		return fmt.Errorf("error during handshake: %v", err)
+		return "", fmt.Errorf("error during handshake: %v", err)
 	}
-	return nil
+	return handshaker.AppVersion(), nil
 }
 
 func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusLogger log.Logger) {
@@ -275,17 +297,20 @@ func createMempoolAndMempoolReactor(
 }
 
 func createEvidenceReactor(config *cfg.Config, dbProvider cfg.DBProvider,
-	stateStore sm.Store, blockStore *store.BlockStore, logger log.Logger,
+	stateStore sm.Store, blockStore *store.BlockStore, eventBus *types.EventBus,
+	signatureCache *types.SignatureCache, metrics *evidence.Metrics, logger log.Logger,
 ) (*evidence.Reactor, *evidence.Pool, error) {
 	evidenceDB, err := dbProvider(&cfg.DBContext{ID: "evidence", Config: config})
 	if err != nil {
 		return nil, nil, err
 	}
 	evidenceLogger := logger.With("module", "evidence")
-	evidencePool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
+	evidencePool, err := evidence.NewPool(evidenceDB, stateStore, blockStore,
+		evidence.WithMetrics(metrics), evidence.WithSignatureCache(signatureCache))
 	if err != nil {
 		return nil, nil, err
 	}
+	evidencePool.SetEventBus(eventBus)
 	evidenceReactor := evidence.NewReactor(evidencePool)
 	evidenceReactor.SetLogger(evidenceLogger)
 	return evidenceReactor, evidencePool, nil
@@ -336,6 +361,7 @@ func createConsensusReactor(
 	eventBus *types.EventBus,
 	logger log.Logger,
 	offlineStateSyncHeight int64,
+	stateDB dbm.DB,
 ) (*cs.Reactor, *cs.State) {
 	consensusState := cs.NewState(
 		config.Consensus,
@@ -346,13 +372,19 @@ func createConsensusReactor(
 		evidencePool,
 		cs.StateMetrics(csMetrics),
 		cs.OfflineStateSyncHeight(offlineStateSyncHeight),
+		cs.CheckpointDB(stateDB),
 	)
 	consensusState.SetLogger(logger)
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
 
-	consensusReactor := cs.NewReactor(consensusState, waitForSync, cs.ReactorMetrics(csMetrics))
+	reactorOptions := []cs.ReactorOption{cs.ReactorMetrics(csMetrics)}
+	if txs, ok := mempool.(cs.TxProvider); ok && config.Consensus.CompactBlocks {
+		reactorOptions = append(reactorOptions, cs.WithCompactBlocks(txs))
+	}
+
+	consensusReactor := cs.NewReactor(consensusState, waitForSync, reactorOptions...)
 	consensusReactor.SetLogger(logger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -499,6 +531,74 @@ func createCometSwitch(
 	return sw
 }
 
+// createLibP2PReactors returns the reactors to add to a libp2p switch.
+func createLibP2PReactors(
+	config *cfg.Config,
+	mempoolReactor p2p.Reactor,
+	bcReactor p2p.Reactor,
+	stateSyncReactor *statesync.Reactor,
+	consensusReactor *cs.Reactor,
+	evidenceReactor *evidence.Reactor,
+) []lp2p.SwitchReactor {
+	reactors := []lp2p.SwitchReactor{
+		{Name: "MEMPOOL", Reactor: mempoolReactor},
+		{Name: "BLOCKSYNC", Reactor: bcReactor},
+		{Name: "CONSENSUS", Reactor: consensusReactor},
+		{Name: "EVIDENCE", Reactor: evidenceReactor},
+		{Name: "STATESYNC", Reactor: stateSyncReactor},
+	}
+
+	// drop mempool if nop
+	if config.Mempool.Type == cfg.MempoolTypeNop {
+		reactors = reactors[1:]
+	}
+
+	return reactors
+}
+
+// createBridgeSwitch creates the switch running comet p2p on p2p.laddr and
+// libp2p on p2p.libp2p.bridge.laddr. The reactors are added to both stacks.
+func createBridgeSwitch(
+	config *cfg.Config,
+	nodeInfo p2p.NodeInfo,
+	nodeKey *p2p.NodeKey,
+	proxyApp proxy.AppConns,
+	reactors []lp2p.SwitchReactor,
+	p2pMetrics *p2p.Metrics,
+	p2pLogger log.Logger,
+) (*p2p.MultiplexTransport, *lp2p.BridgeSwitch, error) {
+	transport, peerFilters := createCometTransport(config, nodeInfo, nodeKey, proxyApp)
+
+	legacy := p2p.NewSwitch(
+		config.P2P,
+		transport,
+		p2p.WithMetrics(p2pMetrics),
+		p2p.SwitchPeerFilters(peerFilters...),
+	)
+	legacy.SetLogger(p2pLogger.With("stack", "legacy"))
+	legacy.SetNodeInfo(nodeInfo)
+	legacy.SetNodeKey(nodeKey)
+
+	host, err := lp2p.NewHost(config.P2P, nodeKey.PrivKey, p2pLogger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create libp2p host: %w", err)
+	}
+
+	sw, err := lp2p.NewBridgeSwitch(legacy, nodeInfo, host, reactors, p2pMetrics, p2pLogger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create bridge switch: %w", err)
+	}
+
+	p2pLogger.Info(
+		"Using comet p2p and libp2p transports (migration bridge)",
+		"ID", nodeKey.ID(),
+		"host_id", host.ID().String(),
+		"libp2p_laddr", config.P2P.LibP2PListenAddress(),
+	)
+
+	return transport, sw, nil
+}
+
 func createAddrBookAndSetOnSwitch(
 	config *cfg.Config,
 	sw *p2p.Switch,
@@ -747,6 +847,21 @@ func createAndStartPrivValidatorSocketClient(
 	return pvscWithRetries, nil
 }
 
+// withoutLegacyPeers drops the legacy node IDs and addresses from the peers
+// of the given config field, which libp2p can't use, logging a warning for
+// each of them. Such entries used to be ignored when libp2p is enabled.
+func withoutLegacyPeers(peers []string, field string, logger log.Logger) []string {
+	filtered := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		if lp2p.IsLegacyPeerAddr(peer) {
+			logger.Warn("Ignoring legacy node ID, libp2p requires a libp2p peer ID", "field", field, "peer", peer)
+			continue
+		}
+		filtered = append(filtered, peer)
+	}
+	return filtered
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
@@ -86,6 +86,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "message_reactor_queue_concurrency",
 			Help:      "Concurrency of the incoming message queue for a given reactor",
 		}, append(labels, "reactor")).With(labelsAndValues...),
+		ProtocolSendBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "protocol_send_bytes_total",
+			Help:      "Number of bytes sent over each protocol (libp2p only).",
+		}, append(labels, "protocol")).With(labelsAndValues...),
+		ProtocolReceiveBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "protocol_receive_bytes_total",
+			Help:      "Number of bytes received over each protocol (libp2p only).",
+		}, append(labels, "protocol")).With(labelsAndValues...),
+		ProtocolRateLimitWaitSeconds: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "protocol_rate_limit_wait_seconds",
+			Help:      "Time spent waiting for the bandwidth rate limits of each protocol and direction (libp2p only).",
+		}, append(labels, "protocol", "direction")).With(labelsAndValues...),
 	}
 }
 
@@ -103,5 +121,8 @@ func NopMetrics() *Metrics {
 		MessagesReactorPendingDuration: discard.NewHistogram(),
 		MessageReactorReceiveDuration:  discard.NewHistogram(),
 		MessageReactorQueueConcurrency: discard.NewGauge(),
+		ProtocolSendBytesTotal:         discard.NewCounter(),
+		ProtocolReceiveBytesTotal:      discard.NewCounter(),
+		ProtocolRateLimitWaitSeconds:   discard.NewCounter(),
 	}
 }
@@ -48,6 +48,13 @@ type Metrics struct {
 	MessageReactorReceiveDuration metrics.Histogram `metrics_labels:"message_type,reactor"`
 	// Concurrency of the incoming message queue for a given reactor
 	MessageReactorQueueConcurrency metrics.Gauge `metrics_labels:"reactor"`
+	// Number of bytes sent over each protocol (libp2p only).
+	ProtocolSendBytesTotal metrics.Counter `metrics_labels:"protocol"`
+	// Number of bytes received over each protocol (libp2p only).
+	ProtocolReceiveBytesTotal metrics.Counter `metrics_labels:"protocol"`
+	// Time spent waiting for the bandwidth rate limits of each protocol and
+	// direction (libp2p only).
+	ProtocolRateLimitWaitSeconds metrics.Counter `metrics_labels:"protocol,direction"`
 }
 
 type metricsLabelCache struct {
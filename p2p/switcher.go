@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"time"
+
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/service"
 )
@@ -52,3 +54,18 @@ type Broadcaster interface {
 	BroadcastAsync(e Envelope)
 	TryBroadcast(e Envelope)
 }
+
+// PeerBanner is implemented by switches that temporarily ban misbehaving peers.
+type PeerBanner interface {
+	// BannedPeers returns the currently banned peers.
+	BannedPeers() []BannedPeer
+	// UnbanPeer lifts the ban of a peer. Returns false if the peer was not banned.
+	UnbanPeer(id ID) (bool, error)
+}
+
+// BannedPeer is a peer banned until a given time.
+type BannedPeer struct {
+	ID     ID        `json:"id"`
+	Until  time.Time `json:"until"`
+	Reason string    `json:"reason"`
+}
@@ -609,6 +609,270 @@ func (m *VoteSetBits) GetVotes() bits.BitArray {
 	return bits.BitArray{}
 }
 
+// CompactBlock is sent instead of the proposal block parts to the peers
+// supporting compact blocks. The block is sent without its transactions,
+// which are identified by their keys (in order).
+type CompactBlock struct {
+	Height int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32       `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Block  types.Block `protobuf:"bytes,3,opt,name=block,proto3" json:"block"`
+	TxKeys [][]byte    `protobuf:"bytes,4,rep,name=tx_keys,json=txKeys,proto3" json:"tx_keys,omitempty"`
+}
+
+func (m *CompactBlock) Reset()         { *m = CompactBlock{} }
+func (m *CompactBlock) String() string { return proto.CompactTextString(m) }
+func (*CompactBlock) ProtoMessage()    {}
+func (*CompactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{9}
+}
+func (m *CompactBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlock.Merge(m, src)
+}
+func (m *CompactBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlock proto.InternalMessageInfo
+
+func (m *CompactBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetBlock() types.Block {
+	if m != nil {
+		return m.Block
+	}
+	return types.Block{}
+}
+
+func (m *CompactBlock) GetTxKeys() [][]byte {
+	if m != nil {
+		return m.TxKeys
+	}
+	return nil
+}
+
+// CompactBlockTxsRequest is sent to request the transactions of a compact
+// block missing from the mempool.
+type CompactBlockTxsRequest struct {
+	Height  int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round   int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Indexes []uint32 `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (m *CompactBlockTxsRequest) Reset()         { *m = CompactBlockTxsRequest{} }
+func (m *CompactBlockTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*CompactBlockTxsRequest) ProtoMessage()    {}
+func (*CompactBlockTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{10}
+}
+func (m *CompactBlockTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlockTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlockTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlockTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlockTxsRequest.Merge(m, src)
+}
+func (m *CompactBlockTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlockTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlockTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlockTxsRequest proto.InternalMessageInfo
+
+func (m *CompactBlockTxsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlockTxsRequest) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlockTxsRequest) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+// CompactBlockTxs is sent in response to a CompactBlockTxsRequest.
+type CompactBlockTxs struct {
+	Height  int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round   int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Indexes []uint32 `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	Txs     [][]byte `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *CompactBlockTxs) Reset()         { *m = CompactBlockTxs{} }
+func (m *CompactBlockTxs) String() string { return proto.CompactTextString(m) }
+func (*CompactBlockTxs) ProtoMessage()    {}
+func (*CompactBlockTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{11}
+}
+func (m *CompactBlockTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlockTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlockTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlockTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlockTxs.Merge(m, src)
+}
+func (m *CompactBlockTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlockTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlockTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlockTxs proto.InternalMessageInfo
+
+func (m *CompactBlockTxs) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlockTxs) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlockTxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *CompactBlockTxs) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+// CompactBlockAck is sent once a compact block was processed. If the block
+// could not be reconstructed, the peer falls back to sending the block parts.
+type CompactBlockAck struct {
+	Height        int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round         int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Reconstructed bool  `protobuf:"varint,3,opt,name=reconstructed,proto3" json:"reconstructed,omitempty"`
+}
+
+func (m *CompactBlockAck) Reset()         { *m = CompactBlockAck{} }
+func (m *CompactBlockAck) String() string { return proto.CompactTextString(m) }
+func (*CompactBlockAck) ProtoMessage()    {}
+func (*CompactBlockAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{12}
+}
+func (m *CompactBlockAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlockAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlockAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlockAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlockAck.Merge(m, src)
+}
+func (m *CompactBlockAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlockAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlockAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlockAck proto.InternalMessageInfo
+
+func (m *CompactBlockAck) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlockAck) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlockAck) GetReconstructed() bool {
+	if m != nil {
+		return m.Reconstructed
+	}
+	return false
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Message_NewRoundStep
@@ -620,6 +884,10 @@ type Message struct {
 	//	*Message_HasVote
 	//	*Message_VoteSetMaj23
 	//	*Message_VoteSetBits
+	//	*Message_CompactBlock
+	//	*Message_CompactBlockTxsRequest
+	//	*Message_CompactBlockTxs
+	//	*Message_CompactBlockAck
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -627,7 +895,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{9}
+	return fileDescriptor_81a22d2efc008981, []int{13}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,16 +957,32 @@ type Message_VoteSetMaj23 struct {
 type Message_VoteSetBits struct {
 	VoteSetBits *VoteSetBits `protobuf:"bytes,9,opt,name=vote_set_bits,json=voteSetBits,proto3,oneof" json:"vote_set_bits,omitempty"`
 }
+type Message_CompactBlock struct {
+	CompactBlock *CompactBlock `protobuf:"bytes,10,opt,name=compact_block,json=compactBlock,proto3,oneof" json:"compact_block,omitempty"`
+}
+type Message_CompactBlockTxsRequest struct {
+	CompactBlockTxsRequest *CompactBlockTxsRequest `protobuf:"bytes,11,opt,name=compact_block_txs_request,json=compactBlockTxsRequest,proto3,oneof" json:"compact_block_txs_request,omitempty"`
+}
+type Message_CompactBlockTxs struct {
+	CompactBlockTxs *CompactBlockTxs `protobuf:"bytes,12,opt,name=compact_block_txs,json=compactBlockTxs,proto3,oneof" json:"compact_block_txs,omitempty"`
+}
+type Message_CompactBlockAck struct {
+	CompactBlockAck *CompactBlockAck `protobuf:"bytes,13,opt,name=compact_block_ack,json=compactBlockAck,proto3,oneof" json:"compact_block_ack,omitempty"`
+}
 
-func (*Message_NewRoundStep) isMessage_Sum()  {}
-func (*Message_NewValidBlock) isMessage_Sum() {}
-func (*Message_Proposal) isMessage_Sum()      {}
-func (*Message_ProposalPol) isMessage_Sum()   {}
-func (*Message_BlockPart) isMessage_Sum()     {}
-func (*Message_Vote) isMessage_Sum()          {}
-func (*Message_HasVote) isMessage_Sum()       {}
-func (*Message_VoteSetMaj23) isMessage_Sum()  {}
-func (*Message_VoteSetBits) isMessage_Sum()   {}
+func (*Message_NewRoundStep) isMessage_Sum()           {}
+func (*Message_NewValidBlock) isMessage_Sum()          {}
+func (*Message_Proposal) isMessage_Sum()               {}
+func (*Message_ProposalPol) isMessage_Sum()            {}
+func (*Message_BlockPart) isMessage_Sum()              {}
+func (*Message_Vote) isMessage_Sum()                   {}
+func (*Message_HasVote) isMessage_Sum()                {}
+func (*Message_VoteSetMaj23) isMessage_Sum()           {}
+func (*Message_VoteSetBits) isMessage_Sum()            {}
+func (*Message_CompactBlock) isMessage_Sum()           {}
+func (*Message_CompactBlockTxsRequest) isMessage_Sum() {}
+func (*Message_CompactBlockTxs) isMessage_Sum()        {}
+func (*Message_CompactBlockAck) isMessage_Sum()        {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -770,6 +1054,34 @@ func (m *Message) GetVoteSetBits() *VoteSetBits {
 	return nil
 }
 
+func (m *Message) GetCompactBlock() *CompactBlock {
+	if x, ok := m.GetSum().(*Message_CompactBlock); ok {
+		return x.CompactBlock
+	}
+	return nil
+}
+
+func (m *Message) GetCompactBlockTxsRequest() *CompactBlockTxsRequest {
+	if x, ok := m.GetSum().(*Message_CompactBlockTxsRequest); ok {
+		return x.CompactBlockTxsRequest
+	}
+	return nil
+}
+
+func (m *Message) GetCompactBlockTxs() *CompactBlockTxs {
+	if x, ok := m.GetSum().(*Message_CompactBlockTxs); ok {
+		return x.CompactBlockTxs
+	}
+	return nil
+}
+
+func (m *Message) GetCompactBlockAck() *CompactBlockAck {
+	if x, ok := m.GetSum().(*Message_CompactBlockAck); ok {
+		return x.CompactBlockAck
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -782,6 +1094,10 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_HasVote)(nil),
 		(*Message_VoteSetMaj23)(nil),
 		(*Message_VoteSetBits)(nil),
+		(*Message_CompactBlock)(nil),
+		(*Message_CompactBlockTxsRequest)(nil),
+		(*Message_CompactBlockTxs)(nil),
+		(*Message_CompactBlockAck)(nil),
 	}
 }
 
@@ -795,67 +1111,83 @@ func init() {
 	proto.RegisterType((*HasVote)(nil), "tendermint.consensus.HasVote")
 	proto.RegisterType((*VoteSetMaj23)(nil), "tendermint.consensus.VoteSetMaj23")
 	proto.RegisterType((*VoteSetBits)(nil), "tendermint.consensus.VoteSetBits")
+	proto.RegisterType((*CompactBlock)(nil), "tendermint.consensus.CompactBlock")
+	proto.RegisterType((*CompactBlockTxsRequest)(nil), "tendermint.consensus.CompactBlockTxsRequest")
+	proto.RegisterType((*CompactBlockTxs)(nil), "tendermint.consensus.CompactBlockTxs")
+	proto.RegisterType((*CompactBlockAck)(nil), "tendermint.consensus.CompactBlockAck")
 	proto.RegisterType((*Message)(nil), "tendermint.consensus.Message")
 }
 
 func init() { proto.RegisterFile("tendermint/consensus/types.proto", fileDescriptor_81a22d2efc008981) }
 
 var fileDescriptor_81a22d2efc008981 = []byte{
-	// 855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xb7, 0xa9, 0xd3, 0x24, 0xcf, 0x49, 0x0b, 0xa3, 0xee, 0x2a, 0x04, 0x48, 0x82, 0xb9, 0x54,
-	0x08, 0x39, 0x28, 0x3d, 0xac, 0xb4, 0x42, 0x02, 0xcc, 0x9f, 0xf5, 0xae, 0x36, 0xbb, 0xc1, 0x59,
-	0xad, 0x10, 0x17, 0xcb, 0x89, 0x87, 0x64, 0xd8, 0xd8, 0x63, 0x79, 0x26, 0x29, 0xbd, 0xf2, 0x09,
-	0xf8, 0x00, 0x7c, 0x0d, 0x24, 0x3e, 0x42, 0x8f, 0x3d, 0x72, 0xaa, 0x50, 0xfa, 0x11, 0x10, 0x9c,
-	0xd1, 0x8c, 0x27, 0xb1, 0x43, 0xdd, 0x42, 0x2f, 0x48, 0xdc, 0x66, 0xe6, 0xbd, 0xf7, 0x9b, 0x37,
-	0xbf, 0xf7, 0xde, 0xcf, 0x86, 0x1e, 0xc7, 0x71, 0x88, 0xd3, 0x88, 0xc4, 0xbc, 0x3f, 0xa5, 0x31,
-	0xc3, 0x31, 0x5b, 0xb2, 0x3e, 0x3f, 0x4b, 0x30, 0xb3, 0x93, 0x94, 0x72, 0x8a, 0x8e, 0x72, 0x0f,
-	0x7b, 0xeb, 0xd1, 0x3e, 0x9a, 0xd1, 0x19, 0x95, 0x0e, 0x7d, 0xb1, 0xca, 0x7c, 0xdb, 0x45, 0xb4,
-	0x05, 0x99, 0xb0, 0xfe, 0x84, 0xf0, 0x1d, 0xb4, 0xf6, 0xdb, 0x05, 0x0f, 0x79, 0x5e, 0xb4, 0x5a,
-	0x3f, 0xeb, 0xd0, 0x78, 0x86, 0x4f, 0x3d, 0xba, 0x8c, 0xc3, 0x31, 0xc7, 0x09, 0xba, 0x0f, 0xfb,
-	0x73, 0x4c, 0x66, 0x73, 0xde, 0xd2, 0x7b, 0xfa, 0xf1, 0x9e, 0xa7, 0x76, 0xe8, 0x08, 0x2a, 0xa9,
-	0x70, 0x6a, 0xbd, 0xd6, 0xd3, 0x8f, 0x2b, 0x5e, 0xb6, 0x41, 0x08, 0x0c, 0xc6, 0x71, 0xd2, 0xda,
-	0xeb, 0xe9, 0xc7, 0x4d, 0x4f, 0xae, 0xd1, 0x03, 0x68, 0x31, 0x3c, 0xa5, 0x71, 0xc8, 0x7c, 0x46,
-	0xe2, 0x29, 0xf6, 0x19, 0x0f, 0x52, 0xee, 0x73, 0x12, 0xe1, 0x96, 0x21, 0x31, 0xef, 0x29, 0xfb,
-	0x58, 0x98, 0xc7, 0xc2, 0xfa, 0x82, 0x44, 0x18, 0xbd, 0x0f, 0x6f, 0x2c, 0x02, 0xc6, 0xfd, 0x29,
-	0x8d, 0x22, 0xc2, 0xfd, 0xec, 0xba, 0x8a, 0xbc, 0xee, 0x50, 0x18, 0x3e, 0x93, 0xe7, 0x32, 0x55,
-	0xeb, 0x0f, 0x1d, 0x9a, 0xcf, 0xf0, 0xe9, 0xcb, 0x60, 0x41, 0x42, 0x67, 0x41, 0xa7, 0xaf, 0xee,
-	0x98, 0xf8, 0xd7, 0x70, 0x6f, 0x22, 0xc2, 0xfc, 0x44, 0xe4, 0xc6, 0x30, 0xf7, 0xe7, 0x38, 0x08,
-	0x71, 0x2a, 0x5f, 0x62, 0x0e, 0xba, 0x76, 0xa1, 0x06, 0x19, 0x5f, 0xa3, 0x20, 0xe5, 0x63, 0xcc,
-	0x5d, 0xe9, 0xe6, 0x18, 0xe7, 0x97, 0x5d, 0xcd, 0x43, 0x12, 0x63, 0xc7, 0x82, 0x3e, 0x06, 0x33,
-	0x47, 0x66, 0xf2, 0xc5, 0xe6, 0xa0, 0x53, 0xc4, 0x13, 0x75, 0xb2, 0x45, 0x9d, 0x6c, 0x87, 0xf0,
-	0x4f, 0xd3, 0x34, 0x38, 0xf3, 0x60, 0x0b, 0xc4, 0xd0, 0x5b, 0x50, 0x27, 0x4c, 0x91, 0x20, 0x9f,
-	0x5f, 0xf3, 0x6a, 0x84, 0x65, 0x8f, 0xb7, 0x5c, 0xa8, 0x8d, 0x52, 0x9a, 0x50, 0x16, 0x2c, 0xd0,
-	0x47, 0x50, 0x4b, 0xd4, 0x5a, 0xbe, 0xd9, 0x1c, 0xb4, 0x4b, 0xd2, 0x56, 0x1e, 0x2a, 0xe3, 0x6d,
-	0x84, 0xf5, 0x93, 0x0e, 0xe6, 0xc6, 0x38, 0x7a, 0xfe, 0xf4, 0x46, 0xfe, 0x3e, 0x00, 0xb4, 0x89,
-	0xf1, 0x13, 0xba, 0xf0, 0x8b, 0x64, 0xbe, 0xbe, 0xb1, 0x8c, 0xe8, 0x42, 0xd6, 0x05, 0x3d, 0x82,
-	0x46, 0xd1, 0x5b, 0xd1, 0xf9, 0x0f, 0xcf, 0x57, 0xb9, 0x99, 0x05, 0x34, 0xeb, 0x15, 0xd4, 0x9d,
-	0x0d, 0x27, 0x77, 0xac, 0xed, 0x87, 0x60, 0x08, 0xee, 0xd5, 0xdd, 0xf7, 0xcb, 0x4b, 0xa9, 0xee,
-	0x94, 0x9e, 0xd6, 0x00, 0x8c, 0x97, 0x94, 0x8b, 0x0e, 0x34, 0x56, 0x94, 0x63, 0xc5, 0x66, 0x49,
-	0xa4, 0xf0, 0xf2, 0xa4, 0x8f, 0xf5, 0x83, 0x0e, 0x55, 0x37, 0x60, 0x32, 0xee, 0x6e, 0xf9, 0x9d,
-	0x80, 0x21, 0xd0, 0x64, 0x7e, 0x07, 0x65, 0xad, 0x36, 0x26, 0xb3, 0x18, 0x87, 0x43, 0x36, 0x7b,
-	0x71, 0x96, 0x60, 0x4f, 0x3a, 0x0b, 0x28, 0x12, 0x87, 0xf8, 0x7b, 0xd9, 0x50, 0x15, 0x2f, 0xdb,
-	0x58, 0xbf, 0xe8, 0xd0, 0x10, 0x19, 0x8c, 0x31, 0x1f, 0x06, 0xdf, 0x0d, 0x4e, 0xfe, 0x8b, 0x4c,
-	0xbe, 0x80, 0x5a, 0xd6, 0xe0, 0x24, 0x54, 0xdd, 0xfd, 0xe6, 0xf5, 0x40, 0x59, 0xbb, 0xc7, 0x9f,
-	0x3b, 0x87, 0x82, 0xe5, 0xf5, 0x65, 0xb7, 0xaa, 0x0e, 0xbc, 0xaa, 0x8c, 0x7d, 0x1c, 0x5a, 0xbf,
-	0xeb, 0x60, 0xaa, 0xd4, 0x1d, 0xc2, 0xd9, 0xff, 0x27, 0x73, 0xf4, 0x10, 0x2a, 0xa2, 0x03, 0x98,
-	0x1c, 0xce, 0x7f, 0xdb, 0xdc, 0x59, 0x88, 0xf5, 0xa7, 0x01, 0xd5, 0x21, 0x66, 0x2c, 0x98, 0x61,
-	0xf4, 0x04, 0x0e, 0x62, 0x7c, 0x9a, 0x0d, 0x94, 0x2f, 0x65, 0x34, 0xeb, 0x3b, 0xcb, 0x2e, 0xfb,
-	0x00, 0xd8, 0x45, 0x99, 0x76, 0x35, 0xaf, 0x11, 0x17, 0x65, 0x7b, 0x08, 0x87, 0x02, 0x6b, 0x25,
-	0xf4, 0xd0, 0x97, 0x89, 0x4a, 0xbe, 0xcc, 0xc1, 0x7b, 0x37, 0x82, 0xe5, 0xda, 0xe9, 0x6a, 0x5e,
-	0x33, 0xde, 0x11, 0xd3, 0xa2, 0xb4, 0x94, 0x8c, 0x70, 0x8e, 0xb3, 0x51, 0x10, 0xb7, 0x20, 0x2d,
-	0xe8, 0xcb, 0xbf, 0x89, 0x40, 0xc6, 0xf5, 0xbb, 0xb7, 0x23, 0x8c, 0x9e, 0x3f, 0x75, 0x77, 0x35,
-	0x00, 0x7d, 0x02, 0x90, 0x4b, 0xa9, 0x62, 0xbb, 0x5b, 0x8e, 0xb2, 0xd5, 0x0a, 0x57, 0xf3, 0xea,
-	0x5b, 0x31, 0x15, 0x52, 0x20, 0x07, 0x7a, 0xff, 0xba, 0x3c, 0xe6, 0xb1, 0xa2, 0x0b, 0x5d, 0x2d,
-	0x1b, 0x6b, 0xf4, 0x10, 0x6a, 0xf3, 0x80, 0xf9, 0x32, 0xaa, 0x2a, 0xa3, 0xde, 0x29, 0x8f, 0x52,
-	0xb3, 0xef, 0x6a, 0x5e, 0x75, 0xae, 0x64, 0xe0, 0x09, 0x1c, 0x88, 0x38, 0xf9, 0x39, 0x89, 0xc4,
-	0x38, 0xb6, 0x6a, 0xb7, 0x15, 0xb4, 0x38, 0xb8, 0xa2, 0xa0, 0xab, 0xe2, 0x20, 0x3f, 0x82, 0xe6,
-	0x16, 0x4b, 0xf4, 0x53, 0xab, 0x7e, 0x1b, 0x89, 0x85, 0x41, 0x12, 0x24, 0xae, 0xf2, 0xad, 0x53,
-	0x81, 0x3d, 0xb6, 0x8c, 0x9c, 0xaf, 0xce, 0xd7, 0x1d, 0xfd, 0x62, 0xdd, 0xd1, 0x7f, 0x5b, 0x77,
-	0xf4, 0x1f, 0xaf, 0x3a, 0xda, 0xc5, 0x55, 0x47, 0xfb, 0xf5, 0xaa, 0xa3, 0x7d, 0xf3, 0x60, 0x46,
-	0xf8, 0x7c, 0x39, 0xb1, 0xa7, 0x34, 0xea, 0x4f, 0x69, 0x84, 0xf9, 0xe4, 0x5b, 0x9e, 0x2f, 0xb2,
-	0x3f, 0x8e, 0xb2, 0x7f, 0x96, 0xc9, 0xbe, 0xb4, 0x9d, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x94,
-	0xdf, 0xca, 0xd0, 0xd2, 0x08, 0x00, 0x00,
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xdf, 0xad, 0xed, 0xd8, 0x79, 0xb6, 0x9b, 0x76, 0x94, 0xa6, 0xdb, 0x00, 0x8e, 0x59, 0x40,
+	0xb2, 0x50, 0x65, 0x23, 0xe7, 0x50, 0xa9, 0x42, 0x82, 0xb8, 0x40, 0x37, 0xd0, 0xb4, 0x66, 0x1c,
+	0x55, 0x88, 0xcb, 0xb2, 0xde, 0x1d, 0xec, 0xc5, 0xde, 0x3f, 0xec, 0x8c, 0x13, 0xfb, 0xca, 0x91,
+	0x13, 0x1f, 0x80, 0xaf, 0x81, 0xc4, 0x47, 0xe8, 0xb1, 0x27, 0xc4, 0xa9, 0x42, 0xc9, 0x47, 0x40,
+	0xdc, 0xd1, 0xbc, 0x5d, 0xdb, 0xeb, 0xc4, 0x0e, 0x31, 0x42, 0x48, 0xbd, 0xcd, 0xec, 0x7b, 0xef,
+	0xf7, 0xfe, 0xce, 0xef, 0x2d, 0x54, 0x05, 0xf3, 0x1d, 0x16, 0x79, 0xae, 0x2f, 0x1a, 0x76, 0xe0,
+	0x73, 0xe6, 0xf3, 0x11, 0x6f, 0x88, 0x49, 0xc8, 0x78, 0x3d, 0x8c, 0x02, 0x11, 0x90, 0xed, 0xb9,
+	0x46, 0x7d, 0xa6, 0xb1, 0xbb, 0xdd, 0x0b, 0x7a, 0x01, 0x2a, 0x34, 0xe4, 0x29, 0xd6, 0xdd, 0x4d,
+	0xa3, 0x0d, 0xdd, 0x2e, 0x6f, 0x74, 0x5d, 0xb1, 0x80, 0xb6, 0xfb, 0x66, 0x4a, 0x03, 0xbf, 0x37,
+	0xba, 0xc3, 0xc0, 0x1e, 0xac, 0x94, 0xa6, 0x6c, 0xf5, 0x5f, 0x54, 0x28, 0x3d, 0x65, 0xa7, 0x34,
+	0x18, 0xf9, 0x4e, 0x47, 0xb0, 0x90, 0xec, 0xc0, 0x46, 0x9f, 0xb9, 0xbd, 0xbe, 0xd0, 0xd4, 0xaa,
+	0x5a, 0xcb, 0xd0, 0xe4, 0x46, 0xb6, 0x21, 0x17, 0x49, 0x25, 0xed, 0x46, 0x55, 0xad, 0xe5, 0x68,
+	0x7c, 0x21, 0x04, 0xb2, 0x5c, 0xb0, 0x50, 0xcb, 0x54, 0xd5, 0x5a, 0x99, 0xe2, 0x99, 0x3c, 0x00,
+	0x8d, 0x33, 0x3b, 0xf0, 0x1d, 0x6e, 0x72, 0xd7, 0xb7, 0x99, 0xc9, 0x85, 0x15, 0x09, 0x53, 0xb8,
+	0x1e, 0xd3, 0xb2, 0x88, 0x79, 0x27, 0x91, 0x77, 0xa4, 0xb8, 0x23, 0xa5, 0xc7, 0xae, 0xc7, 0xc8,
+	0xfb, 0x70, 0x7b, 0x68, 0x71, 0x61, 0xda, 0x81, 0xe7, 0xb9, 0xc2, 0x8c, 0xdd, 0xe5, 0xd0, 0xdd,
+	0x96, 0x14, 0x3c, 0xc2, 0xef, 0x18, 0xaa, 0xfe, 0x97, 0x0a, 0xe5, 0xa7, 0xec, 0xf4, 0xb9, 0x35,
+	0x74, 0x9d, 0x96, 0xcc, 0x76, 0xcd, 0xc0, 0xbf, 0x82, 0x3b, 0x58, 0x24, 0x33, 0x94, 0xb1, 0x71,
+	0x26, 0xcc, 0x3e, 0xb3, 0x1c, 0x16, 0x61, 0x26, 0xc5, 0xe6, 0x5e, 0x3d, 0xd5, 0xa1, 0xb8, 0x5e,
+	0x6d, 0x2b, 0x12, 0x1d, 0x26, 0x0c, 0x54, 0x6b, 0x65, 0x5f, 0xbc, 0xda, 0x53, 0x28, 0x41, 0x8c,
+	0x05, 0x09, 0xf9, 0x08, 0x8a, 0x73, 0x64, 0x8e, 0x19, 0x17, 0x9b, 0x95, 0x34, 0x9e, 0xec, 0x62,
+	0x5d, 0x76, 0xb1, 0xde, 0x72, 0xc5, 0x41, 0x14, 0x59, 0x13, 0x0a, 0x33, 0x20, 0x4e, 0xde, 0x80,
+	0x4d, 0x97, 0x27, 0x45, 0xc0, 0xf4, 0x0b, 0xb4, 0xe0, 0xf2, 0x38, 0x79, 0xdd, 0x80, 0x42, 0x3b,
+	0x0a, 0xc2, 0x80, 0x5b, 0x43, 0xf2, 0x21, 0x14, 0xc2, 0xe4, 0x8c, 0x39, 0x17, 0x9b, 0xbb, 0x4b,
+	0xc2, 0x4e, 0x34, 0x92, 0x88, 0x67, 0x16, 0xfa, 0xcf, 0x2a, 0x14, 0xa7, 0xc2, 0xf6, 0xb3, 0x27,
+	0x2b, 0xeb, 0x77, 0x1f, 0xc8, 0xd4, 0xc6, 0x0c, 0x83, 0xa1, 0x99, 0x2e, 0xe6, 0xad, 0xa9, 0xa4,
+	0x1d, 0x0c, 0xb1, 0x2f, 0xe4, 0x31, 0x94, 0xd2, 0xda, 0x5a, 0xe6, 0x3a, 0xe9, 0x27, 0xb1, 0x15,
+	0x53, 0x68, 0xfa, 0x00, 0x36, 0x5b, 0xd3, 0x9a, 0xac, 0xd9, 0xdb, 0x0f, 0x20, 0x2b, 0x6b, 0x9f,
+	0xf8, 0xde, 0x59, 0xde, 0xca, 0xc4, 0x27, 0x6a, 0xea, 0x4d, 0xc8, 0x3e, 0x0f, 0x84, 0x9c, 0xc0,
+	0xec, 0x49, 0x20, 0x98, 0xa6, 0xae, 0xb2, 0x94, 0x5a, 0x14, 0x75, 0xf4, 0x1f, 0x54, 0xc8, 0x1b,
+	0x16, 0x47, 0xbb, 0xf5, 0xe2, 0xdb, 0x87, 0xac, 0x44, 0xc3, 0xf8, 0x6e, 0x2e, 0x1b, 0xb5, 0x8e,
+	0xdb, 0xf3, 0x99, 0x73, 0xc4, 0x7b, 0xc7, 0x93, 0x90, 0x51, 0x54, 0x96, 0x50, 0xae, 0xef, 0xb0,
+	0x31, 0x0e, 0x54, 0x8e, 0xc6, 0x17, 0xfd, 0x57, 0x15, 0x4a, 0x32, 0x82, 0x0e, 0x13, 0x47, 0xd6,
+	0x77, 0xcd, 0xfd, 0xff, 0x23, 0x92, 0x4f, 0xa1, 0x10, 0x0f, 0xb8, 0xeb, 0x24, 0xd3, 0x7d, 0xef,
+	0xb2, 0x21, 0xf6, 0xee, 0xf0, 0x93, 0xd6, 0x96, 0xac, 0xf2, 0xd9, 0xab, 0xbd, 0x7c, 0xf2, 0x81,
+	0xe6, 0xd1, 0xf6, 0xd0, 0xd1, 0xff, 0x54, 0xa1, 0x98, 0x84, 0xde, 0x72, 0x05, 0x7f, 0x7d, 0x22,
+	0x27, 0x0f, 0x21, 0x27, 0x27, 0x80, 0x6b, 0xb9, 0x35, 0x86, 0x3b, 0x36, 0xd1, 0x7f, 0x54, 0xa1,
+	0xf4, 0x28, 0xf0, 0x42, 0xcb, 0x16, 0xff, 0x86, 0xb6, 0xf6, 0x21, 0x87, 0x51, 0x24, 0xb3, 0x7d,
+	0x77, 0x45, 0xf8, 0x53, 0x9f, 0xa8, 0x4b, 0xee, 0x42, 0x5e, 0x8c, 0xcd, 0x01, 0x9b, 0x48, 0x36,
+	0xca, 0xd4, 0x4a, 0x74, 0x43, 0x8c, 0xbf, 0x60, 0x13, 0xae, 0x7f, 0x03, 0x3b, 0xe9, 0x58, 0x8e,
+	0xc7, 0x9c, 0xb2, 0xef, 0x47, 0x8c, 0xaf, 0xfb, 0xe0, 0x34, 0xc8, 0xe3, 0x38, 0x32, 0xae, 0x65,
+	0xaa, 0x99, 0x5a, 0x99, 0x4e, 0xaf, 0xfa, 0x00, 0xb6, 0x2e, 0x78, 0xf8, 0xaf, 0xa0, 0xc9, 0x2d,
+	0xc8, 0x88, 0xf1, 0x34, 0x23, 0x79, 0xd4, 0xd9, 0xa2, 0xb3, 0x83, 0xb5, 0xab, 0xfb, 0x2e, 0x94,
+	0x23, 0xb9, 0x99, 0xb8, 0x88, 0x46, 0xb6, 0x60, 0x0e, 0x56, 0xb9, 0x40, 0x17, 0x3f, 0xea, 0xbf,
+	0xe5, 0x21, 0x7f, 0xc4, 0x38, 0xb7, 0x7a, 0x8c, 0x7c, 0x0e, 0x37, 0x7d, 0x76, 0x1a, 0x73, 0xa2,
+	0x89, 0x9b, 0x30, 0xa6, 0x0e, 0xbd, 0xbe, 0x6c, 0xc3, 0xd7, 0xd3, 0x9b, 0xd6, 0x50, 0x68, 0xc9,
+	0x4f, 0xdd, 0xc9, 0x11, 0x6c, 0x49, 0xac, 0x13, 0xb9, 0xd2, 0xcc, 0xb8, 0xcb, 0x37, 0x10, 0xec,
+	0x9d, 0x95, 0x60, 0xf3, 0xf5, 0x67, 0x28, 0xb4, 0xec, 0xa7, 0x3f, 0x2c, 0x6c, 0x87, 0x25, 0x2c,
+	0x3c, 0xc7, 0x99, 0x2e, 0x01, 0x23, 0xb5, 0x1d, 0xc8, 0x67, 0x17, 0x78, 0x3c, 0x7e, 0x2e, 0x6f,
+	0x5f, 0x8d, 0xd0, 0x7e, 0xf6, 0xc4, 0x58, 0xa4, 0x71, 0xf2, 0x31, 0xc0, 0x7c, 0x1b, 0x26, 0x0f,
+	0x66, 0x6f, 0x39, 0xca, 0x8c, 0xee, 0x0d, 0x85, 0x6e, 0xce, 0xf6, 0xa1, 0x64, 0x73, 0xe4, 0xe4,
+	0x8d, 0xcb, 0x1b, 0x6e, 0x6e, 0x2b, 0x89, 0xc4, 0x50, 0x62, 0x66, 0x26, 0x0f, 0xa1, 0xd0, 0xb7,
+	0xb8, 0x89, 0x56, 0x79, 0xb4, 0x7a, 0x6b, 0xb9, 0x55, 0x42, 0xdf, 0x86, 0x42, 0xf3, 0xfd, 0xf8,
+	0x28, 0x1b, 0x2a, 0xed, 0xf0, 0x8f, 0xc0, 0x93, 0x8c, 0xaa, 0x15, 0xae, 0x6a, 0x68, 0x9a, 0x7b,
+	0x65, 0x43, 0x4f, 0x52, 0x77, 0xf2, 0x18, 0xca, 0x33, 0x2c, 0x49, 0x09, 0xda, 0xe6, 0x55, 0x45,
+	0x4c, 0x71, 0xa1, 0x2c, 0xe2, 0xc9, 0xfc, 0x4a, 0x0e, 0xa1, 0x6c, 0xc7, 0x83, 0x9d, 0xcc, 0x05,
+	0x5c, 0x15, 0x53, 0xfa, 0x0d, 0xc8, 0x98, 0xec, 0xd4, 0x9d, 0xb8, 0x70, 0x6f, 0x01, 0xca, 0x14,
+	0x63, 0x6e, 0x46, 0xf1, 0xab, 0xd7, 0x8a, 0x08, 0x7b, 0xff, 0x9f, 0x61, 0xe7, 0x4c, 0x61, 0x28,
+	0x74, 0xc7, 0x5e, 0x2a, 0x21, 0x1d, 0xb8, 0x7d, 0xc9, 0x95, 0x56, 0x42, 0x17, 0xef, 0x5d, 0xcb,
+	0x85, 0xa1, 0xd0, 0xad, 0x0b, 0xd8, 0x97, 0x41, 0x2d, 0x7b, 0xa0, 0x95, 0xaf, 0x0b, 0x7a, 0x60,
+	0x0f, 0x2e, 0x82, 0x1e, 0xd8, 0x83, 0x56, 0x0e, 0x32, 0x7c, 0xe4, 0xb5, 0xbe, 0x7c, 0x71, 0x56,
+	0x51, 0x5f, 0x9e, 0x55, 0xd4, 0x3f, 0xce, 0x2a, 0xea, 0x4f, 0xe7, 0x15, 0xe5, 0xe5, 0x79, 0x45,
+	0xf9, 0xfd, 0xbc, 0xa2, 0x7c, 0xfd, 0xa0, 0xe7, 0x8a, 0xfe, 0xa8, 0x5b, 0xb7, 0x03, 0xaf, 0x61,
+	0x07, 0x1e, 0x13, 0xdd, 0x6f, 0xc5, 0xfc, 0x10, 0xff, 0xb2, 0x2f, 0xfb, 0xe9, 0xef, 0x6e, 0xa0,
+	0x6c, 0xff, 0xef, 0x01, 0x00, 0xa8, 0xd8, 0x32, 0x68, 0x13, 0x0c, 0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1274,7 +1606,7 @@ func (m *VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
+func (m *CompactBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1284,55 +1616,261 @@ func (m *Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if len(m.TxKeys) > 0 {
+		for iNdEx := len(m.TxKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxKeys[iNdEx])
+			copy(dAtA[i:], m.TxKeys[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TxKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactBlockTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactBlockTxsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactBlockTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.NewRoundStep != nil {
-		{
-			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		dAtA12 := make([]byte, len(m.Indexes)*10)
+		var j11 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTypes(dAtA, i, uint64(j11))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *CompactBlockTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactBlockTxs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactBlockTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Indexes) > 0 {
+		dAtA14 := make([]byte, len(m.Indexes)*10)
+		var j13 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTypes(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactBlockAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactBlockAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactBlockAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reconstructed {
+		i--
+		if m.Reconstructed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewRoundStep != nil {
+		{
+			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.NewValidBlock != nil {
 		{
@@ -1495,6 +2033,90 @@ func (m *Message_VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_CompactBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlock != nil {
+		{
+			size, err := m.CompactBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_CompactBlockTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlockTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlockTxsRequest != nil {
+		{
+			size, err := m.CompactBlockTxsRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_CompactBlockTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlockTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlockTxs != nil {
+		{
+			size, err := m.CompactBlockTxs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_CompactBlockAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlockAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlockAck != nil {
+		{
+			size, err := m.CompactBlockAck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1675,6 +2297,97 @@ func (m *VoteSetBits) Size() (n int) {
 	return n
 }
 
+func (m *CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = m.Block.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.TxKeys) > 0 {
+		for _, b := range m.TxKeys {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompactBlockTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *CompactBlockTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompactBlockAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if m.Reconstructed {
+		n += 2
+	}
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1795,11 +2508,59 @@ func (m *Message_VoteSetBits) Size() (n int) {
 	}
 	return n
 }
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
+func (m *Message_CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlock != nil {
+		l = m.CompactBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_CompactBlockTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlockTxsRequest != nil {
+		l = m.CompactBlockTxsRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_CompactBlockTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlockTxs != nil {
+		l = m.CompactBlockTxs.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_CompactBlockAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlockAck != nil {
+		l = m.CompactBlockAck.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
@@ -2604,9 +3365,563 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.SignedMsgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteSetMaj23: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteSetMaj23: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.SignedMsgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteSetBits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteSetBits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.SignedMsgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxKeys = append(m.TxKeys, make([]byte, postIndex-iNdEx))
+			copy(m.TxKeys[len(m.TxKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactBlockTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactBlockTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactBlockTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Type = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2616,16 +3931,16 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= types.SignedMsgType(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.Index = 0
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2635,11 +3950,87 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				m.Round |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2661,7 +4052,7 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
+func (m *CompactBlockTxs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2684,10 +4075,10 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteSetMaj23: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactBlockTxs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteSetMaj23: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactBlockTxs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2729,29 +4120,86 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.SignedMsgType(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2761,24 +4209,23 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2801,7 +4248,7 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
+func (m *CompactBlockAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2824,10 +4271,10 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteSetBits: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactBlockAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteSetBits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactBlockAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2870,61 +4317,9 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.SignedMsgType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconstructed", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
-			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2934,25 +4329,12 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Reconstructed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3318,6 +4700,146 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_VoteSetBits{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlock{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlockTxsRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlockTxsRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlockTxsRequest{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlockTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlockTxs{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlockTxs{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlockAck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlockAck{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlockAck{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -197,12 +197,68 @@ func (m *EndHeight) GetHeight() int64 {
 	return 0
 }
 
+// CheckpointMarker marks the point of the WAL up to which the messages of the
+// given height are reflected in the Checkpoint with the same height and
+// sequence.
+type CheckpointMarker struct {
+	Height   int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *CheckpointMarker) Reset()         { *m = CheckpointMarker{} }
+func (m *CheckpointMarker) String() string { return proto.CompactTextString(m) }
+func (*CheckpointMarker) ProtoMessage()    {}
+func (*CheckpointMarker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed0b60c2d348ab09, []int{3}
+}
+func (m *CheckpointMarker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointMarker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointMarker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointMarker.Merge(m, src)
+}
+func (m *CheckpointMarker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointMarker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointMarker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointMarker proto.InternalMessageInfo
+
+func (m *CheckpointMarker) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CheckpointMarker) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 type WALMessage struct {
 	// Types that are valid to be assigned to Sum:
 	//	*WALMessage_EventDataRoundState
 	//	*WALMessage_MsgInfo
 	//	*WALMessage_TimeoutInfo
 	//	*WALMessage_EndHeight
+	//	*WALMessage_CheckpointMarker
 	Sum isWALMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -210,7 +266,7 @@ func (m *WALMessage) Reset()         { *m = WALMessage{} }
 func (m *WALMessage) String() string { return proto.CompactTextString(m) }
 func (*WALMessage) ProtoMessage()    {}
 func (*WALMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed0b60c2d348ab09, []int{3}
+	return fileDescriptor_ed0b60c2d348ab09, []int{4}
 }
 func (m *WALMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,11 +313,15 @@ type WALMessage_TimeoutInfo struct {
 type WALMessage_EndHeight struct {
 	EndHeight *EndHeight `protobuf:"bytes,4,opt,name=end_height,json=endHeight,proto3,oneof" json:"end_height,omitempty"`
 }
+type WALMessage_CheckpointMarker struct {
+	CheckpointMarker *CheckpointMarker `protobuf:"bytes,5,opt,name=checkpoint_marker,json=checkpointMarker,proto3,oneof" json:"checkpoint_marker,omitempty"`
+}
 
 func (*WALMessage_EventDataRoundState) isWALMessage_Sum() {}
 func (*WALMessage_MsgInfo) isWALMessage_Sum()             {}
 func (*WALMessage_TimeoutInfo) isWALMessage_Sum()         {}
 func (*WALMessage_EndHeight) isWALMessage_Sum()           {}
+func (*WALMessage_CheckpointMarker) isWALMessage_Sum()    {}
 
 func (m *WALMessage) GetSum() isWALMessage_Sum {
 	if m != nil {
@@ -298,6 +358,13 @@ func (m *WALMessage) GetEndHeight() *EndHeight {
 	return nil
 }
 
+func (m *WALMessage) GetCheckpointMarker() *CheckpointMarker {
+	if x, ok := m.GetSum().(*WALMessage_CheckpointMarker); ok {
+		return x.CheckpointMarker
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*WALMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -305,6 +372,7 @@ func (*WALMessage) XXX_OneofWrappers() []interface{} {
 		(*WALMessage_MsgInfo)(nil),
 		(*WALMessage_TimeoutInfo)(nil),
 		(*WALMessage_EndHeight)(nil),
+		(*WALMessage_CheckpointMarker)(nil),
 	}
 }
 
@@ -318,7 +386,7 @@ func (m *TimedWALMessage) Reset()         { *m = TimedWALMessage{} }
 func (m *TimedWALMessage) String() string { return proto.CompactTextString(m) }
 func (*TimedWALMessage) ProtoMessage()    {}
 func (*TimedWALMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed0b60c2d348ab09, []int{4}
+	return fileDescriptor_ed0b60c2d348ab09, []int{5}
 }
 func (m *TimedWALMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,52 +429,370 @@ func (m *TimedWALMessage) GetMsg() *WALMessage {
 	return nil
 }
 
+// Checkpoint is a snapshot of the consensus state of a height, saved
+// periodically so that a restarted node resumes the height without replaying
+// the messages of the WAL preceding the CheckpointMarker with the same height
+// and sequence.
+type Checkpoint struct {
+	Height     int64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Sequence   uint64          `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Round      int32           `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Step       uint32          `protobuf:"varint,4,opt,name=step,proto3" json:"step,omitempty"`
+	StartTime  time.Time       `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	CommitTime time.Time       `protobuf:"bytes,6,opt,name=commit_time,json=commitTime,proto3,stdtime" json:"commit_time"`
+	Proposal   *types.Proposal `protobuf:"bytes,7,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	// the part sets of the proposal, locked and valid blocks, without duplicates
+	PartSets                 []CheckpointPartSet  `protobuf:"bytes,8,rep,name=part_sets,json=partSets,proto3" json:"part_sets"`
+	ProposalBlockPartsHeader *types.PartSetHeader `protobuf:"bytes,9,opt,name=proposal_block_parts_header,json=proposalBlockPartsHeader,proto3" json:"proposal_block_parts_header,omitempty"`
+	LockedRound              int32                `protobuf:"varint,10,opt,name=locked_round,json=lockedRound,proto3" json:"locked_round,omitempty"`
+	LockedBlockPartsHeader   *types.PartSetHeader `protobuf:"bytes,11,opt,name=locked_block_parts_header,json=lockedBlockPartsHeader,proto3" json:"locked_block_parts_header,omitempty"`
+	ValidRound               int32                `protobuf:"varint,12,opt,name=valid_round,json=validRound,proto3" json:"valid_round,omitempty"`
+	ValidBlockPartsHeader    *types.PartSetHeader `protobuf:"bytes,13,opt,name=valid_block_parts_header,json=validBlockPartsHeader,proto3" json:"valid_block_parts_header,omitempty"`
+	// the votes of the rounds up to round + 1
+	Votes                     []types.Vote `protobuf:"bytes,14,rep,name=votes,proto3" json:"votes"`
+	CommitRound               int32        `protobuf:"varint,15,opt,name=commit_round,json=commitRound,proto3" json:"commit_round,omitempty"`
+	TriggeredTimeoutPrecommit bool         `protobuf:"varint,16,opt,name=triggered_timeout_precommit,json=triggeredTimeoutPrecommit,proto3" json:"triggered_timeout_precommit,omitempty"`
+}
+
+func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
+func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
+func (*Checkpoint) ProtoMessage()    {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed0b60c2d348ab09, []int{6}
+}
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
+}
+func (m *Checkpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
+func (m *Checkpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetStep() uint32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Checkpoint) GetCommitTime() time.Time {
+	if m != nil {
+		return m.CommitTime
+	}
+	return time.Time{}
+}
+
+func (m *Checkpoint) GetProposal() *types.Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+func (m *Checkpoint) GetPartSets() []CheckpointPartSet {
+	if m != nil {
+		return m.PartSets
+	}
+	return nil
+}
+
+func (m *Checkpoint) GetProposalBlockPartsHeader() *types.PartSetHeader {
+	if m != nil {
+		return m.ProposalBlockPartsHeader
+	}
+	return nil
+}
+
+func (m *Checkpoint) GetLockedRound() int32 {
+	if m != nil {
+		return m.LockedRound
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetLockedBlockPartsHeader() *types.PartSetHeader {
+	if m != nil {
+		return m.LockedBlockPartsHeader
+	}
+	return nil
+}
+
+func (m *Checkpoint) GetValidRound() int32 {
+	if m != nil {
+		return m.ValidRound
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetValidBlockPartsHeader() *types.PartSetHeader {
+	if m != nil {
+		return m.ValidBlockPartsHeader
+	}
+	return nil
+}
+
+func (m *Checkpoint) GetVotes() []types.Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *Checkpoint) GetCommitRound() int32 {
+	if m != nil {
+		return m.CommitRound
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetTriggeredTimeoutPrecommit() bool {
+	if m != nil {
+		return m.TriggeredTimeoutPrecommit
+	}
+	return false
+}
+
+// CheckpointPartSet is a part set, possibly incomplete, of a Checkpoint.
+type CheckpointPartSet struct {
+	Header types.PartSetHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+	Parts  []types.Part        `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts"`
+}
+
+func (m *CheckpointPartSet) Reset()         { *m = CheckpointPartSet{} }
+func (m *CheckpointPartSet) String() string { return proto.CompactTextString(m) }
+func (*CheckpointPartSet) ProtoMessage()    {}
+func (*CheckpointPartSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed0b60c2d348ab09, []int{7}
+}
+func (m *CheckpointPartSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointPartSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointPartSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointPartSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointPartSet.Merge(m, src)
+}
+func (m *CheckpointPartSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointPartSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointPartSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointPartSet proto.InternalMessageInfo
+
+func (m *CheckpointPartSet) GetHeader() types.PartSetHeader {
+	if m != nil {
+		return m.Header
+	}
+	return types.PartSetHeader{}
+}
+
+func (m *CheckpointPartSet) GetParts() []types.Part {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+// SoftwareVersion is the software a node started committing blocks with, saved
+// to tell whether it changed since the application required an upgrade.
+type SoftwareVersion struct {
+	// the version of CometBFT
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the software version of the application, as reported by Info
+	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// the latest height when the node started with the software
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SoftwareVersion) Reset()         { *m = SoftwareVersion{} }
+func (m *SoftwareVersion) String() string { return proto.CompactTextString(m) }
+func (*SoftwareVersion) ProtoMessage()    {}
+func (*SoftwareVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed0b60c2d348ab09, []int{8}
+}
+func (m *SoftwareVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SoftwareVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SoftwareVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SoftwareVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoftwareVersion.Merge(m, src)
+}
+func (m *SoftwareVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *SoftwareVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoftwareVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SoftwareVersion proto.InternalMessageInfo
+
+func (m *SoftwareVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *SoftwareVersion) GetAppVersion() string {
+	if m != nil {
+		return m.AppVersion
+	}
+	return ""
+}
+
+func (m *SoftwareVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgInfo)(nil), "tendermint.consensus.MsgInfo")
 	proto.RegisterType((*TimeoutInfo)(nil), "tendermint.consensus.TimeoutInfo")
 	proto.RegisterType((*EndHeight)(nil), "tendermint.consensus.EndHeight")
+	proto.RegisterType((*CheckpointMarker)(nil), "tendermint.consensus.CheckpointMarker")
 	proto.RegisterType((*WALMessage)(nil), "tendermint.consensus.WALMessage")
 	proto.RegisterType((*TimedWALMessage)(nil), "tendermint.consensus.TimedWALMessage")
+	proto.RegisterType((*Checkpoint)(nil), "tendermint.consensus.Checkpoint")
+	proto.RegisterType((*CheckpointPartSet)(nil), "tendermint.consensus.CheckpointPartSet")
+	proto.RegisterType((*SoftwareVersion)(nil), "tendermint.consensus.SoftwareVersion")
 }
 
 func init() { proto.RegisterFile("tendermint/consensus/wal.proto", fileDescriptor_ed0b60c2d348ab09) }
 
 var fileDescriptor_ed0b60c2d348ab09 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x5f, 0x8b, 0xd3, 0x4e,
-	0x14, 0x4d, 0xb6, 0xff, 0x6f, 0x7f, 0x3f, 0x84, 0x58, 0x96, 0x5a, 0xd8, 0x34, 0x76, 0x11, 0xfa,
-	0x94, 0xc0, 0x8a, 0x28, 0xbe, 0xa8, 0xa5, 0x2b, 0x2d, 0xb8, 0xa0, 0xe3, 0x82, 0x20, 0x42, 0x48,
-	0x9b, 0xdb, 0x34, 0xb0, 0x99, 0x29, 0x99, 0x89, 0xe2, 0x93, 0x5f, 0xa1, 0x8f, 0x7e, 0x13, 0xbf,
-	0xc2, 0x3e, 0xee, 0xa3, 0x4f, 0xab, 0xb4, 0x5f, 0x44, 0x32, 0x33, 0x6d, 0x83, 0x1b, 0xdf, 0xe6,
-	0xe6, 0x9e, 0x7b, 0x4f, 0xce, 0x39, 0x33, 0x60, 0x0b, 0xa4, 0x21, 0xa6, 0x49, 0x4c, 0x85, 0x37,
-	0x67, 0x94, 0x23, 0xe5, 0x19, 0xf7, 0xbe, 0x04, 0x57, 0xee, 0x2a, 0x65, 0x82, 0x59, 0x9d, 0x43,
-	0xdf, 0xdd, 0xf7, 0x7b, 0x9d, 0x88, 0x45, 0x4c, 0x02, 0xbc, 0xfc, 0xa4, 0xb0, 0x3d, 0x3b, 0x62,
-	0x2c, 0xba, 0x42, 0x4f, 0x56, 0xb3, 0x6c, 0xe1, 0x85, 0x59, 0x1a, 0x88, 0x98, 0x51, 0xdd, 0xef,
-	0xff, 0xdd, 0x17, 0x71, 0x82, 0x5c, 0x04, 0xc9, 0x4a, 0x03, 0x9c, 0xd2, 0x9f, 0x11, 0x5f, 0x57,
-	0xc8, 0x35, 0xe2, 0xa4, 0x80, 0x90, 0xdf, 0x3d, 0xfc, 0x8c, 0x54, 0xe8, 0xf6, 0x00, 0xa1, 0x71,
-	0xc1, 0xa3, 0x29, 0x5d, 0x30, 0xeb, 0x09, 0x54, 0x12, 0x1e, 0x75, 0x4d, 0xc7, 0x1c, 0xb6, 0xcf,
-	0x4e, 0xdc, 0x32, 0x19, 0xee, 0x05, 0x72, 0x1e, 0x44, 0x38, 0xaa, 0x5e, 0xdf, 0xf6, 0x0d, 0x92,
-	0xe3, 0xad, 0x53, 0x68, 0xac, 0x10, 0x53, 0x3f, 0x0e, 0xbb, 0x47, 0x8e, 0x39, 0x6c, 0x8d, 0x60,
-	0x73, 0xdb, 0xaf, 0xbf, 0x45, 0x4c, 0xa7, 0x63, 0x52, 0xcf, 0x5b, 0xd3, 0x70, 0xb0, 0x36, 0xa1,
-	0x7d, 0x19, 0x27, 0xc8, 0x32, 0x21, 0xb9, 0x5e, 0x40, 0x73, 0x27, 0x55, 0x13, 0x3e, 0x70, 0x95,
-	0x56, 0x77, 0xa7, 0xd5, 0x1d, 0x6b, 0xc0, 0xa8, 0x99, 0x93, 0x7d, 0xff, 0xd5, 0x37, 0xc9, 0x7e,
-	0xc8, 0x3a, 0x86, 0xfa, 0x12, 0xe3, 0x68, 0x29, 0x24, 0x69, 0x85, 0xe8, 0xca, 0xea, 0x40, 0x2d,
-	0x65, 0x19, 0x0d, 0xbb, 0x15, 0xc7, 0x1c, 0xd6, 0x88, 0x2a, 0x2c, 0x0b, 0xaa, 0x5c, 0xe0, 0xaa,
-	0x5b, 0x75, 0xcc, 0xe1, 0xff, 0x44, 0x9e, 0x07, 0xa7, 0xd0, 0x3a, 0xa7, 0xe1, 0x44, 0x8d, 0x1d,
-	0xd6, 0x99, 0xc5, 0x75, 0x83, 0x1f, 0x47, 0x00, 0x1f, 0x5e, 0xbd, 0xd1, 0xb2, 0xad, 0x4f, 0x70,
-	0x2c, 0xdd, 0xf3, 0xc3, 0x40, 0x04, 0xbe, 0xdc, 0xed, 0x73, 0x11, 0x08, 0xd4, 0x22, 0x1e, 0x15,
-	0x5d, 0x53, 0x29, 0x9c, 0xe7, 0xf8, 0x71, 0x20, 0x02, 0x92, 0xa3, 0xdf, 0xe7, 0xe0, 0x89, 0x41,
-	0xee, 0xe3, 0xdd, 0xcf, 0xd6, 0x73, 0x68, 0x26, 0x3c, 0xf2, 0x63, 0xba, 0x60, 0x52, 0xd5, 0xbf,
-	0x53, 0x50, 0x89, 0x4d, 0x0c, 0xd2, 0x48, 0x74, 0x78, 0xaf, 0xe1, 0x3f, 0xa1, 0xfc, 0x55, 0xf3,
-	0x15, 0x39, 0xff, 0xb0, 0x7c, 0xbe, 0x90, 0xc4, 0xc4, 0x20, 0x6d, 0x51, 0x08, 0xe6, 0x25, 0x00,
-	0xd2, 0xd0, 0xd7, 0x66, 0x54, 0xe5, 0x96, 0x7e, 0xf9, 0x96, 0xbd, 0x7b, 0x13, 0x83, 0xb4, 0x70,
-	0x57, 0x8c, 0x6a, 0x50, 0xe1, 0x59, 0x32, 0xf8, 0x06, 0xf7, 0x72, 0x9a, 0xb0, 0xe0, 0xde, 0x33,
-	0xa8, 0xe6, 0x54, 0xda, 0xab, 0xde, 0x9d, 0xc0, 0x2f, 0x77, 0x97, 0x5b, 0x25, 0xbe, 0xce, 0x13,
-	0x97, 0x13, 0xd6, 0x99, 0xba, 0x9a, 0xca, 0x14, 0xa7, 0xfc, 0x77, 0x0e, 0x44, 0xf2, 0x5e, 0x8e,
-	0xde, 0x5d, 0x6f, 0x6c, 0xf3, 0x66, 0x63, 0x9b, 0xbf, 0x37, 0xb6, 0xb9, 0xde, 0xda, 0xc6, 0xcd,
-	0xd6, 0x36, 0x7e, 0x6e, 0x6d, 0xe3, 0xe3, 0xd3, 0x28, 0x16, 0xcb, 0x6c, 0xe6, 0xce, 0x59, 0xe2,
-	0xcd, 0x59, 0x82, 0x62, 0xb6, 0x10, 0x87, 0x83, 0x7a, 0xa4, 0x65, 0xef, 0x6a, 0x56, 0x97, 0xbd,
-	0xc7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x0a, 0x05, 0xb5, 0x03, 0x04, 0x00, 0x00,
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xd6, 0xdf, 0xcf, 0x29, 0x49, 0x87, 0x10, 0x6d, 0x52, 0x6a, 0xbb, 0xae, 0x00, 0x9f,
+	0x6c, 0x29, 0x88, 0x0f, 0x21, 0xf1, 0xe5, 0x26, 0x95, 0x83, 0x88, 0x14, 0x26, 0xa5, 0xa0, 0x0a,
+	0xb1, 0x1a, 0x7b, 0x9f, 0xd7, 0xab, 0x78, 0x77, 0x96, 0x9d, 0x71, 0x2a, 0x4e, 0xdc, 0x38, 0xa2,
+	0x1c, 0xf9, 0x93, 0x7a, 0xec, 0x91, 0x53, 0x41, 0xc9, 0x91, 0x7f, 0x02, 0xcd, 0xc7, 0xda, 0x56,
+	0xbc, 0x85, 0x94, 0xdb, 0xcc, 0xbc, 0xdf, 0xfb, 0xbd, 0x8f, 0xdf, 0x9b, 0xd9, 0x85, 0xa6, 0xc4,
+	0xd8, 0xc7, 0x34, 0x0a, 0x63, 0xd9, 0x1f, 0xf3, 0x58, 0x60, 0x2c, 0xe6, 0xa2, 0xff, 0x8c, 0xcd,
+	0x7a, 0x49, 0xca, 0x25, 0x27, 0xdb, 0x4b, 0x7b, 0x6f, 0x61, 0xdf, 0xdb, 0x0e, 0x78, 0xc0, 0x35,
+	0xa0, 0xaf, 0x56, 0x06, 0xbb, 0xd7, 0x0c, 0x38, 0x0f, 0x66, 0xd8, 0xd7, 0xbb, 0xd1, 0x7c, 0xd2,
+	0xf7, 0xe7, 0x29, 0x93, 0x21, 0x8f, 0xad, 0xbd, 0x75, 0xdd, 0x2e, 0xc3, 0x08, 0x85, 0x64, 0x51,
+	0x62, 0x01, 0xed, 0xdc, 0x64, 0xe4, 0xcf, 0x09, 0x0a, 0x8b, 0xb8, 0xb7, 0x82, 0xd0, 0xe7, 0x7d,
+	0x3c, 0xc7, 0x58, 0x66, 0xe6, 0xb7, 0xd7, 0xcc, 0x2b, 0xce, 0x1d, 0x84, 0xea, 0xb1, 0x08, 0x8e,
+	0xe2, 0x09, 0x27, 0x1f, 0x40, 0x31, 0x12, 0x81, 0xeb, 0xb4, 0x9d, 0x6e, 0x63, 0xff, 0x5e, 0x2f,
+	0xaf, 0xc8, 0xde, 0x31, 0x0a, 0xc1, 0x02, 0x1c, 0x94, 0x9e, 0xbf, 0x6c, 0x15, 0xa8, 0xc2, 0x93,
+	0x07, 0x50, 0x4d, 0x10, 0x53, 0x2f, 0xf4, 0xdd, 0x5b, 0x6d, 0xa7, 0x5b, 0x1f, 0xc0, 0xe5, 0xcb,
+	0x56, 0xe5, 0x04, 0x31, 0x3d, 0x3a, 0xa0, 0x15, 0x65, 0x3a, 0xf2, 0x3b, 0x17, 0x0e, 0x34, 0x1e,
+	0x87, 0x11, 0xf2, 0xb9, 0xd4, 0xb1, 0x3e, 0x87, 0x5a, 0xd6, 0x08, 0x1b, 0x70, 0xb7, 0x67, 0x3a,
+	0xd1, 0xcb, 0x3a, 0xd1, 0x3b, 0xb0, 0x80, 0x41, 0x4d, 0x05, 0xfb, 0xfd, 0xcf, 0x96, 0x43, 0x17,
+	0x4e, 0x64, 0x07, 0x2a, 0x53, 0x0c, 0x83, 0xa9, 0xd4, 0x41, 0x8b, 0xd4, 0xee, 0xc8, 0x36, 0x94,
+	0x53, 0x3e, 0x8f, 0x7d, 0xb7, 0xd8, 0x76, 0xba, 0x65, 0x6a, 0x36, 0x84, 0x40, 0x49, 0x48, 0x4c,
+	0xdc, 0x52, 0xdb, 0xe9, 0xde, 0xa6, 0x7a, 0xdd, 0x79, 0x00, 0xf5, 0xc3, 0xd8, 0x1f, 0x1a, 0xb7,
+	0x25, 0x9d, 0xb3, 0x4a, 0xd7, 0x79, 0x04, 0x5b, 0x0f, 0xa7, 0x38, 0x3e, 0x4b, 0x78, 0x18, 0xcb,
+	0x63, 0x96, 0x9e, 0x61, 0xfa, 0x2a, 0x2c, 0xd9, 0x83, 0x9a, 0xc0, 0x9f, 0xe6, 0x18, 0x8f, 0x51,
+	0x27, 0x55, 0xa2, 0x8b, 0x7d, 0xe7, 0xb7, 0x22, 0xc0, 0x77, 0x5f, 0x7e, 0x6d, 0xdb, 0x47, 0x7e,
+	0x80, 0x1d, 0xad, 0x91, 0xe7, 0x33, 0xc9, 0x3c, 0x9d, 0xa3, 0x27, 0x24, 0x93, 0x68, 0x9b, 0xf1,
+	0xce, 0x6a, 0xf7, 0x8d, 0x5c, 0x87, 0x0a, 0x7f, 0xc0, 0x24, 0xa3, 0x0a, 0x7d, 0xaa, 0xc0, 0xc3,
+	0x02, 0x7d, 0x13, 0xd7, 0x8f, 0xc9, 0x27, 0x50, 0x8b, 0x44, 0xe0, 0x85, 0xf1, 0x84, 0xbb, 0xb7,
+	0xfe, 0x55, 0x4d, 0xa3, 0xfc, 0xb0, 0x40, 0xab, 0x91, 0x59, 0x92, 0x47, 0xb0, 0x21, 0x8d, 0x4e,
+	0xc6, 0xbf, 0xa8, 0xfd, 0xef, 0xe7, 0xfb, 0xaf, 0x28, 0x3a, 0x2c, 0xd0, 0x86, 0x5c, 0x6e, 0xc9,
+	0x17, 0x00, 0x18, 0xfb, 0x9e, 0x6d, 0x54, 0x49, 0xb3, 0xb4, 0xf2, 0x59, 0x16, 0x2a, 0x0c, 0x0b,
+	0xb4, 0x8e, 0x0b, 0x49, 0xbe, 0x85, 0x3b, 0xe3, 0x45, 0xeb, 0xbd, 0x48, 0xf7, 0xde, 0x2d, 0x6b,
+	0xa2, 0x77, 0xf3, 0x89, 0xae, 0x2b, 0x35, 0x2c, 0xd0, 0xad, 0xf1, 0xb5, 0xb3, 0x41, 0x19, 0x8a,
+	0x62, 0x1e, 0x75, 0x7e, 0x81, 0x4d, 0x95, 0xbd, 0xbf, 0x22, 0xca, 0xc7, 0x50, 0x52, 0x15, 0x58,
+	0x09, 0xf6, 0xd6, 0xe6, 0xf1, 0x71, 0x76, 0x33, 0xcd, 0x40, 0x5e, 0xa8, 0x81, 0xd4, 0x1e, 0x64,
+	0xdf, 0xdc, 0x1c, 0xd3, 0xeb, 0x76, 0x7e, 0x72, 0xcb, 0x40, 0xfa, 0xda, 0x74, 0xfe, 0xae, 0x00,
+	0x2c, 0x13, 0xfe, 0x3f, 0x43, 0x75, 0xf3, 0x59, 0x27, 0x0f, 0x01, 0x84, 0x64, 0xa9, 0xf4, 0x74,
+	0x81, 0xe5, 0xd7, 0x28, 0xb0, 0xae, 0xfd, 0x94, 0x85, 0x1c, 0x42, 0x63, 0xcc, 0xa3, 0x28, 0xb4,
+	0x2c, 0x95, 0xd7, 0x60, 0x01, 0xe3, 0xa8, 0x69, 0x3e, 0x84, 0x5a, 0x92, 0xf2, 0x84, 0x0b, 0x36,
+	0x73, 0xab, 0x96, 0x63, 0x6d, 0xda, 0x4f, 0x2c, 0x82, 0x2e, 0xb0, 0xe4, 0x2b, 0xa8, 0x27, 0xaa,
+	0x04, 0x81, 0x52, 0xb8, 0xb5, 0x76, 0xb1, 0xdb, 0xd8, 0x7f, 0xef, 0xbf, 0xe6, 0xe0, 0x84, 0xa5,
+	0xf2, 0x14, 0xa5, 0x7d, 0xae, 0x6a, 0x89, 0xd9, 0x0a, 0xf2, 0x23, 0xdc, 0xcd, 0x78, 0xbd, 0xd1,
+	0x8c, 0x8f, 0xcf, 0x3c, 0x65, 0x12, 0xde, 0x14, 0x99, 0x8f, 0xa9, 0x5b, 0x5f, 0x1f, 0x57, 0x9b,
+	0x96, 0x21, 0x18, 0x6a, 0x18, 0x75, 0x33, 0x8e, 0x81, 0xa2, 0x50, 0x36, 0x61, 0x2c, 0xe4, 0x3e,
+	0x6c, 0xa8, 0x23, 0xf4, 0xcd, 0xdd, 0x76, 0x41, 0x0b, 0xd4, 0x30, 0x67, 0xfa, 0xa6, 0x92, 0xa7,
+	0xb0, 0x6b, 0x21, 0x39, 0x09, 0x34, 0x6e, 0x96, 0xc0, 0x8e, 0x61, 0x58, 0x0b, 0xdf, 0x82, 0xc6,
+	0x39, 0x9b, 0x85, 0x59, 0xf4, 0x0d, 0x1d, 0x1d, 0xf4, 0x91, 0x09, 0xfe, 0x3d, 0xb8, 0x06, 0x90,
+	0x13, 0xfb, 0xf6, 0xcd, 0x62, 0xbf, 0xa5, 0x09, 0xd6, 0x42, 0xef, 0x43, 0xf9, 0x9c, 0x4b, 0x14,
+	0xee, 0x1b, 0x5a, 0xa1, 0x9d, 0x75, 0x9a, 0x27, 0x5c, 0x66, 0xdf, 0x0f, 0x03, 0x55, 0xdd, 0xb2,
+	0x83, 0x65, 0xf2, 0xdd, 0x34, 0xdd, 0x32, 0x67, 0x26, 0xe1, 0xcf, 0xe0, 0xae, 0x4c, 0xc3, 0x20,
+	0xc0, 0x14, 0x7d, 0x2f, 0x7b, 0xa0, 0x92, 0x14, 0x0d, 0xc6, 0xdd, 0x6a, 0x3b, 0xdd, 0x1a, 0xdd,
+	0x5d, 0x40, 0xec, 0xc3, 0x74, 0x92, 0x01, 0x3a, 0xbf, 0x3a, 0x70, 0x67, 0x6d, 0x2c, 0xc8, 0xa7,
+	0xea, 0xd2, 0xe9, 0xa2, 0x9d, 0x1b, 0x15, 0x6d, 0xd3, 0xae, 0x4c, 0x17, 0xb5, 0xea, 0xce, 0xb9,
+	0xb7, 0x5e, 0x55, 0xab, 0xf2, 0xce, 0x6a, 0xd5, 0xd0, 0x8e, 0x0f, 0x9b, 0xa7, 0x7c, 0x22, 0x9f,
+	0xb1, 0x14, 0x9f, 0x60, 0x2a, 0xd4, 0xa7, 0xcc, 0x85, 0xea, 0xb9, 0x59, 0xea, 0x34, 0xea, 0x34,
+	0xdb, 0x2a, 0x1d, 0x59, 0x92, 0x78, 0x99, 0x55, 0x7f, 0x5e, 0x29, 0xb0, 0x24, 0xc9, 0x5c, 0x97,
+	0xaf, 0x46, 0x71, 0xf5, 0xd5, 0x18, 0x7c, 0xf3, 0xfc, 0xb2, 0xe9, 0xbc, 0xb8, 0x6c, 0x3a, 0x7f,
+	0x5d, 0x36, 0x9d, 0x8b, 0xab, 0x66, 0xe1, 0xc5, 0x55, 0xb3, 0xf0, 0xc7, 0x55, 0xb3, 0xf0, 0xf4,
+	0xa3, 0x20, 0x94, 0xd3, 0xf9, 0xa8, 0x37, 0xe6, 0x51, 0x7f, 0xcc, 0x23, 0x94, 0xa3, 0x89, 0x5c,
+	0x2e, 0xcc, 0xef, 0x4b, 0xde, 0x1f, 0xc7, 0xa8, 0xa2, 0x6d, 0xef, 0xff, 0x33, 0x00, 0x94, 0x74,
+	0x11, 0x0f, 0x1d, 0x09, 0x00, 0x00,
 }
 
 func (m *MsgInfo) Marshal() (dAtA []byte, err error) {
@@ -523,6 +909,39 @@ func (m *EndHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CheckpointMarker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointMarker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WALMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -639,6 +1058,27 @@ func (m *WALMessage_EndHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *WALMessage_CheckpointMarker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WALMessage_CheckpointMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CheckpointMarker != nil {
+		{
+			size, err := m.CheckpointMarker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TimedWALMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -671,23 +1111,274 @@ func (m *TimedWALMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintWal(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintWal(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TriggeredTimeoutPrecommit {
+		i--
+		if m.TriggeredTimeoutPrecommit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.CommitRound != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.CommitRound))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.ValidBlockPartsHeader != nil {
+		{
+			size, err := m.ValidBlockPartsHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ValidRound != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.ValidRound))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LockedBlockPartsHeader != nil {
+		{
+			size, err := m.LockedBlockPartsHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.LockedRound != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.LockedRound))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ProposalBlockPartsHeader != nil {
+		{
+			size, err := m.ProposalBlockPartsHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PartSets) > 0 {
+		for iNdEx := len(m.PartSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CommitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintWal(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x32
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintWal(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x2a
+	if m.Step != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Round != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointPartSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointPartSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointPartSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parts) > 0 {
+		for iNdEx := len(m.Parts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SoftwareVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SoftwareVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SoftwareVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AppVersion) > 0 {
+		i -= len(m.AppVersion)
+		copy(dAtA[i:], m.AppVersion)
+		i = encodeVarintWal(dAtA, i, uint64(len(m.AppVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintWal(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -740,6 +1431,21 @@ func (m *EndHeight) Size() (n int) {
 	return n
 }
 
+func (m *CheckpointMarker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovWal(uint64(m.Height))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovWal(uint64(m.Sequence))
+	}
+	return n
+}
+
 func (m *WALMessage) Size() (n int) {
 	if m == nil {
 		return 0
@@ -800,6 +1506,18 @@ func (m *WALMessage_EndHeight) Size() (n int) {
 	}
 	return n
 }
+func (m *WALMessage_CheckpointMarker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckpointMarker != nil {
+		l = m.CheckpointMarker.Size()
+		n += 1 + l + sovWal(uint64(l))
+	}
+	return n
+}
 func (m *TimedWALMessage) Size() (n int) {
 	if m == nil {
 		return 0
@@ -815,6 +1533,108 @@ func (m *TimedWALMessage) Size() (n int) {
 	return n
 }
 
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovWal(uint64(m.Height))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovWal(uint64(m.Sequence))
+	}
+	if m.Round != 0 {
+		n += 1 + sovWal(uint64(m.Round))
+	}
+	if m.Step != 0 {
+		n += 1 + sovWal(uint64(m.Step))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovWal(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitTime)
+	n += 1 + l + sovWal(uint64(l))
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovWal(uint64(l))
+	}
+	if len(m.PartSets) > 0 {
+		for _, e := range m.PartSets {
+			l = e.Size()
+			n += 1 + l + sovWal(uint64(l))
+		}
+	}
+	if m.ProposalBlockPartsHeader != nil {
+		l = m.ProposalBlockPartsHeader.Size()
+		n += 1 + l + sovWal(uint64(l))
+	}
+	if m.LockedRound != 0 {
+		n += 1 + sovWal(uint64(m.LockedRound))
+	}
+	if m.LockedBlockPartsHeader != nil {
+		l = m.LockedBlockPartsHeader.Size()
+		n += 1 + l + sovWal(uint64(l))
+	}
+	if m.ValidRound != 0 {
+		n += 1 + sovWal(uint64(m.ValidRound))
+	}
+	if m.ValidBlockPartsHeader != nil {
+		l = m.ValidBlockPartsHeader.Size()
+		n += 1 + l + sovWal(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovWal(uint64(l))
+		}
+	}
+	if m.CommitRound != 0 {
+		n += 1 + sovWal(uint64(m.CommitRound))
+	}
+	if m.TriggeredTimeoutPrecommit {
+		n += 3
+	}
+	return n
+}
+
+func (m *CheckpointPartSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovWal(uint64(l))
+	if len(m.Parts) > 0 {
+		for _, e := range m.Parts {
+			l = e.Size()
+			n += 1 + l + sovWal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SoftwareVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovWal(uint64(l))
+	}
+	l = len(m.AppVersion)
+	if l > 0 {
+		n += 1 + l + sovWal(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovWal(uint64(m.Height))
+	}
+	return n
+}
+
 func sovWal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1145,7 +1965,7 @@ func (m *EndHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WALMessage) Unmarshal(dAtA []byte) error {
+func (m *CheckpointMarker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1168,16 +1988,104 @@ func (m *WALMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WALMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointMarker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WALMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointMarker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventDataRoundState", wireType)
-			}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WALMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WALMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WALMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventDataRoundState", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -1314,6 +2222,41 @@ func (m *WALMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &WALMessage_EndHeight{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointMarker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CheckpointMarker{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &WALMessage_CheckpointMarker{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWal(dAtA[iNdEx:])
@@ -1454,6 +2397,737 @@ func (m *TimedWALMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Checkpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CommitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &types.Proposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartSets = append(m.PartSets, CheckpointPartSet{})
+			if err := m.PartSets[len(m.PartSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalBlockPartsHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalBlockPartsHeader == nil {
+				m.ProposalBlockPartsHeader = &types.PartSetHeader{}
+			}
+			if err := m.ProposalBlockPartsHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedRound", wireType)
+			}
+			m.LockedRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedRound |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedBlockPartsHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockedBlockPartsHeader == nil {
+				m.LockedBlockPartsHeader = &types.PartSetHeader{}
+			}
+			if err := m.LockedBlockPartsHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidRound", wireType)
+			}
+			m.ValidRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidRound |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidBlockPartsHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidBlockPartsHeader == nil {
+				m.ValidBlockPartsHeader = &types.PartSetHeader{}
+			}
+			if err := m.ValidBlockPartsHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, types.Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRound", wireType)
+			}
+			m.CommitRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitRound |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredTimeoutPrecommit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TriggeredTimeoutPrecommit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointPartSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointPartSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointPartSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parts = append(m.Parts, types.Part{})
+			if err := m.Parts[len(m.Parts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SoftwareVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SoftwareVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SoftwareVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
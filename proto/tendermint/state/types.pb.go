@@ -578,6 +578,112 @@ func (m *State) GetAppHash() []byte {
 	return nil
 }
 
+// CommitSignatures records how each validator of a height signed its commit,
+// as tracked by the validator liveness service.
+type CommitSignatures struct {
+	Height     int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Signatures []CommitSignature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *CommitSignatures) Reset()         { *m = CommitSignatures{} }
+func (m *CommitSignatures) String() string { return proto.CompactTextString(m) }
+func (*CommitSignatures) ProtoMessage()    {}
+func (*CommitSignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccfacf933f22bf93, []int{8}
+}
+func (m *CommitSignatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitSignatures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitSignatures.Merge(m, src)
+}
+func (m *CommitSignatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitSignatures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitSignatures proto.InternalMessageInfo
+
+func (m *CommitSignatures) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CommitSignatures) GetSignatures() []CommitSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+type CommitSignature struct {
+	ValidatorAddress []byte             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	BlockIdFlag      types1.BlockIDFlag `protobuf:"varint,2,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=tendermint.types.BlockIDFlag" json:"block_id_flag,omitempty"`
+}
+
+func (m *CommitSignature) Reset()         { *m = CommitSignature{} }
+func (m *CommitSignature) String() string { return proto.CompactTextString(m) }
+func (*CommitSignature) ProtoMessage()    {}
+func (*CommitSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccfacf933f22bf93, []int{9}
+}
+func (m *CommitSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitSignature.Merge(m, src)
+}
+func (m *CommitSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitSignature proto.InternalMessageInfo
+
+func (m *CommitSignature) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *CommitSignature) GetBlockIdFlag() types1.BlockIDFlag {
+	if m != nil {
+		return m.BlockIdFlag
+	}
+	return types1.BlockIDFlagUnknown
+}
+
 func init() {
 	proto.RegisterType((*LegacyABCIResponses)(nil), "tendermint.state.LegacyABCIResponses")
 	proto.RegisterType((*ResponseBeginBlock)(nil), "tendermint.state.ResponseBeginBlock")
@@ -587,73 +693,80 @@ func init() {
 	proto.RegisterType((*ABCIResponsesInfo)(nil), "tendermint.state.ABCIResponsesInfo")
 	proto.RegisterType((*Version)(nil), "tendermint.state.Version")
 	proto.RegisterType((*State)(nil), "tendermint.state.State")
+	proto.RegisterType((*CommitSignatures)(nil), "tendermint.state.CommitSignatures")
+	proto.RegisterType((*CommitSignature)(nil), "tendermint.state.CommitSignature")
 }
 
 func init() { proto.RegisterFile("tendermint/state/types.proto", fileDescriptor_ccfacf933f22bf93) }
 
 var fileDescriptor_ccfacf933f22bf93 = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xd6, 0xad, 0x7f, 0x3c, 0xc7, 0x89, 0x33, 0x26, 0xad, 0xeb, 0xb6, 0xb6, 0xb1, 0xda,
-	0x2a, 0x42, 0x68, 0x2d, 0xb5, 0x27, 0x2e, 0x45, 0xb1, 0x13, 0x88, 0xa5, 0x80, 0xd0, 0x26, 0x54,
-	0x2a, 0x87, 0xae, 0xc6, 0xbb, 0x63, 0x7b, 0x84, 0xbd, 0xbb, 0xda, 0x19, 0x1b, 0x87, 0x3b, 0x37,
-	0x0e, 0xbd, 0xf2, 0x1f, 0xf5, 0xd8, 0x23, 0x17, 0x02, 0x38, 0x12, 0x07, 0xfe, 0x0a, 0x34, 0x3f,
-	0xf6, 0x87, 0xbd, 0x41, 0x04, 0xe5, 0xe6, 0x9d, 0xf7, 0xbd, 0xef, 0x7d, 0xef, 0x9b, 0x79, 0x33,
-	0x86, 0xc7, 0x9c, 0x78, 0x2e, 0x09, 0x67, 0xd4, 0xe3, 0x5d, 0xc6, 0x31, 0x27, 0x5d, 0x7e, 0x11,
-	0x10, 0x66, 0x06, 0xa1, 0xcf, 0x7d, 0x54, 0x4d, 0xa2, 0xa6, 0x8c, 0x36, 0x3e, 0x1a, 0xfb, 0x63,
-	0x5f, 0x06, 0xbb, 0xe2, 0x97, 0xc2, 0x35, 0x5a, 0x63, 0xdf, 0x1f, 0x4f, 0x49, 0x57, 0x7e, 0x0d,
-	0xe7, 0xa3, 0x2e, 0xa7, 0x33, 0xc2, 0x38, 0x9e, 0x05, 0x1a, 0xf0, 0x28, 0x55, 0x06, 0x0f, 0x1d,
-	0x9a, 0xae, 0xd2, 0x78, 0x92, 0x0a, 0xca, 0xf5, 0x6e, 0x80, 0x43, 0x3c, 0x8b, 0xc2, 0x8f, 0x33,
-	0xe1, 0x74, 0x72, 0x3b, 0x13, 0x5d, 0xe0, 0x29, 0x75, 0x31, 0xf7, 0x43, 0x8d, 0x68, 0xa6, 0x10,
-	0x0b, 0x12, 0x32, 0xea, 0x7b, 0x69, 0x86, 0xce, 0x6f, 0x06, 0xd4, 0x4e, 0xc9, 0x18, 0x3b, 0x17,
-	0x87, 0xbd, 0xfe, 0xc0, 0x22, 0x2c, 0xf0, 0x3d, 0x46, 0x18, 0x7a, 0x05, 0x65, 0x97, 0x4c, 0xe9,
-	0x82, 0x84, 0x36, 0x5f, 0xb2, 0xba, 0xd1, 0xce, 0x1d, 0x94, 0x5f, 0x3c, 0x31, 0x53, 0x96, 0x88,
-	0x4e, 0xcc, 0xe3, 0x25, 0x71, 0xce, 0x97, 0x16, 0x61, 0xf3, 0x29, 0xb7, 0x40, 0x67, 0x9c, 0x2f,
-	0x19, 0xfa, 0x1c, 0x4a, 0xc4, 0x73, 0xed, 0xe1, 0xd4, 0x77, 0xbe, 0xaf, 0xdf, 0x69, 0x1b, 0x07,
-	0xe5, 0x17, 0x1d, 0x73, 0xd3, 0x50, 0x33, 0xaa, 0x77, 0xec, 0xb9, 0x3d, 0x81, 0xb4, 0x8a, 0x44,
-	0xff, 0x42, 0xc7, 0x50, 0x1e, 0x92, 0x31, 0xf5, 0x34, 0x45, 0x4e, 0x52, 0x3c, 0xfd, 0x77, 0x8a,
-	0x9e, 0x00, 0x2b, 0x12, 0x18, 0xc6, 0xbf, 0x3b, 0x6f, 0x01, 0x65, 0x11, 0xe8, 0x04, 0xf2, 0x64,
-	0x41, 0x3c, 0x1e, 0x35, 0x76, 0x3f, 0xdb, 0x98, 0x08, 0xf7, 0xea, 0xef, 0x2f, 0x5b, 0x5b, 0x7f,
-	0x5f, 0xb6, 0xaa, 0x0a, 0xfd, 0xa9, 0x3f, 0xa3, 0x9c, 0xcc, 0x02, 0x7e, 0x61, 0xe9, 0xfc, 0xce,
-	0xcf, 0x77, 0xa0, 0xba, 0xd9, 0x05, 0x3a, 0x83, 0xbd, 0x78, 0x1f, 0xec, 0x79, 0xe0, 0x62, 0x4e,
-	0xa2, 0x4a, 0xed, 0x4c, 0xa5, 0xd7, 0x11, 0xf2, 0x5b, 0x09, 0xec, 0xdd, 0x15, 0x35, 0xad, 0xea,
-	0x62, 0x7d, 0x99, 0xa1, 0x37, 0xf0, 0xc0, 0x11, 0x55, 0x3c, 0x36, 0x67, 0xb6, 0x3c, 0x23, 0x31,
-	0xb5, 0xf2, 0xf7, 0xe3, 0x34, 0xb5, 0xda, 0xe3, 0x7e, 0x94, 0xf0, 0x8d, 0x3c, 0x53, 0xd6, 0xbe,
-	0xb3, 0xb6, 0x10, 0x51, 0x27, 0x76, 0xe4, 0x6e, 0x69, 0xc7, 0x4f, 0x06, 0xec, 0xc4, 0x0d, 0xb1,
-	0x81, 0x37, 0xf2, 0x51, 0x1f, 0x2a, 0x89, 0x19, 0x8c, 0xf0, 0xba, 0x21, 0xd5, 0x36, 0xb3, 0x6a,
-	0xe3, 0xc4, 0x33, 0xc2, 0xad, 0xed, 0x45, 0xea, 0x0b, 0x99, 0x50, 0x9b, 0x62, 0xc6, 0xed, 0x09,
-	0xa1, 0xe3, 0x09, 0xb7, 0x9d, 0x09, 0xf6, 0xc6, 0xc4, 0x95, 0x8d, 0xe7, 0xac, 0x3d, 0x11, 0x3a,
-	0x91, 0x91, 0xbe, 0x0a, 0x74, 0x7e, 0x31, 0xa0, 0xb6, 0xd1, 0xbc, 0x14, 0x63, 0x41, 0x75, 0xc3,
-	0x44, 0xa6, 0xf5, 0xfc, 0xb7, 0x7b, 0x7a, 0x67, 0x76, 0xd7, 0x3d, 0x64, 0xff, 0x5b, 0xdb, 0x5f,
-	0x06, 0xec, 0xad, 0x0d, 0x9b, 0x54, 0xf6, 0x06, 0xf6, 0xa7, 0x72, 0x0e, 0x6d, 0x61, 0xb8, 0x1d,
-	0x46, 0x41, 0x2d, 0xef, 0x59, 0xf6, 0xe4, 0x5f, 0x33, 0xb6, 0x56, 0x4d, 0x71, 0x1c, 0x0e, 0x1d,
-	0x9a, 0xcc, 0xf2, 0x7d, 0xc8, 0x2b, 0x6d, 0x5a, 0x93, 0xfe, 0x42, 0x6f, 0xe1, 0x41, 0x54, 0xc6,
-	0x1e, 0x51, 0x0f, 0x4f, 0xe9, 0x8f, 0x64, 0x6d, 0xdc, 0x9e, 0x67, 0xce, 0x41, 0x44, 0xfa, 0x85,
-	0x86, 0xab, 0x81, 0xdb, 0x0f, 0xaf, 0x5b, 0xee, 0x4c, 0xa0, 0xf0, 0x5a, 0x5d, 0x39, 0xe8, 0x10,
-	0x4a, 0xb1, 0x6d, 0xba, 0xa3, 0xb5, 0xcb, 0x44, 0x5f, 0x4d, 0x89, 0xe5, 0xda, 0xec, 0x24, 0x0b,
-	0x35, 0xa0, 0xc8, 0xfc, 0x11, 0xff, 0x01, 0x87, 0x44, 0xf6, 0x51, 0xb2, 0xe2, 0xef, 0xce, 0x9f,
-	0x79, 0xb8, 0x77, 0x26, 0x4c, 0x41, 0x9f, 0x41, 0x41, 0x73, 0xe9, 0x32, 0x0f, 0xb3, 0xc6, 0x69,
-	0x51, 0xba, 0x44, 0x84, 0x47, 0xcf, 0xa1, 0xe8, 0x4c, 0x30, 0xf5, 0x6c, 0xaa, 0x36, 0xaf, 0xd4,
-	0x2b, 0xaf, 0x2e, 0x5b, 0x85, 0xbe, 0x58, 0x1b, 0x1c, 0x59, 0x05, 0x19, 0x1c, 0xb8, 0xe8, 0x19,
-	0xec, 0x50, 0x8f, 0x72, 0x8a, 0xa7, 0x7a, 0xcb, 0xeb, 0x3b, 0xd2, 0xd6, 0x8a, 0x5e, 0x55, 0xbb,
-	0x8d, 0x3e, 0x01, 0xb9, 0xf7, 0xca, 0xd0, 0x08, 0x99, 0x93, 0xc8, 0x5d, 0x11, 0x90, 0x1e, 0x69,
-	0xac, 0x05, 0x95, 0x14, 0x96, 0xba, 0xf5, 0xbb, 0x59, 0xed, 0xea, 0x4c, 0xca, 0xac, 0xc1, 0x51,
-	0xaf, 0x26, 0xb4, 0xaf, 0x2e, 0x5b, 0xe5, 0xd3, 0x88, 0x6a, 0x70, 0x64, 0x95, 0x63, 0xde, 0x81,
-	0x8b, 0x4e, 0x61, 0x37, 0xc5, 0x29, 0xde, 0xa4, 0xfa, 0x3d, 0xc9, 0xda, 0x30, 0xd5, 0x83, 0x65,
-	0x46, 0x0f, 0x96, 0x79, 0x1e, 0x3d, 0x58, 0xbd, 0xa2, 0xa0, 0x7d, 0xf7, 0x7b, 0xcb, 0xb0, 0x2a,
-	0x31, 0x97, 0x88, 0xa2, 0x2f, 0x61, 0xd7, 0x23, 0x4b, 0x6e, 0xc7, 0x53, 0xc9, 0xea, 0xf9, 0x1b,
-	0xcd, 0xf1, 0x8e, 0x48, 0x4b, 0xae, 0x04, 0xf4, 0x0a, 0x20, 0xc5, 0x51, 0xb8, 0x11, 0x47, 0x2a,
-	0x43, 0x08, 0x91, 0x6d, 0xa5, 0x48, 0x8a, 0x37, 0x13, 0x22, 0xd2, 0x52, 0x42, 0xfa, 0xd0, 0x4c,
-	0x8f, 0x6d, 0xc2, 0x17, 0x4f, 0x70, 0x49, 0x6e, 0xd6, 0xa3, 0x64, 0x82, 0x93, 0x6c, 0x3d, 0xcb,
-	0xd7, 0xde, 0x27, 0x70, 0xcb, 0xfb, 0xe4, 0x6b, 0x78, 0xba, 0x76, 0x9f, 0x6c, 0xf0, 0xc7, 0xf2,
-	0xca, 0x52, 0x5e, 0x3b, 0x75, 0xc1, 0xac, 0x13, 0x45, 0x1a, 0xa3, 0x83, 0x18, 0xca, 0x57, 0x9a,
-	0xd9, 0x13, 0xcc, 0x26, 0xf5, 0xed, 0xb6, 0x71, 0xb0, 0xad, 0x0e, 0xa2, 0x7a, 0xbd, 0xd9, 0x09,
-	0x66, 0x13, 0xf4, 0x10, 0x8a, 0x38, 0x08, 0x14, 0xa4, 0x22, 0x21, 0x05, 0x1c, 0x04, 0x22, 0xd4,
-	0xfb, 0xea, 0xfd, 0xaa, 0x69, 0x7c, 0x58, 0x35, 0x8d, 0x3f, 0x56, 0x4d, 0xe3, 0xdd, 0x55, 0x73,
-	0xeb, 0xc3, 0x55, 0x73, 0xeb, 0xd7, 0xab, 0xe6, 0xd6, 0x77, 0x2f, 0xc7, 0x94, 0x4f, 0xe6, 0x43,
-	0xd3, 0xf1, 0x67, 0x5d, 0xc7, 0x9f, 0x11, 0x3e, 0x1c, 0xf1, 0xe4, 0x87, 0xfa, 0xbf, 0xb4, 0xf9,
-	0x4f, 0x6b, 0x98, 0x97, 0xeb, 0x2f, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x11, 0x85, 0xd5, 0x91,
-	0x84, 0x09, 0x00, 0x00,
+	// 1052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x35, 0xa3, 0xc4, 0x92, 0x47, 0x96, 0x2d, 0xaf, 0xeb, 0x84, 0x71, 0x62, 0x49, 0x15, 0x92,
+	0xc0, 0x68, 0x0b, 0x0a, 0x70, 0x4e, 0xbd, 0xb4, 0xb0, 0x64, 0x27, 0x16, 0xe0, 0x16, 0x05, 0xed,
+	0x06, 0x48, 0x0f, 0x21, 0x56, 0xe4, 0x8a, 0x5a, 0x94, 0x22, 0x09, 0xee, 0x4a, 0xb5, 0x7b, 0xeb,
+	0xa1, 0xb7, 0x1e, 0x72, 0xed, 0x3f, 0xca, 0x31, 0xc7, 0x5e, 0xea, 0xb6, 0x32, 0xd0, 0x43, 0x7f,
+	0x45, 0xb1, 0x1f, 0xa4, 0x28, 0xd1, 0x05, 0x5c, 0xe4, 0xc6, 0xdd, 0x79, 0xf3, 0x66, 0xe6, 0xed,
+	0xce, 0x2c, 0xe1, 0x31, 0x27, 0xa1, 0x47, 0x92, 0x31, 0x0d, 0x79, 0x87, 0x71, 0xcc, 0x49, 0x87,
+	0x5f, 0xc6, 0x84, 0x59, 0x71, 0x12, 0xf1, 0x08, 0xd5, 0xe7, 0x56, 0x4b, 0x5a, 0x77, 0x3f, 0xf2,
+	0x23, 0x3f, 0x92, 0xc6, 0x8e, 0xf8, 0x52, 0xb8, 0xdd, 0xa6, 0x1f, 0x45, 0x7e, 0x40, 0x3a, 0x72,
+	0x35, 0x98, 0x0c, 0x3b, 0x9c, 0x8e, 0x09, 0xe3, 0x78, 0x1c, 0x6b, 0xc0, 0xa3, 0x5c, 0x18, 0x3c,
+	0x70, 0x69, 0x3e, 0xca, 0xee, 0x5e, 0xce, 0x28, 0xf7, 0x3b, 0x31, 0x4e, 0xf0, 0x38, 0x35, 0x3f,
+	0x2e, 0x98, 0xf3, 0xce, 0xad, 0x82, 0x75, 0x8a, 0x03, 0xea, 0x61, 0x1e, 0x25, 0x1a, 0xd1, 0xc8,
+	0x21, 0xa6, 0x24, 0x61, 0x34, 0x0a, 0xf3, 0x0c, 0xed, 0xdf, 0x0d, 0xd8, 0x3e, 0x25, 0x3e, 0x76,
+	0x2f, 0x0f, 0xbb, 0xbd, 0xbe, 0x4d, 0x58, 0x1c, 0x85, 0x8c, 0x30, 0xf4, 0x05, 0x54, 0x3d, 0x12,
+	0xd0, 0x29, 0x49, 0x1c, 0x7e, 0xc1, 0x4c, 0xa3, 0x55, 0xda, 0xaf, 0x1e, 0xec, 0x59, 0x39, 0x49,
+	0x44, 0x25, 0xd6, 0xf1, 0x05, 0x71, 0xcf, 0x2f, 0x6c, 0xc2, 0x26, 0x01, 0xb7, 0x41, 0x7b, 0x9c,
+	0x5f, 0x30, 0xf4, 0x25, 0xac, 0x91, 0xd0, 0x73, 0x06, 0x41, 0xe4, 0x7e, 0x6f, 0xde, 0x69, 0x19,
+	0xfb, 0xd5, 0x83, 0xb6, 0xb5, 0x2c, 0xa8, 0x95, 0xc6, 0x3b, 0x0e, 0xbd, 0xae, 0x40, 0xda, 0x15,
+	0xa2, 0xbf, 0xd0, 0x31, 0x54, 0x07, 0xc4, 0xa7, 0xa1, 0xa6, 0x28, 0x49, 0x8a, 0x27, 0xff, 0x4d,
+	0xd1, 0x15, 0x60, 0x45, 0x02, 0x83, 0xec, 0xbb, 0xfd, 0x06, 0x50, 0x11, 0x81, 0x4e, 0x60, 0x95,
+	0x4c, 0x49, 0xc8, 0xd3, 0xc2, 0xee, 0x17, 0x0b, 0x13, 0xe6, 0xae, 0xf9, 0xee, 0xaa, 0xb9, 0xf2,
+	0xcf, 0x55, 0xb3, 0xae, 0xd0, 0x9f, 0x45, 0x63, 0xca, 0xc9, 0x38, 0xe6, 0x97, 0xb6, 0xf6, 0x6f,
+	0xff, 0x72, 0x07, 0xea, 0xcb, 0x55, 0xa0, 0x33, 0xd8, 0xca, 0xce, 0xc1, 0x99, 0xc4, 0x1e, 0xe6,
+	0x24, 0x8d, 0xd4, 0x2a, 0x44, 0x7a, 0x95, 0x22, 0xbf, 0x95, 0xc0, 0xee, 0x5d, 0x11, 0xd3, 0xae,
+	0x4f, 0x17, 0xb7, 0x19, 0x7a, 0x0d, 0x0f, 0x5c, 0x11, 0x25, 0x64, 0x13, 0xe6, 0xc8, 0x3b, 0x92,
+	0x51, 0x2b, 0x7d, 0x3f, 0xce, 0x53, 0xab, 0x33, 0xee, 0xa5, 0x0e, 0xdf, 0x08, 0x3c, 0xb3, 0x77,
+	0xdc, 0x85, 0x8d, 0x94, 0x7a, 0x2e, 0x47, 0xe9, 0x03, 0xe5, 0xf8, 0xd9, 0x80, 0x8d, 0xac, 0x20,
+	0xd6, 0x0f, 0x87, 0x11, 0xea, 0x41, 0x6d, 0x2e, 0x06, 0x23, 0xdc, 0x34, 0x64, 0xb6, 0x8d, 0x62,
+	0xb6, 0x99, 0xe3, 0x19, 0xe1, 0xf6, 0xfa, 0x34, 0xb7, 0x42, 0x16, 0x6c, 0x07, 0x98, 0x71, 0x67,
+	0x44, 0xa8, 0x3f, 0xe2, 0x8e, 0x3b, 0xc2, 0xa1, 0x4f, 0x3c, 0x59, 0x78, 0xc9, 0xde, 0x12, 0xa6,
+	0x13, 0x69, 0xe9, 0x29, 0x43, 0xfb, 0x57, 0x03, 0xb6, 0x97, 0x8a, 0x97, 0xc9, 0xd8, 0x50, 0x5f,
+	0x12, 0x91, 0x99, 0xc6, 0x2d, 0xd5, 0xd3, 0x27, 0xb3, 0xb9, 0xa8, 0x21, 0xfb, 0xdf, 0xb9, 0xfd,
+	0x6d, 0xc0, 0xd6, 0x42, 0xb3, 0xc9, 0xcc, 0x5e, 0xc3, 0x4e, 0x20, 0xfb, 0xd0, 0x11, 0x82, 0x3b,
+	0x49, 0x6a, 0xd4, 0xe9, 0x3d, 0x2d, 0xde, 0xfc, 0x1b, 0xda, 0xd6, 0xde, 0x56, 0x1c, 0x87, 0x03,
+	0x97, 0xce, 0x7b, 0xf9, 0x3e, 0xac, 0xaa, 0xdc, 0x74, 0x4e, 0x7a, 0x85, 0xde, 0xc0, 0x83, 0x34,
+	0x8c, 0x33, 0xa4, 0x21, 0x0e, 0xe8, 0x8f, 0x64, 0xa1, 0xdd, 0x9e, 0x15, 0xee, 0x41, 0x4a, 0xfa,
+	0x42, 0xc3, 0x55, 0xc3, 0xed, 0x24, 0x37, 0x6d, 0xb7, 0x47, 0x50, 0x7e, 0xa5, 0x46, 0x0e, 0x3a,
+	0x84, 0xb5, 0x4c, 0x36, 0x5d, 0xd1, 0xc2, 0x30, 0xd1, 0xa3, 0x69, 0x2e, 0xb9, 0x16, 0x7b, 0xee,
+	0x85, 0x76, 0xa1, 0xc2, 0xa2, 0x21, 0xff, 0x01, 0x27, 0x44, 0xd6, 0xb1, 0x66, 0x67, 0xeb, 0xf6,
+	0x5f, 0xab, 0x70, 0xef, 0x4c, 0x88, 0x82, 0x3e, 0x87, 0xb2, 0xe6, 0xd2, 0x61, 0x1e, 0x16, 0x85,
+	0xd3, 0x49, 0xe9, 0x10, 0x29, 0x1e, 0x3d, 0x83, 0x8a, 0x3b, 0xc2, 0x34, 0x74, 0xa8, 0x3a, 0xbc,
+	0xb5, 0x6e, 0x75, 0x76, 0xd5, 0x2c, 0xf7, 0xc4, 0x5e, 0xff, 0xc8, 0x2e, 0x4b, 0x63, 0xdf, 0x43,
+	0x4f, 0x61, 0x83, 0x86, 0x94, 0x53, 0x1c, 0xe8, 0x23, 0x37, 0x37, 0xa4, 0xac, 0x35, 0xbd, 0xab,
+	0x4e, 0x1b, 0x7d, 0x02, 0xf2, 0xec, 0x95, 0xa0, 0x29, 0xb2, 0x24, 0x91, 0x9b, 0xc2, 0x20, 0x35,
+	0xd2, 0x58, 0x1b, 0x6a, 0x39, 0x2c, 0xf5, 0xcc, 0xbb, 0xc5, 0xdc, 0xd5, 0x9d, 0x94, 0x5e, 0xfd,
+	0xa3, 0xee, 0xb6, 0xc8, 0x7d, 0x76, 0xd5, 0xac, 0x9e, 0xa6, 0x54, 0xfd, 0x23, 0xbb, 0x9a, 0xf1,
+	0xf6, 0x3d, 0x74, 0x0a, 0x9b, 0x39, 0x4e, 0xf1, 0x26, 0x99, 0xf7, 0x24, 0xeb, 0xae, 0xa5, 0x1e,
+	0x2c, 0x2b, 0x7d, 0xb0, 0xac, 0xf3, 0xf4, 0xc1, 0xea, 0x56, 0x04, 0xed, 0xdb, 0x3f, 0x9a, 0x86,
+	0x5d, 0xcb, 0xb8, 0x84, 0x15, 0xbd, 0x84, 0xcd, 0x90, 0x5c, 0x70, 0x27, 0xeb, 0x4a, 0x66, 0xae,
+	0xde, 0xaa, 0x8f, 0x37, 0x84, 0x5b, 0xb6, 0x23, 0x1e, 0x16, 0xc8, 0x71, 0x94, 0x6f, 0xc5, 0x91,
+	0xf3, 0x10, 0x89, 0xc8, 0xb2, 0x72, 0x24, 0x95, 0xdb, 0x25, 0x22, 0xdc, 0x72, 0x89, 0xf4, 0xa0,
+	0x91, 0x6f, 0xdb, 0x39, 0x5f, 0xd6, 0xc1, 0x6b, 0xf2, 0xb0, 0x1e, 0xcd, 0x3b, 0x78, 0xee, 0xad,
+	0x7b, 0xf9, 0xc6, 0x79, 0x02, 0x1f, 0x38, 0x4f, 0xbe, 0x86, 0x27, 0x0b, 0xf3, 0x64, 0x89, 0x3f,
+	0x4b, 0xaf, 0x2a, 0xd3, 0x6b, 0xe5, 0x06, 0xcc, 0x22, 0x51, 0x9a, 0x63, 0x7a, 0x11, 0x13, 0xf9,
+	0x4a, 0x33, 0x67, 0x84, 0xd9, 0xc8, 0x5c, 0x6f, 0x19, 0xfb, 0xeb, 0xea, 0x22, 0xaa, 0xd7, 0x9b,
+	0x9d, 0x60, 0x36, 0x42, 0x0f, 0xa1, 0x82, 0xe3, 0x58, 0x41, 0x6a, 0x12, 0x52, 0xc6, 0x71, 0x2c,
+	0x4c, 0x6d, 0x06, 0xf5, 0x5e, 0x34, 0x1e, 0x53, 0x7e, 0x46, 0xfd, 0x10, 0xf3, 0x49, 0xb2, 0x30,
+	0x59, 0x8c, 0x85, 0xc9, 0xf2, 0x12, 0x80, 0x65, 0x28, 0xf3, 0x4e, 0xab, 0xb4, 0x2c, 0x88, 0x6a,
+	0xc4, 0x25, 0x3e, 0x2d, 0x48, 0xce, 0xb5, 0xfd, 0x93, 0x01, 0x9b, 0x4b, 0x28, 0xf4, 0x69, 0xfe,
+	0x75, 0xc5, 0x9e, 0x97, 0x10, 0xa6, 0x66, 0xca, 0x7a, 0xee, 0xd5, 0x3c, 0x54, 0xfb, 0xe8, 0x10,
+	0x6a, 0x69, 0x53, 0x39, 0xc3, 0x00, 0xfb, 0xb2, 0xb3, 0x37, 0x0e, 0xf6, 0x8a, 0xa7, 0xa3, 0x9b,
+	0xe8, 0x45, 0x80, 0x7d, 0xbb, 0x2a, 0x7d, 0xfa, 0x9e, 0x58, 0x74, 0xbf, 0x7a, 0x37, 0x6b, 0x18,
+	0xef, 0x67, 0x0d, 0xe3, 0xcf, 0x59, 0xc3, 0x78, 0x7b, 0xdd, 0x58, 0x79, 0x7f, 0xdd, 0x58, 0xf9,
+	0xed, 0xba, 0xb1, 0xf2, 0xdd, 0x73, 0x9f, 0xf2, 0xd1, 0x64, 0x60, 0xb9, 0xd1, 0xb8, 0xe3, 0x46,
+	0x63, 0xc2, 0x07, 0x43, 0x3e, 0xff, 0x50, 0x3f, 0x8a, 0xcb, 0xbf, 0x98, 0x83, 0x55, 0xb9, 0xff,
+	0xfc, 0xdf, 0x01, 0x00, 0x73, 0x9b, 0x07, 0xd8, 0x7d, 0x0a, 0x00, 0x00,
 }
 
 func (m *LegacyABCIResponses) Marshal() (dAtA []byte, err error) {
@@ -1125,6 +1238,83 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommitSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockIdFlag != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockIdFlag))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1317,6 +1507,40 @@ func (m *State) Size() (n int) {
 	return n
 }
 
+func (m *CommitSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommitSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BlockIdFlag != 0 {
+		n += 1 + sovTypes(uint64(m.BlockIdFlag))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2646,6 +2870,212 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommitSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, CommitSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockIdFlag", wireType)
+			}
+			m.BlockIdFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockIdFlag |= types1.BlockIDFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25,6 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type BlockStoreState struct {
 	Base   int64 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
 	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The lowest height whose extended commit may still be stored, above base
+	// if the extended commits are pruned independently of the blocks.
+	ExtendedCommitBase int64 `protobuf:"varint,3,opt,name=extended_commit_base,json=extendedCommitBase,proto3" json:"extended_commit_base,omitempty"`
 }
 
 func (m *BlockStoreState) Reset()         { *m = BlockStoreState{} }
@@ -74,6 +77,13 @@ func (m *BlockStoreState) GetHeight() int64 {
 	return 0
 }
 
+func (m *BlockStoreState) GetExtendedCommitBase() int64 {
+	if m != nil {
+		return m.ExtendedCommitBase
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BlockStoreState)(nil), "tendermint.store.BlockStoreState")
 }
@@ -81,18 +91,20 @@ func init() {
 func init() { proto.RegisterFile("tendermint/store/types.proto", fileDescriptor_ff9e53a0a74267f7) }
 
 var fileDescriptor_ff9e53a0a74267f7 = []byte{
-	// 171 bytes of a gzipped FileDescriptorProto
+	// 199 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x49, 0xcd, 0x4b,
 	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0xd5, 0x2f, 0xa9, 0x2c,
 	0x48, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x40, 0xc8, 0xea, 0x81, 0x65, 0x95,
-	0x6c, 0xb9, 0xf8, 0x9d, 0x72, 0xf2, 0x93, 0xb3, 0x83, 0x41, 0xbc, 0xe0, 0x92, 0xc4, 0x92, 0x54,
+	0xf2, 0xb9, 0xf8, 0x9d, 0x72, 0xf2, 0x93, 0xb3, 0x83, 0x41, 0xbc, 0xe0, 0x92, 0xc4, 0x92, 0x54,
 	0x21, 0x21, 0x2e, 0x96, 0xa4, 0xc4, 0xe2, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xe6, 0x20, 0x30,
 	0x5b, 0x48, 0x8c, 0x8b, 0x2d, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x09, 0x2c, 0x0a, 0xe5,
-	0x39, 0xf9, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x71, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x72, 0x7e, 0x6e, 0x6a, 0x49, 0x52, 0x5a,
-	0x09, 0x82, 0x01, 0x76, 0x8e, 0x3e, 0xba, 0x5b, 0x93, 0xd8, 0xc0, 0xe2, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb7, 0x2b, 0x34, 0x2a, 0xc6, 0x00, 0x00, 0x00,
+	0x09, 0x19, 0x70, 0x89, 0xa4, 0x56, 0x80, 0x0d, 0x4d, 0x89, 0x4f, 0xce, 0xcf, 0xcd, 0xcd, 0x2c,
+	0x89, 0x07, 0xeb, 0x65, 0x06, 0xab, 0x12, 0x82, 0xc9, 0x39, 0x83, 0xa5, 0x9c, 0x12, 0x8b, 0x53,
+	0x9d, 0x7c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
+	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x38, 0x3d, 0xb3,
+	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0x3f, 0x37, 0xb5, 0x24, 0x29, 0xad,
+	0x04, 0xc1, 0x00, 0x7b, 0x40, 0x1f, 0xdd, 0x77, 0x49, 0x6c, 0x60, 0x71, 0x63, 0xc0, 0x00, 0xd7,
+	0x90, 0xbe, 0x9b, 0xf8, 0x00, 0x00, 0x00,
 }
 
 func (m *BlockStoreState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +127,11 @@ func (m *BlockStoreState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExtendedCommitBase != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExtendedCommitBase))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Height != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 		i--
@@ -151,6 +168,9 @@ func (m *BlockStoreState) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovTypes(uint64(m.Height))
 	}
+	if m.ExtendedCommitBase != 0 {
+		n += 1 + sovTypes(uint64(m.ExtendedCommitBase))
+	}
 	return n
 }
 
@@ -227,6 +247,25 @@ func (m *BlockStoreState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommitBase", wireType)
+			}
+			m.ExtendedCommitBase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendedCommitBase |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -27,6 +27,36 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProposerSelection is the strategy selecting the proposer of each round among
+// the validators.
+type ProposerSelection int32
+
+const (
+	// The weighted round-robin of the proposer priorities of the validator set.
+	ProposerSelectionRoundRobin ProposerSelection = 0
+	// A validator drawn with a probability proportional to its voting power, from
+	// the hash of the previous block and the round.
+	ProposerSelectionHash ProposerSelection = 1
+)
+
+var ProposerSelection_name = map[int32]string{
+	0: "PROPOSER_SELECTION_ROUND_ROBIN",
+	1: "PROPOSER_SELECTION_HASH",
+}
+
+var ProposerSelection_value = map[string]int32{
+	"PROPOSER_SELECTION_ROUND_ROBIN": 0,
+	"PROPOSER_SELECTION_HASH":        1,
+}
+
+func (x ProposerSelection) String() string {
+	return proto.EnumName(ProposerSelection_name, int32(x))
+}
+
+func (ProposerSelection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{0}
+}
+
 // ConsensusParams contains consensus critical parameters that determine the
 // validity of blocks.
 type ConsensusParams struct {
@@ -36,6 +66,9 @@ type ConsensusParams struct {
 	Version   *VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 	Abci      *ABCIParams      `protobuf:"bytes,5,opt,name=abci,proto3" json:"abci,omitempty"`
 	Authority *AuthorityParams `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+	Timeout   *TimeoutParams   `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Feature   *FeatureParams   `protobuf:"bytes,8,opt,name=feature,proto3" json:"feature,omitempty"`
+	Proposer  *ProposerParams  `protobuf:"bytes,9,opt,name=proposer,proto3" json:"proposer,omitempty"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -113,6 +146,27 @@ func (m *ConsensusParams) GetAuthority() *AuthorityParams {
 	return nil
 }
 
+func (m *ConsensusParams) GetTimeout() *TimeoutParams {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetFeature() *FeatureParams {
+	if m != nil {
+		return m.Feature
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetProposer() *ProposerParams {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -490,7 +544,215 @@ func (m *AuthorityParams) GetAuthority() string {
 	return ""
 }
 
+// TimeoutParams configure the timeouts of the consensus rounds, which apply to
+// the validators from FeatureParams.timeout_params_enable_height.
+type TimeoutParams struct {
+	// How long validators wait for a proposal block before prevoting nil.
+	Propose time.Duration `protobuf:"bytes,1,opt,name=propose,proto3,stdduration" json:"propose"`
+	// How much the propose timeout increases with each round.
+	ProposeDelta time.Duration `protobuf:"bytes,2,opt,name=propose_delta,json=proposeDelta,proto3,stdduration" json:"propose_delta"`
+	// How long validators wait after receiving +2/3 prevotes for "anything"
+	// (ie. not a single block or nil).
+	Prevote time.Duration `protobuf:"bytes,3,opt,name=prevote,proto3,stdduration" json:"prevote"`
+	// How much the prevote timeout increases with each round.
+	PrevoteDelta time.Duration `protobuf:"bytes,4,opt,name=prevote_delta,json=prevoteDelta,proto3,stdduration" json:"prevote_delta"`
+	// How long validators wait after receiving +2/3 precommits for "anything"
+	// (ie. not a single block or nil).
+	Precommit time.Duration `protobuf:"bytes,5,opt,name=precommit,proto3,stdduration" json:"precommit"`
+	// How much the precommit timeout increases with each round.
+	PrecommitDelta time.Duration `protobuf:"bytes,6,opt,name=precommit_delta,json=precommitDelta,proto3,stdduration" json:"precommit_delta"`
+	// How long validators wait after committing a block before starting on the
+	// new height, to receive more precommits.
+	Commit time.Duration `protobuf:"bytes,7,opt,name=commit,proto3,stdduration" json:"commit"`
+	// Make progress as soon as all the precommits are received, as if the
+	// commit timeout was 0.
+	BypassCommitTimeout bool `protobuf:"varint,8,opt,name=bypass_commit_timeout,json=bypassCommitTimeout,proto3" json:"bypass_commit_timeout,omitempty"`
+}
+
+func (m *TimeoutParams) Reset()         { *m = TimeoutParams{} }
+func (m *TimeoutParams) String() string { return proto.CompactTextString(m) }
+func (*TimeoutParams) ProtoMessage()    {}
+func (*TimeoutParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{8}
+}
+func (m *TimeoutParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeoutParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeoutParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeoutParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeoutParams.Merge(m, src)
+}
+func (m *TimeoutParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeoutParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeoutParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeoutParams proto.InternalMessageInfo
+
+func (m *TimeoutParams) GetPropose() time.Duration {
+	if m != nil {
+		return m.Propose
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetProposeDelta() time.Duration {
+	if m != nil {
+		return m.ProposeDelta
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetPrevote() time.Duration {
+	if m != nil {
+		return m.Prevote
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetPrevoteDelta() time.Duration {
+	if m != nil {
+		return m.PrevoteDelta
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetPrecommit() time.Duration {
+	if m != nil {
+		return m.Precommit
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetPrecommitDelta() time.Duration {
+	if m != nil {
+		return m.PrecommitDelta
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetCommit() time.Duration {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetBypassCommitTimeout() bool {
+	if m != nil {
+		return m.BypassCommitTimeout
+	}
+	return false
+}
+
+// FeatureParams configure the heights from which consensus features are enabled.
+type FeatureParams struct {
+	// timeout_params_enable_height configures the first height from which the
+	// validators use the timeouts of TimeoutParams instead of the ones of their
+	// local configuration. 0 disables the feature.
+	TimeoutParamsEnableHeight int64 `protobuf:"varint,1,opt,name=timeout_params_enable_height,json=timeoutParamsEnableHeight,proto3" json:"timeout_params_enable_height,omitempty"`
+}
+
+func (m *FeatureParams) Reset()         { *m = FeatureParams{} }
+func (m *FeatureParams) String() string { return proto.CompactTextString(m) }
+func (*FeatureParams) ProtoMessage()    {}
+func (*FeatureParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{9}
+}
+func (m *FeatureParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeatureParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureParams.Merge(m, src)
+}
+func (m *FeatureParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureParams proto.InternalMessageInfo
+
+func (m *FeatureParams) GetTimeoutParamsEnableHeight() int64 {
+	if m != nil {
+		return m.TimeoutParamsEnableHeight
+	}
+	return 0
+}
+
+// ProposerParams configure how the proposer of each round is selected.
+type ProposerParams struct {
+	Selection ProposerSelection `protobuf:"varint,1,opt,name=selection,proto3,enum=tendermint.types.ProposerSelection" json:"selection,omitempty"`
+}
+
+func (m *ProposerParams) Reset()         { *m = ProposerParams{} }
+func (m *ProposerParams) String() string { return proto.CompactTextString(m) }
+func (*ProposerParams) ProtoMessage()    {}
+func (*ProposerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{10}
+}
+func (m *ProposerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerParams.Merge(m, src)
+}
+func (m *ProposerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerParams proto.InternalMessageInfo
+
+func (m *ProposerParams) GetSelection() ProposerSelection {
+	if m != nil {
+		return m.Selection
+	}
+	return ProposerSelectionRoundRobin
+}
+
 func init() {
+	proto.RegisterEnum("tendermint.types.ProposerSelection", ProposerSelection_name, ProposerSelection_value)
 	proto.RegisterType((*ConsensusParams)(nil), "tendermint.types.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "tendermint.types.BlockParams")
 	proto.RegisterType((*EvidenceParams)(nil), "tendermint.types.EvidenceParams")
@@ -499,51 +761,74 @@ func init() {
 	proto.RegisterType((*HashedParams)(nil), "tendermint.types.HashedParams")
 	proto.RegisterType((*ABCIParams)(nil), "tendermint.types.ABCIParams")
 	proto.RegisterType((*AuthorityParams)(nil), "tendermint.types.AuthorityParams")
+	proto.RegisterType((*TimeoutParams)(nil), "tendermint.types.TimeoutParams")
+	proto.RegisterType((*FeatureParams)(nil), "tendermint.types.FeatureParams")
+	proto.RegisterType((*ProposerParams)(nil), "tendermint.types.ProposerParams")
 }
 
 func init() { proto.RegisterFile("tendermint/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 614 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc7, 0xe3, 0x3a, 0x6d, 0x93, 0x93, 0x2f, 0x4d, 0x34, 0xfa, 0x24, 0x4c, 0x69, 0x9c, 0xe0,
-	0x05, 0xaa, 0x54, 0xc9, 0x46, 0x64, 0x05, 0x42, 0xaa, 0x92, 0x12, 0xb5, 0x05, 0x95, 0x8b, 0x85,
-	0x58, 0x74, 0x63, 0x8d, 0x93, 0xa9, 0x63, 0x35, 0xf6, 0x58, 0x9e, 0x71, 0x94, 0xbc, 0x05, 0x4b,
-	0x96, 0x5d, 0xc2, 0x1b, 0xf0, 0x08, 0x5d, 0x76, 0xc9, 0x0a, 0x50, 0xb2, 0x61, 0xcf, 0x0b, 0x20,
-	0x8f, 0xed, 0x38, 0x17, 0xd8, 0x8d, 0xe7, 0xfc, 0x7e, 0x73, 0xf9, 0xcf, 0x91, 0xa1, 0xc1, 0x89,
-	0x3f, 0x20, 0xa1, 0xe7, 0xfa, 0xdc, 0xe0, 0xd3, 0x80, 0x30, 0x23, 0xc0, 0x21, 0xf6, 0x98, 0x1e,
-	0x84, 0x94, 0x53, 0x54, 0xcf, 0xcb, 0xba, 0x28, 0xef, 0xff, 0xef, 0x50, 0x87, 0x8a, 0xa2, 0x11,
-	0x8f, 0x12, 0x6e, 0x5f, 0x75, 0x28, 0x75, 0x46, 0xc4, 0x10, 0x5f, 0x76, 0x74, 0x65, 0x0c, 0xa2,
-	0x10, 0x73, 0x97, 0xfa, 0x49, 0x5d, 0xfb, 0xbd, 0x05, 0xb5, 0x13, 0xea, 0x33, 0xe2, 0xb3, 0x88,
-	0xbd, 0x15, 0x3b, 0xa0, 0x36, 0x6c, 0xdb, 0x23, 0xda, 0xbf, 0x56, 0xa4, 0x96, 0x74, 0x58, 0x79,
-	0xd2, 0xd0, 0xd7, 0xf7, 0xd2, 0xbb, 0x71, 0x39, 0xa1, 0xcd, 0x84, 0x45, 0xcf, 0xa1, 0x44, 0xc6,
-	0xee, 0x80, 0xf8, 0x7d, 0xa2, 0x6c, 0x09, 0xaf, 0xb5, 0xe9, 0xf5, 0x52, 0x22, 0x55, 0x17, 0x06,
-	0x3a, 0x86, 0xf2, 0x18, 0x8f, 0xdc, 0x01, 0xe6, 0x34, 0x54, 0x64, 0xa1, 0x3f, 0xdc, 0xd4, 0x3f,
-	0x64, 0x48, 0xea, 0xe7, 0x0e, 0x7a, 0x0a, 0xbb, 0x63, 0x12, 0x32, 0x97, 0xfa, 0x4a, 0x51, 0xe8,
-	0xcd, 0xbf, 0xe8, 0x09, 0x90, 0xca, 0x19, 0x8f, 0x1e, 0x43, 0x11, 0xdb, 0x7d, 0x57, 0xd9, 0x16,
-	0xde, 0xc1, 0xa6, 0xd7, 0xe9, 0x9e, 0x9c, 0xa7, 0x92, 0x20, 0xe3, 0xd3, 0xe2, 0x88, 0x0f, 0x69,
-	0xe8, 0xf2, 0xa9, 0xb2, 0xf3, 0xaf, 0xd3, 0x76, 0x32, 0x24, 0x3b, 0xed, 0xc2, 0xd1, 0xce, 0xa1,
-	0xb2, 0x14, 0x21, 0x7a, 0x00, 0x65, 0x0f, 0x4f, 0x2c, 0x7b, 0xca, 0x09, 0x13, 0xa1, 0xcb, 0x66,
-	0xc9, 0xc3, 0x93, 0x6e, 0xfc, 0x8d, 0xee, 0xc1, 0x6e, 0x5c, 0x74, 0x30, 0x13, 0xb9, 0xca, 0xe6,
-	0x8e, 0x87, 0x27, 0xa7, 0x98, 0xbd, 0x2c, 0x96, 0xe4, 0x7a, 0x51, 0xfb, 0x22, 0xc1, 0xde, 0x6a,
-	0xac, 0xe8, 0x08, 0x50, 0x6c, 0x60, 0x87, 0x58, 0x7e, 0xe4, 0x59, 0xe2, 0x7d, 0xb2, 0x75, 0x6b,
-	0x1e, 0x9e, 0x74, 0x1c, 0xf2, 0x3a, 0xf2, 0xc4, 0x01, 0x18, 0xba, 0x80, 0x7a, 0x06, 0x67, 0xad,
-	0x91, 0xbe, 0xdf, 0x7d, 0x3d, 0xe9, 0x1d, 0x3d, 0xeb, 0x1d, 0xfd, 0x45, 0x0a, 0x74, 0x4b, 0xb7,
-	0xdf, 0x9b, 0x85, 0x4f, 0x3f, 0x9a, 0x92, 0xb9, 0x97, 0xac, 0x97, 0x55, 0x56, 0xaf, 0x22, 0xaf,
-	0x5e, 0x45, 0x3b, 0x86, 0xda, 0xda, 0x13, 0x22, 0x0d, 0xaa, 0x41, 0x64, 0x5b, 0xd7, 0x64, 0x6a,
-	0x89, 0xd4, 0x14, 0xa9, 0x25, 0x1f, 0x96, 0xcd, 0x4a, 0x10, 0xd9, 0xaf, 0xc8, 0xf4, 0x7d, 0x3c,
-	0xf5, 0xac, 0xf4, 0xf5, 0xa6, 0x29, 0xfd, 0xba, 0x69, 0x4a, 0xda, 0x11, 0x54, 0x57, 0x1e, 0x11,
-	0xd5, 0x41, 0xc6, 0x41, 0x20, 0xee, 0x56, 0x34, 0xe3, 0xe1, 0x12, 0x7c, 0x09, 0xff, 0x9d, 0x61,
-	0x36, 0x24, 0x83, 0x94, 0x7d, 0x04, 0x35, 0x11, 0x85, 0xb5, 0x9e, 0x75, 0x55, 0x4c, 0x5f, 0x64,
-	0x81, 0x6b, 0x50, 0xcd, 0xb9, 0x3c, 0xf6, 0x4a, 0x46, 0x9d, 0x62, 0xa6, 0xbd, 0x01, 0xc8, 0xbb,
-	0x02, 0x75, 0xa0, 0x31, 0xa6, 0x9c, 0x58, 0x64, 0xc2, 0x89, 0x1f, 0x9f, 0x8e, 0x59, 0xc4, 0xc7,
-	0xf6, 0x88, 0x58, 0x43, 0xe2, 0x3a, 0x43, 0x9e, 0xee, 0xb3, 0x1f, 0x43, 0xbd, 0x05, 0xd3, 0x13,
-	0xc8, 0x99, 0x20, 0x34, 0x03, 0x6a, 0x6b, 0xfd, 0x82, 0x0e, 0x96, 0xbb, 0x2c, 0x5e, 0xa1, 0xbc,
-	0xd4, 0x42, 0xdd, 0x77, 0x9f, 0x67, 0xaa, 0x74, 0x3b, 0x53, 0xa5, 0xbb, 0x99, 0x2a, 0xfd, 0x9c,
-	0xa9, 0xd2, 0xc7, 0xb9, 0x5a, 0xb8, 0x9b, 0xab, 0x85, 0x6f, 0x73, 0xb5, 0x70, 0xd9, 0x76, 0x5c,
-	0x3e, 0x8c, 0x6c, 0xbd, 0x4f, 0x3d, 0xa3, 0x4f, 0x3d, 0xc2, 0xed, 0x2b, 0x9e, 0x0f, 0x92, 0xbf,
-	0xc4, 0xfa, 0x0f, 0xc6, 0xde, 0x11, 0xf3, 0xed, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xf7,
-	0xad, 0x2a, 0x7b, 0x04, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x86, 0xc5, 0x4a, 0xd6, 0xe5, 0x38, 0xb2, 0xd4, 0x69, 0x83, 0xd0, 0x8a, 0x4d, 0xa9, 0x2c,
+	0x50, 0x04, 0x0d, 0x20, 0x15, 0x36, 0x50, 0xa0, 0x37, 0x18, 0x92, 0xac, 0x46, 0x6e, 0x13, 0x4b,
+	0xa5, 0xdc, 0x2e, 0xb2, 0x21, 0x86, 0xd2, 0x58, 0x22, 0x22, 0x72, 0x08, 0x72, 0x68, 0x48, 0x6f,
+	0x50, 0x64, 0xd5, 0x65, 0x51, 0x20, 0x40, 0x80, 0x14, 0x68, 0xfa, 0x06, 0x7d, 0x84, 0x2c, 0xb3,
+	0xec, 0xaa, 0x2d, 0xec, 0x4d, 0x1f, 0x23, 0x98, 0xe1, 0x50, 0x57, 0x0b, 0xb0, 0x77, 0x43, 0x9e,
+	0xff, 0x3b, 0xfc, 0x39, 0xf3, 0xeb, 0x88, 0xb0, 0xcf, 0x88, 0x3b, 0x20, 0xbe, 0x63, 0xbb, 0xac,
+	0xc6, 0xa6, 0x1e, 0x09, 0x6a, 0x1e, 0xf6, 0xb1, 0x13, 0x54, 0x3d, 0x9f, 0x32, 0x8a, 0x8a, 0xf3,
+	0x72, 0x55, 0x94, 0x4b, 0x1f, 0x0e, 0xe9, 0x90, 0x8a, 0x62, 0x8d, 0xaf, 0x22, 0x5d, 0x49, 0x1b,
+	0x52, 0x3a, 0x1c, 0x93, 0x9a, 0xb8, 0xb2, 0xc2, 0xf3, 0xda, 0x20, 0xf4, 0x31, 0xb3, 0xa9, 0x1b,
+	0xd5, 0xf5, 0x3f, 0x52, 0x50, 0x68, 0x52, 0x37, 0x20, 0x6e, 0x10, 0x06, 0x5d, 0xf1, 0x04, 0x74,
+	0x08, 0x5b, 0xd6, 0x98, 0xf6, 0x9f, 0xa9, 0x4a, 0x45, 0x79, 0xb0, 0x7d, 0xb0, 0x5f, 0x5d, 0x7d,
+	0x56, 0xb5, 0xc1, 0xcb, 0x91, 0xda, 0x88, 0xb4, 0xe8, 0x6b, 0xc8, 0x92, 0x0b, 0x7b, 0x40, 0xdc,
+	0x3e, 0x51, 0xdf, 0x13, 0x5c, 0x65, 0x9d, 0x6b, 0x49, 0x85, 0x44, 0x67, 0x04, 0x3a, 0x82, 0xdc,
+	0x05, 0x1e, 0xdb, 0x03, 0xcc, 0xa8, 0xaf, 0x26, 0x05, 0xfe, 0xd1, 0x3a, 0xfe, 0x53, 0x2c, 0x91,
+	0xfc, 0x9c, 0x41, 0x5f, 0x40, 0xe6, 0x82, 0xf8, 0x81, 0x4d, 0x5d, 0x35, 0x25, 0xf0, 0xf2, 0x35,
+	0x78, 0x24, 0x90, 0x70, 0xac, 0x47, 0x9f, 0x41, 0x0a, 0x5b, 0x7d, 0x5b, 0xdd, 0x12, 0xdc, 0xde,
+	0x3a, 0x57, 0x6f, 0x34, 0x4f, 0x24, 0x24, 0x94, 0xdc, 0x2d, 0x0e, 0xd9, 0x88, 0xfa, 0x36, 0x9b,
+	0xaa, 0xe9, 0x4d, 0x6e, 0xeb, 0xb1, 0x24, 0x76, 0x3b, 0x63, 0xb8, 0x5b, 0x66, 0x3b, 0x84, 0x86,
+	0x4c, 0xcd, 0x6c, 0x72, 0x7b, 0x16, 0x09, 0x62, 0xb7, 0x52, 0xcf, 0xd1, 0x73, 0x82, 0x59, 0xe8,
+	0x13, 0x35, 0xbb, 0x09, 0xfd, 0x36, 0x12, 0xc4, 0xa8, 0xd4, 0xf3, 0x23, 0xf2, 0x7c, 0xea, 0xd1,
+	0x80, 0xf8, 0x6a, 0x6e, 0xd3, 0x11, 0x75, 0xa5, 0x22, 0x3e, 0xa2, 0x98, 0xd0, 0x4f, 0x60, 0x7b,
+	0xe1, 0xd8, 0xd1, 0x7d, 0xc8, 0x39, 0x78, 0x62, 0x5a, 0x53, 0x46, 0x02, 0x11, 0x94, 0xa4, 0x91,
+	0x75, 0xf0, 0xa4, 0xc1, 0xaf, 0xd1, 0x3d, 0xc8, 0xf0, 0xe2, 0x10, 0x07, 0x22, 0x0b, 0x49, 0x23,
+	0xed, 0xe0, 0xc9, 0x23, 0x1c, 0x7c, 0x97, 0xca, 0x26, 0x8b, 0x29, 0xfd, 0x4f, 0x05, 0x76, 0x96,
+	0xa3, 0x80, 0x1e, 0x02, 0xe2, 0x04, 0x1e, 0x12, 0xd3, 0x0d, 0x1d, 0x53, 0x64, 0x2a, 0xee, 0x5b,
+	0x70, 0xf0, 0xa4, 0x3e, 0x24, 0xa7, 0xa1, 0x23, 0x0c, 0x04, 0xe8, 0x09, 0x14, 0x63, 0x71, 0x1c,
+	0x67, 0x99, 0xb9, 0xdd, 0x6a, 0x94, 0xf7, 0x6a, 0x9c, 0xf7, 0xea, 0xb1, 0x14, 0x34, 0xb2, 0x6f,
+	0xfe, 0x29, 0x27, 0x7e, 0xfd, 0xb7, 0xac, 0x18, 0x3b, 0x51, 0xbf, 0xb8, 0xb2, 0xfc, 0x2a, 0xc9,
+	0xe5, 0x57, 0xd1, 0x8f, 0xa0, 0xb0, 0x12, 0x3b, 0xa4, 0x43, 0xde, 0x0b, 0x2d, 0xf3, 0x19, 0x99,
+	0x9a, 0x62, 0xcf, 0x54, 0xa5, 0x92, 0x7c, 0x90, 0x33, 0xb6, 0xbd, 0xd0, 0xfa, 0x9e, 0x4c, 0xcf,
+	0xf8, 0xad, 0x2f, 0xb3, 0x7f, 0xbd, 0x2c, 0x2b, 0xff, 0xbf, 0x2c, 0x2b, 0xfa, 0x43, 0xc8, 0x2f,
+	0x05, 0x0f, 0x15, 0x21, 0x89, 0x3d, 0x4f, 0xbc, 0x5b, 0xca, 0xe0, 0xcb, 0x05, 0xf1, 0x53, 0xb8,
+	0xd3, 0xc6, 0xc1, 0x88, 0x0c, 0xa4, 0xf6, 0x13, 0x28, 0x88, 0xad, 0x30, 0x57, 0xf7, 0x3a, 0x2f,
+	0x6e, 0x3f, 0x89, 0x37, 0x5c, 0x87, 0xfc, 0x5c, 0x37, 0xdf, 0xf6, 0xed, 0x58, 0xf5, 0x08, 0x07,
+	0x7a, 0x07, 0x60, 0x9e, 0x64, 0x54, 0x87, 0xfd, 0x0b, 0xca, 0x88, 0x49, 0x26, 0x8c, 0xb8, 0xdc,
+	0x5d, 0x60, 0x12, 0x17, 0x5b, 0x63, 0x62, 0x8e, 0x88, 0x3d, 0x1c, 0x31, 0xf9, 0x9c, 0x12, 0x17,
+	0xb5, 0x66, 0x9a, 0x96, 0x90, 0xb4, 0x85, 0x42, 0xaf, 0x41, 0x61, 0x25, 0xe3, 0x68, 0x6f, 0xf1,
+	0x97, 0xc1, 0x3b, 0xe4, 0x16, 0x62, 0xaf, 0xbf, 0x4a, 0x41, 0x7e, 0x29, 0xd6, 0xe8, 0x1b, 0xc8,
+	0xc8, 0x80, 0xa9, 0xca, 0xcd, 0x0f, 0x30, 0x66, 0x50, 0x1b, 0xf2, 0x72, 0x69, 0x0e, 0xc8, 0x98,
+	0xe1, 0xdb, 0xa4, 0xe0, 0x8e, 0x24, 0x8f, 0x39, 0x18, 0x19, 0x21, 0xfc, 0x65, 0xd5, 0xe4, 0xcd,
+	0x7b, 0xc4, 0x4c, 0x64, 0x44, 0x2c, 0xa5, 0x91, 0xd4, 0xad, 0x8c, 0x08, 0x32, 0x32, 0x52, 0x87,
+	0x9c, 0xe7, 0x93, 0x3e, 0x75, 0x1c, 0x9b, 0xa9, 0x5b, 0x37, 0xef, 0x32, 0xa7, 0xd0, 0x63, 0x28,
+	0xcc, 0x2e, 0xa4, 0x9d, 0xf4, 0x2d, 0x7e, 0x1d, 0x33, 0x36, 0x32, 0xf4, 0x15, 0xa4, 0xa5, 0x9b,
+	0xcc, 0xcd, 0x9b, 0x48, 0x04, 0x1d, 0xc0, 0x5d, 0x6b, 0xea, 0xe1, 0x20, 0x30, 0xa5, 0x9d, 0x78,
+	0xec, 0xf1, 0xd9, 0x95, 0x35, 0x3e, 0x88, 0x8a, 0x4d, 0x51, 0x93, 0xc9, 0xd0, 0xbb, 0x90, 0x5f,
+	0x1a, 0x60, 0xe8, 0x08, 0xf6, 0x24, 0x66, 0x46, 0xff, 0x81, 0xd7, 0x26, 0x75, 0x97, 0x2d, 0x26,
+	0x6b, 0x29, 0xa8, 0x3d, 0xd8, 0x59, 0x1e, 0x6b, 0x7c, 0x97, 0x03, 0x32, 0x26, 0x7d, 0x31, 0x3a,
+	0x38, 0xbf, 0x73, 0xf0, 0xf1, 0xe6, 0x59, 0xd8, 0x8b, 0xa5, 0xc6, 0x9c, 0xfa, 0xf4, 0x37, 0x05,
+	0xde, 0x5f, 0x13, 0xa0, 0x26, 0x68, 0x5d, 0xa3, 0xd3, 0xed, 0xf4, 0x5a, 0x86, 0xd9, 0x6b, 0x3d,
+	0x6e, 0x35, 0xcf, 0x4e, 0x3a, 0xa7, 0xa6, 0xd1, 0xf9, 0xf1, 0xf4, 0xd8, 0x34, 0x3a, 0x8d, 0x93,
+	0xd3, 0x62, 0xa2, 0x54, 0x7e, 0xfe, 0xa2, 0x72, 0x7f, 0xbd, 0x37, 0x0d, 0xdd, 0x81, 0x41, 0x2d,
+	0xdb, 0x45, 0x9f, 0xc3, 0xbd, 0x6b, 0x9a, 0xb4, 0xeb, 0xbd, 0x76, 0x51, 0x29, 0xed, 0x3e, 0x7f,
+	0x51, 0xb9, 0xbb, 0x46, 0xf3, 0xa9, 0x51, 0xca, 0xfe, 0xfc, 0x4a, 0x4b, 0xbc, 0xfe, 0x5d, 0x53,
+	0x1a, 0x3f, 0xbc, 0xbe, 0xd4, 0x94, 0x37, 0x97, 0x9a, 0xf2, 0xf6, 0x52, 0x53, 0xfe, 0xbb, 0xd4,
+	0x94, 0x5f, 0xae, 0xb4, 0xc4, 0xdb, 0x2b, 0x2d, 0xf1, 0xf7, 0x95, 0x96, 0x78, 0x7a, 0x38, 0xb4,
+	0xd9, 0x28, 0xb4, 0xaa, 0x7d, 0xea, 0xd4, 0xfa, 0xd4, 0x21, 0xcc, 0x3a, 0x67, 0xf3, 0x45, 0xf4,
+	0x0d, 0xb1, 0xfa, 0xf9, 0x61, 0xa5, 0xc5, 0xfd, 0xc3, 0x77, 0x03, 0x00, 0x7e, 0xa1, 0xd9, 0x56,
+	0x99, 0x08, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -583,6 +868,15 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Authority.Equal(that1.Authority) {
 		return false
 	}
+	if !this.Timeout.Equal(that1.Timeout) {
+		return false
+	}
+	if !this.Feature.Equal(that1.Feature) {
+		return false
+	}
+	if !this.Proposer.Equal(that1.Proposer) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -770,6 +1064,99 @@ func (this *AuthorityParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TimeoutParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimeoutParams)
+	if !ok {
+		that2, ok := that.(TimeoutParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Propose != that1.Propose {
+		return false
+	}
+	if this.ProposeDelta != that1.ProposeDelta {
+		return false
+	}
+	if this.Prevote != that1.Prevote {
+		return false
+	}
+	if this.PrevoteDelta != that1.PrevoteDelta {
+		return false
+	}
+	if this.Precommit != that1.Precommit {
+		return false
+	}
+	if this.PrecommitDelta != that1.PrecommitDelta {
+		return false
+	}
+	if this.Commit != that1.Commit {
+		return false
+	}
+	if this.BypassCommitTimeout != that1.BypassCommitTimeout {
+		return false
+	}
+	return true
+}
+func (this *FeatureParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeatureParams)
+	if !ok {
+		that2, ok := that.(FeatureParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TimeoutParamsEnableHeight != that1.TimeoutParamsEnableHeight {
+		return false
+	}
+	return true
+}
+func (this *ProposerParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProposerParams)
+	if !ok {
+		that2, ok := that.(ProposerParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Selection != that1.Selection {
+		return false
+	}
+	return true
+}
 func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -790,6 +1177,42 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Proposer != nil {
+		{
+			size, err := m.Proposer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Feature != nil {
+		{
+			size, err := m.Feature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Authority != nil {
 		{
 			size, err := m.Authority.MarshalToSizedBuffer(dAtA[:i])
@@ -923,12 +1346,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err7 != nil {
-		return 0, err7
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n7
-	i = encodeVarintParams(dAtA, i, uint64(n7))
+	i -= n10
+	i = encodeVarintParams(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -1090,16 +1513,161 @@ func (m *AuthorityParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TimeoutParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *TimeoutParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeoutParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BypassCommitTimeout {
+		i--
+		if m.BypassCommitTimeout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Commit, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Commit):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintParams(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x3a
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PrecommitDelta, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PrecommitDelta):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintParams(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x32
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Precommit, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Precommit):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintParams(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PrevoteDelta, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PrevoteDelta):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintParams(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Prevote, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Prevote):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintParams(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x1a
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposeDelta, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposeDelta):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintParams(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x12
+	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Propose, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Propose):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintParams(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeatureParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutParamsEnableHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeoutParamsEnableHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Selection != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Selection))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func NewPopulatedValidatorParams(r randyParams, easy bool) *ValidatorParams {
 	this := &ValidatorParams{}
@@ -1223,6 +1791,18 @@ func (m *ConsensusParams) Size() (n int) {
 		l = m.Authority.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Feature != nil {
+		l = m.Feature.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Proposer != nil {
+		l = m.Proposer.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1325,6 +1905,56 @@ func (m *AuthorityParams) Size() (n int) {
 	return n
 }
 
+func (m *TimeoutParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Propose)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposeDelta)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Prevote)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PrevoteDelta)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Precommit)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PrecommitDelta)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Commit)
+	n += 1 + l + sovParams(uint64(l))
+	if m.BypassCommitTimeout {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeatureParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeoutParamsEnableHeight != 0 {
+		n += 1 + sovParams(uint64(m.TimeoutParamsEnableHeight))
+	}
+	return n
+}
+
+func (m *ProposerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Selection != 0 {
+		n += 1 + sovParams(uint64(m.Selection))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1576,6 +2206,114 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &TimeoutParams{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Feature == nil {
+				m.Feature = &FeatureParams{}
+			}
+			if err := m.Feature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposer == nil {
+				m.Proposer = &ProposerParams{}
+			}
+			if err := m.Proposer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2196,6 +2934,445 @@ func (m *AuthorityParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TimeoutParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeoutParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeoutParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Propose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Propose, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposeDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ProposeDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prevote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Prevote, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevoteDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PrevoteDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Precommit, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecommitDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PrecommitDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Commit, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassCommitTimeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BypassCommitTimeout = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeatureParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutParamsEnableHeight", wireType)
+			}
+			m.TimeoutParamsEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutParamsEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selection", wireType)
+			}
+			m.Selection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Selection |= ProposerSelection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
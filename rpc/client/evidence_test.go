@@ -126,6 +126,9 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		correct, fakes := makeEvidences(t, pv, chainID)
 		t.Logf("client %d", i)
 
+		before, err := c.Status(context.Background())
+		require.NoError(t, err)
+
 		result, err := c.BroadcastEvidence(context.Background(), correct)
 		require.NoError(t, err, "BroadcastEvidence(%s) failed", correct)
 		assert.Equal(t, correct.Hash(), result.Hash, "expected result hash to match evidence hash")
@@ -135,6 +138,15 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		err = client.WaitForHeight(c, status.SyncInfo.LatestBlockHeight+2, nil)
 		require.NoError(t, err)
 
+		committed, err := c.CommittedEvidence(context.Background(), before.SyncInfo.LatestBlockHeight, 0)
+		require.NoError(t, err)
+		require.Len(t, committed.Evidence, 1)
+		assert.Equal(t, correct.Hash(), committed.Evidence[0].Evidence.Hash())
+
+		pending, err := c.PendingEvidence(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Zero(t, pending.Total)
+
 		ed25519pub := pv.Key.PubKey.(ed25519.PubKey)
 		rawpub := ed25519pub.Bytes()
 		result2, err := c.ABCIQuery(context.Background(), "/val", rawpub)
@@ -348,6 +348,38 @@ func (c *baseRPCClient) ConsensusState(ctx context.Context) (*ctypes.ResultConse
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusTrace(
+	ctx context.Context,
+	height *int64,
+) (*ctypes.ResultConsensusTrace, error) {
+	result := new(ctypes.ResultConsensusTrace)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "consensus_trace", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) ValidatorStats(
+	ctx context.Context,
+	address bytes.HexBytes,
+) (*ctypes.ResultValidatorStats, error) {
+	result := new(ctypes.ResultValidatorStats)
+	params := make(map[string]any)
+	if len(address) > 0 {
+		params["address"] = address
+	}
+	_, err := c.caller.Call(ctx, "validator_stats", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusParams(
 	ctx context.Context,
 	height *int64,
@@ -485,6 +517,19 @@ func (c *baseRPCClient) Commit(ctx context.Context, height *int64) (*ctypes.Resu
 	return result, nil
 }
 
+func (c *baseRPCClient) ExtendedCommit(ctx context.Context, height *int64) (*ctypes.ResultExtendedCommit, error) {
+	result := new(ctypes.ResultExtendedCommit)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "extended_commit", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	result := new(ctypes.ResultTx)
 	params := map[string]any{
@@ -591,6 +636,37 @@ func (c *baseRPCClient) BroadcastEvidence(
 	return result, nil
 }
 
+func (c *baseRPCClient) PendingEvidence(
+	ctx context.Context,
+	limit *int,
+) (*ctypes.ResultPendingEvidence, error) {
+	result := new(ctypes.ResultPendingEvidence)
+	params := make(map[string]any)
+	if limit != nil {
+		params["limit"] = limit
+	}
+	_, err := c.caller.Call(ctx, "pending_evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) CommittedEvidence(
+	ctx context.Context,
+	minHeight,
+	maxHeight int64,
+) (*ctypes.ResultCommittedEvidence, error) {
+	result := new(ctypes.ResultCommittedEvidence)
+	_, err := c.caller.Call(ctx, "committed_evidence",
+		map[string]any{"minHeight": minHeight, "maxHeight": maxHeight},
+		result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 //-----------------------------------------------------------------------------
 // WSEvents
 
@@ -70,6 +70,7 @@ type SignClient interface {
 	Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error)
 	HeaderByHash(ctx context.Context, hash bytes.HexBytes) (*ctypes.ResultHeader, error)
 	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
+	ExtendedCommit(ctx context.Context, height *int64) (*ctypes.ResultExtendedCommit, error)
 	Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error)
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
@@ -111,6 +112,8 @@ type NetworkClient interface {
 	NetInfo(context.Context) (*ctypes.ResultNetInfo, error)
 	DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(context.Context) (*ctypes.ResultConsensusState, error)
+	ConsensusTrace(ctx context.Context, height *int64) (*ctypes.ResultConsensusTrace, error)
+	ValidatorStats(ctx context.Context, address bytes.HexBytes) (*ctypes.ResultValidatorStats, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 	Health(context.Context) (*ctypes.ResultHealth, error)
 }
@@ -140,9 +143,11 @@ type MempoolClient interface {
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behavior.
+// behavior and querying pending and committed evidence.
 type EvidenceClient interface {
 	BroadcastEvidence(context.Context, types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence(ctx context.Context, limit *int) (*ctypes.ResultPendingEvidence, error)
+	CommittedEvidence(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultCommittedEvidence, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
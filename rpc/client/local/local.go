@@ -122,6 +122,14 @@ func (c *Local) ConsensusState(context.Context) (*ctypes.ResultConsensusState, e
 	return c.env.GetConsensusState(c.ctx)
 }
 
+func (c *Local) ConsensusTrace(_ context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.env.ConsensusTrace(c.ctx, height)
+}
+
+func (c *Local) ValidatorStats(_ context.Context, address bytes.HexBytes) (*ctypes.ResultValidatorStats, error) {
+	return c.env.ValidatorStats(c.ctx, address)
+}
+
 func (c *Local) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(c.ctx, height)
 }
@@ -180,6 +188,10 @@ func (c *Local) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit,
 	return c.env.Commit(c.ctx, height)
 }
 
+func (c *Local) ExtendedCommit(_ context.Context, height *int64) (*ctypes.ResultExtendedCommit, error) {
+	return c.env.ExtendedCommit(c.ctx, height)
+}
+
 func (c *Local) Validators(_ context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
 	return c.env.Validators(c.ctx, height, page, perPage)
 }
@@ -212,6 +224,14 @@ func (c *Local) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes
 	return c.env.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(_ context.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(c.ctx, limit)
+}
+
+func (c *Local) CommittedEvidence(_ context.Context, minHeight, maxHeight int64) (*ctypes.ResultCommittedEvidence, error) {
+	return c.env.CommittedEvidence(c.ctx, minHeight, maxHeight)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
@@ -132,6 +132,14 @@ func (c Client) DumpConsensusState(_ context.Context) (*ctypes.ResultDumpConsens
 	return c.env.DumpConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) ConsensusTrace(_ context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.env.ConsensusTrace(&rpctypes.Context{}, height)
+}
+
+func (c Client) ValidatorStats(_ context.Context, address bytes.HexBytes) (*ctypes.ResultValidatorStats, error) {
+	return c.env.ValidatorStats(&rpctypes.Context{}, address)
+}
+
 func (c Client) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
@@ -174,6 +182,10 @@ func (c Client) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit,
 	return c.env.Commit(&rpctypes.Context{}, height)
 }
 
+func (c Client) ExtendedCommit(_ context.Context, height *int64) (*ctypes.ResultExtendedCommit, error) {
+	return c.env.ExtendedCommit(&rpctypes.Context{}, height)
+}
+
 func (c Client) Validators(_ context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
 	return c.env.Validators(&rpctypes.Context{}, height, page, perPage)
 }
@@ -181,3 +193,11 @@ func (c Client) Validators(_ context.Context, height *int64, page, perPage *int)
 func (c Client) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.env.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
+
+func (c Client) PendingEvidence(_ context.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(&rpctypes.Context{}, limit)
+}
+
+func (c Client) CommittedEvidence(_ context.Context, minHeight, maxHeight int64) (*ctypes.ResultCommittedEvidence, error) {
+	return c.env.CommittedEvidence(&rpctypes.Context{}, minHeight, maxHeight)
+}
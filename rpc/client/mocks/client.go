@@ -344,6 +344,29 @@ func (_m *Client) Commit(ctx context.Context, height *int64) (*coretypes.ResultC
 	return r0, r1
 }
 
+// CommittedEvidence provides a mock function with given fields: ctx, minHeight, maxHeight
+func (_m *Client) CommittedEvidence(ctx context.Context, minHeight int64, maxHeight int64) (*coretypes.ResultCommittedEvidence, error) {
+	ret := _m.Called(ctx, minHeight, maxHeight)
+
+	var r0 *coretypes.ResultCommittedEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *coretypes.ResultCommittedEvidence); ok {
+		r0 = rf(ctx, minHeight, maxHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultCommittedEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, minHeight, maxHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConsensusParams provides a mock function with given fields: ctx, height
 func (_m *Client) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
 	ret := _m.Called(ctx, height)
@@ -390,6 +413,29 @@ func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensu
 	return r0, r1
 }
 
+// ConsensusTrace provides a mock function with given fields: ctx, height
+func (_m *Client) ConsensusTrace(ctx context.Context, height *int64) (*coretypes.ResultConsensusTrace, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultConsensusTrace
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultConsensusTrace); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultConsensusTrace)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DumpConsensusState provides a mock function with given fields: _a0
 func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -413,6 +459,29 @@ func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDump
 	return r0, r1
 }
 
+// ExtendedCommit provides a mock function with given fields: ctx, height
+func (_m *Client) ExtendedCommit(ctx context.Context, height *int64) (*coretypes.ResultExtendedCommit, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultExtendedCommit
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultExtendedCommit); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultExtendedCommit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Genesis provides a mock function with given fields: _a0
 func (_m *Client) Genesis(_a0 context.Context) (*coretypes.ResultGenesis, error) {
 	ret := _m.Called(_a0)
@@ -621,6 +690,29 @@ func (_m *Client) OnStop() {
 	_m.Called()
 }
 
+// PendingEvidence provides a mock function with given fields: ctx, limit
+func (_m *Client) PendingEvidence(ctx context.Context, limit *int) (*coretypes.ResultPendingEvidence, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 *coretypes.ResultPendingEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, *int) *coretypes.ResultPendingEvidence); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultPendingEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Quit provides a mock function with given fields:
 func (_m *Client) Quit() <-chan struct{} {
 	ret := _m.Called()
@@ -797,7 +889,30 @@ func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *
 	return r0, r1
 }
 
-// UnconfirmedTxs provides a mock function with given fields: ctx, limit
+// ValidatorStats provides a mock function with given fields: ctx, address
+func (_m *Client) ValidatorStats(ctx context.Context, address bytes.HexBytes) (*coretypes.ResultValidatorStats, error) {
+	ret := _m.Called(ctx, address)
+
+	var r0 *coretypes.ResultValidatorStats
+	if rf, ok := ret.Get(0).(func(context.Context, bytes.HexBytes) *coretypes.ResultValidatorStats); ok {
+		r0 = rf(ctx, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultValidatorStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, bytes.HexBytes) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Validators provides a mock function with given fields: ctx, limit
 func (_m *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
 	ret := _m.Called(ctx, limit)
 
@@ -152,6 +152,43 @@ func TestConsensusState(t *testing.T) {
 	}
 }
 
+func TestConsensusTrace(t *testing.T) {
+	for i, c := range GetClients() {
+		nc, ok := c.(client.NetworkClient)
+		require.True(t, ok, "%d", i)
+		cons, err := nc.ConsensusTrace(context.Background(), nil)
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.Contains(t, string(cons.Trace), `"events":`)
+
+		height := int64(0)
+		_, err = nc.ConsensusTrace(context.Background(), &height)
+		require.Error(t, err, "%d", i)
+	}
+}
+
+func TestValidatorStats(t *testing.T) {
+	for i, c := range GetClients() {
+		nc, ok := c.(client.NetworkClient)
+		require.True(t, ok, "%d", i)
+		require.NoError(t, client.WaitForHeight(c, 3, nil))
+
+		res, err := nc.ValidatorStats(context.Background(), nil)
+		require.Nil(t, err, "%d: %+v", i, err)
+		require.Len(t, res.Validators, 1, "%d", i)
+		stats := res.Validators[0]
+		require.NotEmpty(t, stats.Windows, "%d", i)
+		assert.Positive(t, stats.Windows[0].Signed, "%d", i)
+
+		res, err = nc.ValidatorStats(context.Background(), stats.Address)
+		require.Nil(t, err, "%d: %+v", i, err)
+		require.Len(t, res.Validators, 1, "%d", i)
+		assert.Equal(t, stats.Address, res.Validators[0].Address)
+
+		_, err = nc.ValidatorStats(context.Background(), []byte("unknown"))
+		require.Error(t, err, "%d", i)
+	}
+}
+
 func TestHealth(t *testing.T) {
 	for i, c := range GetClients() {
 		nc, ok := c.(client.NetworkClient)
@@ -335,6 +372,21 @@ func TestAppCalls(t *testing.T) {
 	}
 }
 
+func TestExtendedCommit(t *testing.T) {
+	for i, c := range GetClients() {
+		require.NoError(t, client.WaitForHeight(c, 1, nil))
+
+		// vote extensions are disabled in the test genesis
+		h := int64(1)
+		_, err := c.ExtendedCommit(context.Background(), &h)
+		require.ErrorContains(t, err, "no extended commit", "%d", i)
+
+		h = 0
+		_, err = c.ExtendedCommit(context.Background(), &h)
+		require.Error(t, err, "%d", i)
+	}
+}
+
 func TestBroadcastTxSync(t *testing.T) {
 	require := require.New(t)
 
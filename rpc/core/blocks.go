@@ -174,6 +174,23 @@ func (env *Environment) Commit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.R
 	return ctypes.NewResultCommit(&header, commit, true), nil
 }
 
+// ExtendedCommit gets the extended commit of a given height, with the vote
+// extensions of its precommits and their signatures.
+// If no height is provided, it will fetch the extended commit of the latest
+// block.
+func (env *Environment) ExtendedCommit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultExtendedCommit, error) {
+	height, err := env.getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	extCommit := env.BlockStore.LoadBlockExtendedCommit(height)
+	if extCommit == nil {
+		return nil, fmt.Errorf("no extended commit for height %d: vote extensions were disabled or it was pruned", height)
+	}
+	return &ctypes.ResultExtendedCommit{ExtendedCommit: extCommit}, nil
+}
+
 // BlockResults gets ABCIResults at a given height.
 // If no height is provided, it will fetch results for the latest block.
 //
@@ -1,13 +1,16 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 
 	cm "github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/libs/bytes"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	"github.com/cometbft/cometbft/p2p"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/liveness"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -53,6 +56,27 @@ func (env *Environment) Validators(
 	}, nil
 }
 
+// ValidatorStats gets the signed, missed and absent precommits of the
+// validators over the windows of heights tracked by the liveness service.
+//
+// If no address is provided, it will fetch the stats of every validator in
+// the validator set of any height of the largest window.
+func (env *Environment) ValidatorStats(_ *rpctypes.Context, address bytes.HexBytes) (*ctypes.ResultValidatorStats, error) {
+	if env.Liveness == nil {
+		return nil, errors.New("validator stats are disabled, see validator_stats.enabled")
+	}
+
+	height := env.Liveness.Height()
+	if len(address) == 0 {
+		return &ctypes.ResultValidatorStats{Height: height, Validators: env.Liveness.Stats()}, nil
+	}
+	vs, ok := env.Liveness.ValidatorStats(address)
+	if !ok {
+		return nil, fmt.Errorf("validator %X isn't tracked", address)
+	}
+	return &ctypes.ResultValidatorStats{Height: height, Validators: []liveness.ValidatorStats{vs}}, nil
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/dump_consensus_state
@@ -101,6 +125,26 @@ func (env *Environment) GetConsensusState(*rpctypes.Context) (*ctypes.ResultCons
 	return &ctypes.ResultConsensusState{RoundState: bz}, err
 }
 
+// ConsensusTrace returns the timeline of the consensus events of the given
+// height: step transitions, arrivals of the proposal, block parts and votes,
+// timeouts and ABCI call durations. If no height is provided, it returns the
+// timeline of the current height. Only the last heights are recorded.
+// UNSTABLE
+func (env *Environment) ConsensusTrace(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultConsensusTrace, error) {
+	height := env.ConsensusState.GetLastHeight() + 1
+	if heightPtr != nil {
+		height = *heightPtr
+	}
+	if height <= 0 {
+		return nil, fmt.Errorf("height must be greater than 0, but got %d", height)
+	}
+	bz, err := env.ConsensusState.GetHeightTraceJSON(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultConsensusTrace{Trace: bz}, nil
+}
+
 // ConsensusParams gets the consensus parameters at the given block height.
 // If no height is provided, it will fetch the latest consensus params.
 // More: https://docs.cometbft.com/v0.38/spec/rpc/#consensusparams
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	cfg "github.com/cometbft/cometbft/config"
+	cm "github.com/cometbft/cometbft/consensus"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/evidence"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	mempl "github.com/cometbft/cometbft/mempool"
@@ -14,6 +16,7 @@ import (
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/liveness"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
@@ -41,6 +44,8 @@ type Consensus interface {
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
+	GetHeightTraceJSON(height int64) ([]byte, error)
+	GetHalt() *cm.Halt
 }
 
 type transport interface {
@@ -57,6 +62,13 @@ type peers interface {
 	Peers() p2p.IPeerSet
 }
 
+type evidencePool interface {
+	AddEvidence(types.Evidence) error
+	PendingEvidence(maxBytes int64) ([]types.Evidence, int64)
+	CommittedEvidence(minHeight, maxHeight int64) ([]evidence.CommittedEvidence, error)
+	Size() uint32
+}
+
 // A reactor that transitions from block sync or state sync to consensus mode.
 type syncReactor interface {
 	WaitSync() bool
@@ -73,7 +85,7 @@ type Environment struct {
 	// interfaces defined in types and above
 	StateStore       sm.Store
 	BlockStore       sm.BlockStore
-	EvidencePool     sm.EvidencePool
+	EvidencePool     evidencePool
 	ConsensusState   Consensus
 	ConsensusReactor syncReactor
 	MempoolReactor   syncReactor
@@ -89,8 +101,10 @@ type Environment struct {
 	GenDoc       *types.GenesisDoc // cache the genesis structure
 	TxIndexer    txindex.TxIndexer
 	BlockIndexer indexer.BlockIndexer
-	EventBus     *types.EventBus // thread safe
+	Reindexer    *txindex.Reindexer // nil if indexing is disabled
+	EventBus     *types.EventBus    // thread safe
 	Mempool      mempl.Mempool
+	Liveness     *liveness.Tracker // nil if the validator stats are disabled
 
 	Logger log.Logger
 
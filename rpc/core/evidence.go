@@ -28,3 +28,75 @@ func (env *Environment) BroadcastEvidence(
 	}
 	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// PendingEvidence gets the evidence that has been verified by the node but
+// not yet committed (maximum ?limit entries) including its number.
+func (env *Environment) PendingEvidence(
+	_ *rpctypes.Context,
+	limitPtr *int,
+) (*ctypes.ResultPendingEvidence, error) {
+	// reuse per_page validator
+	limit := env.validatePerPage(limitPtr)
+
+	evList, totalBytes := env.EvidencePool.PendingEvidence(-1)
+	total := len(evList)
+	if len(evList) > limit {
+		evList = evList[:limit]
+	}
+
+	return &ctypes.ResultPendingEvidence{
+		Count:      len(evList),
+		Total:      total,
+		TotalBytes: totalBytes,
+		Evidence:   evList,
+	}, nil
+}
+
+// CommittedEvidence gets the evidence committed in blocks with
+// minHeight <= height <= maxHeight.
+//
+// If maxHeight does not yet exist, evidence up to the current height will be
+// returned. If minHeight does not exist (due to pruning), earliest existing
+// height will be used.
+//
+// At most 1000 heights are scanned. Evidence is returned in ascending order
+// of the height it was committed at.
+//
+// Evidence committed before the node was upgraded to a version indexing
+// evidence by commit height is not returned; it can still be found in the
+// blocks themselves.
+func (env *Environment) CommittedEvidence(
+	_ *rpctypes.Context,
+	minHeight, maxHeight int64,
+) (*ctypes.ResultCommittedEvidence, error) {
+	const limit int64 = 1000
+	var err error
+	minHeight, maxHeight, err = filterMinMax(
+		env.BlockStore.Base(),
+		env.BlockStore.Height(),
+		minHeight,
+		maxHeight,
+		limit)
+	if err != nil {
+		return nil, err
+	}
+
+	committed, err := env.EvidencePool.CommittedEvidence(minHeight, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	evidence := make([]*ctypes.CommittedEvidence, 0, len(committed))
+	for _, ev := range committed {
+		evidence = append(evidence, &ctypes.CommittedEvidence{
+			Height:   ev.Height,
+			Evidence: ev.Evidence,
+		})
+	}
+
+	return &ctypes.ResultCommittedEvidence{
+		MinHeight: minHeight,
+		MaxHeight: maxHeight,
+		Evidence:  evidence,
+	}, nil
+}
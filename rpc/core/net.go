@@ -34,11 +34,17 @@ func (env *Environment) NetInfo(*rpctypes.Context) (*ctypes.ResultNetInfo, error
 	// TODO: Should we include PersistentPeers and Seeds in here?
 	// PRO: useful info
 	// CON: privacy
+	var bannedPeers []p2p.BannedPeer
+	if banner, ok := env.P2PPeers.(p2p.PeerBanner); ok {
+		bannedPeers = banner.BannedPeers()
+	}
+
 	return &ctypes.ResultNetInfo{
-		Listening: env.P2PTransport.IsListening(),
-		Listeners: env.P2PTransport.Listeners(),
-		NPeers:    len(peers),
-		Peers:     peers,
+		Listening:   env.P2PTransport.IsListening(),
+		Listeners:   env.P2PTransport.Listeners(),
+		NPeers:      len(peers),
+		Peers:       peers,
+		BannedPeers: bannedPeers,
 	}, nil
 }
 
@@ -98,6 +104,28 @@ func (env *Environment) UnsafeDialPeers(
 	return &ctypes.ResultDialPeers{Log: "Dialing peers in progress. See /net_info for details"}, nil
 }
 
+// UnsafeUnbanPeer lifts the ban of the given peer.
+// Only supported by switches that ban misbehaving peers (libp2p).
+func (env *Environment) UnsafeUnbanPeer(_ *rpctypes.Context, peerID string) (*ctypes.ResultUnbanPeer, error) {
+	banner, ok := env.P2PPeers.(p2p.PeerBanner)
+	if !ok {
+		return &ctypes.ResultUnbanPeer{}, errors.New("peer banning is not supported by the p2p layer")
+	}
+
+	if peerID == "" {
+		return &ctypes.ResultUnbanPeer{}, errors.New("no peer id provided")
+	}
+
+	env.Logger.Info("UnbanPeer", "peer_id", peerID)
+
+	unbanned, err := banner.UnbanPeer(p2p.ID(peerID))
+	if err != nil {
+		return &ctypes.ResultUnbanPeer{}, err
+	}
+
+	return &ctypes.ResultUnbanPeer{Unbanned: unbanned}, nil
+}
+
 // Genesis returns genesis file.
 // More: https://docs.cometbft.com/v0.38/spec/rpc/#genesis
 func (env *Environment) Genesis(*rpctypes.Context) (*ctypes.ResultGenesis, error) {
@@ -134,6 +162,15 @@ func getIDs(peers []string) ([]string, error) {
 	ids := make([]string, 0, len(peers))
 
 	for _, peer := range peers {
+		// libp2p multiaddr, e.g. "/ip4/1.2.3.4/udp/26656/quic-v1/p2p/<id>"
+		if strings.HasPrefix(peer, "/") {
+			i := strings.LastIndex(peer, "/p2p/")
+			if i < 0 {
+				return nil, p2p.ErrNetAddressNoID{Addr: peer}
+			}
+			ids = append(ids, peer[i+len("/p2p/"):])
+			continue
+		}
 
 		spl := strings.Split(peer, "@")
 		if len(spl) != 2 {
@@ -87,3 +87,57 @@ func TestUnsafeDialPeers(t *testing.T) {
 		}
 	}
 }
+
+type banningPeers struct {
+	peers
+	banned map[p2p.ID]bool
+}
+
+func (b *banningPeers) BannedPeers() []p2p.BannedPeer { return nil }
+
+func (b *banningPeers) UnbanPeer(id p2p.ID) (bool, error) {
+	unbanned := b.banned[id]
+	delete(b.banned, id)
+	return unbanned, nil
+}
+
+func TestUnsafeUnbanPeer(t *testing.T) {
+	const peerID = "12D3KooWJx9i4sCpXHhrm6NcX2QhmPWxc7Bys3cS7BFyMW3bHmuD"
+
+	env := &Environment{}
+	env.Logger = log.TestingLogger()
+
+	// the legacy switch doesn't ban peers
+	env.P2PPeers = p2p.MakeSwitch(cfg.DefaultP2PConfig(), 1,
+		func(n int, sw *p2p.Switch) *p2p.Switch { return sw })
+
+	_, err := env.UnsafeUnbanPeer(&rpctypes.Context{}, peerID)
+	require.ErrorContains(t, err, "not supported")
+
+	env.P2PPeers = &banningPeers{banned: map[p2p.ID]bool{peerID: true}}
+
+	_, err = env.UnsafeUnbanPeer(&rpctypes.Context{}, "")
+	require.Error(t, err)
+
+	res, err := env.UnsafeUnbanPeer(&rpctypes.Context{}, peerID)
+	require.NoError(t, err)
+	assert.True(t, res.Unbanned)
+
+	res, err = env.UnsafeUnbanPeer(&rpctypes.Context{}, peerID)
+	require.NoError(t, err)
+	assert.False(t, res.Unbanned)
+}
+
+func TestGetIDs(t *testing.T) {
+	const lp2pID = "12D3KooWJx9i4sCpXHhrm6NcX2QhmPWxc7Bys3cS7BFyMW3bHmuD"
+
+	ids, err := getIDs([]string{
+		"d51fb70907db1c6c2d5237e78379b25cf1a37ab4@127.0.0.1:41198",
+		"/ip4/127.0.0.1/udp/26656/quic-v1/p2p/" + lp2pID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4", lp2pID}, ids)
+
+	_, err = getIDs([]string{"/ip4/127.0.0.1/udp/26656/quic-v1"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"errors"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/txindex"
+)
+
+// UnsafeReindex starts reindexing, in the background, the block and
+// transaction events of the heights from startHeight to endHeight
+// (inclusive) into the configured indexer. If startHeight or endHeight is 0,
+// they default to the base and current height of the block store.
+// Only one reindexing job can run at a time.
+func (env *Environment) UnsafeReindex(
+	_ *rpctypes.Context,
+	startHeight, endHeight int64,
+) (*ctypes.ResultReindexStatus, error) {
+	if env.Reindexer == nil {
+		return nil, errors.New("indexing is disabled")
+	}
+	status, err := env.Reindexer.Start(startHeight, endHeight)
+	if err != nil {
+		return nil, err
+	}
+	return resultReindexStatus(status), nil
+}
+
+// UnsafeReindexStatus returns the progress of the running reindexing job, or
+// the outcome of the last one.
+func (env *Environment) UnsafeReindexStatus(*rpctypes.Context) (*ctypes.ResultReindexStatus, error) {
+	if env.Reindexer == nil {
+		return nil, errors.New("indexing is disabled")
+	}
+	return resultReindexStatus(env.Reindexer.Status()), nil
+}
+
+// UnsafeReindexCancel cancels the running reindexing job and returns its
+// final status.
+func (env *Environment) UnsafeReindexCancel(*rpctypes.Context) (*ctypes.ResultReindexStatus, error) {
+	if env.Reindexer == nil {
+		return nil, errors.New("indexing is disabled")
+	}
+	status, err := env.Reindexer.Cancel()
+	if err != nil {
+		return nil, err
+	}
+	return resultReindexStatus(status), nil
+}
+
+func resultReindexStatus(s txindex.ReindexStatus) *ctypes.ResultReindexStatus {
+	return &ctypes.ResultReindexStatus{
+		Running:     s.Running,
+		StartHeight: s.StartHeight,
+		EndHeight:   s.EndHeight,
+		LastHeight:  s.LastHeight,
+		StartedAt:   s.StartedAt,
+		FinishedAt:  s.FinishedAt,
+		Cancelled:   s.Cancelled,
+		Error:       s.Error,
+	}
+}
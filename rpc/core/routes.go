@@ -27,6 +27,7 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"block_by_hash":        rpc.NewRPCFunc(env.BlockByHash, "hash", rpc.Cacheable()),
 		"block_results":        rpc.NewRPCFunc(env.BlockResults, "height", rpc.Cacheable("height")),
 		"commit":               rpc.NewRPCFunc(env.Commit, "height", rpc.Cacheable("height")),
+		"extended_commit":      rpc.NewRPCFunc(env.ExtendedCommit, "height"),
 		"header":               rpc.NewRPCFunc(env.Header, "height", rpc.Cacheable("height")),
 		"header_by_hash":       rpc.NewRPCFunc(env.HeaderByHash, "hash", rpc.Cacheable()),
 		"check_tx":             rpc.NewRPCFunc(env.CheckTx, "tx"),
@@ -34,8 +35,10 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"tx_search":            rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
 		"block_search":         rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
 		"validators":           rpc.NewRPCFunc(env.Validators, "height,page,per_page", rpc.Cacheable("height")),
+		"validator_stats":      rpc.NewRPCFunc(env.ValidatorStats, "address"),
 		"dump_consensus_state": rpc.NewRPCFunc(env.DumpConsensusState, ""),
 		"consensus_state":      rpc.NewRPCFunc(env.GetConsensusState, ""),
+		"consensus_trace":      rpc.NewRPCFunc(env.ConsensusTrace, "height"),
 		"consensus_params":     rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
 		"unconfirmed_txs":      rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
 		"num_unconfirmed_txs":  rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
@@ -51,6 +54,8 @@ func (env *Environment) GetRoutes() RoutesMap {
 
 		// evidence API
 		"broadcast_evidence": rpc.NewRPCFunc(env.BroadcastEvidence, "evidence"),
+		"pending_evidence":   rpc.NewRPCFunc(env.PendingEvidence, "limit"),
+		"committed_evidence": rpc.NewRPCFunc(env.CommittedEvidence, "minHeight,maxHeight"),
 	}
 }
 
@@ -59,5 +64,9 @@ func (env *Environment) AddUnsafeRoutes(routes RoutesMap) {
 	// control API
 	routes["dial_seeds"] = rpc.NewRPCFunc(env.UnsafeDialSeeds, "seeds")
 	routes["dial_peers"] = rpc.NewRPCFunc(env.UnsafeDialPeers, "peers,persistent,unconditional,private")
+	routes["unban_peer"] = rpc.NewRPCFunc(env.UnsafeUnbanPeer, "peer_id")
 	routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(env.UnsafeFlushMempool, "")
+	routes["unsafe_reindex"] = rpc.NewRPCFunc(env.UnsafeReindex, "start_height,end_height")
+	routes["unsafe_reindex_status"] = rpc.NewRPCFunc(env.UnsafeReindexStatus, "")
+	routes["unsafe_reindex_cancel"] = rpc.NewRPCFunc(env.UnsafeReindexCancel, "")
 }
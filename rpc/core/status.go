@@ -53,6 +53,12 @@ func (env *Environment) Status(*rpctypes.Context) (*ctypes.ResultStatus, error)
 
 	catchingUp := env.ConsensusReactor.WaitSync()
 
+	var haltReason string
+	halt := env.ConsensusState.GetHalt()
+	if halt != nil {
+		haltReason = halt.String()
+	}
+
 	return &ctypes.ResultStatus{
 		NodeInfo: env.P2PTransport.NodeInfo().(p2p.DefaultNodeInfo),
 		SyncInfo: ctypes.SyncInfo{
@@ -65,6 +71,8 @@ func (env *Environment) Status(*rpctypes.Context) (*ctypes.ResultStatus, error)
 			EarliestBlockHeight: earliestBlockHeight,
 			EarliestBlockTime:   time.Unix(0, earliestBlockTimeNano),
 			CatchingUp:          catchingUp,
+			Halted:              halt != nil,
+			HaltReason:          haltReason,
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
 			Address:     env.PubKey.Address(),
@@ -9,6 +9,7 @@ import (
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/p2p"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/state/liveness"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -50,6 +51,12 @@ type ResultCommit struct {
 	CanonicalCommit    bool `json:"canonical"`
 }
 
+// ResultExtendedCommit is the extended commit of a height, with its vote
+// extensions and their signatures
+type ResultExtendedCommit struct {
+	ExtendedCommit *types.ExtendedCommit `json:"extended_commit"`
+}
+
 // ABCI results from a block
 type ResultBlockResults struct {
 	Height                int64                     `json:"height"`
@@ -87,6 +94,10 @@ type SyncInfo struct {
 	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
 
 	CatchingUp bool `json:"catching_up"`
+
+	// set if consensus halted after the latest block, e.g. for an upgrade
+	Halted     bool   `json:"halted"`
+	HaltReason string `json:"halt_reason,omitempty"`
 }
 
 // Info about the node's validator
@@ -113,10 +124,11 @@ func (s *ResultStatus) TxIndexEnabled() bool {
 
 // Info about peer connections
 type ResultNetInfo struct {
-	Listening bool     `json:"listening"`
-	Listeners []string `json:"listeners"`
-	NPeers    int      `json:"n_peers"`
-	Peers     []Peer   `json:"peers"`
+	Listening   bool             `json:"listening"`
+	Listeners   []string         `json:"listeners"`
+	NPeers      int              `json:"n_peers"`
+	Peers       []Peer           `json:"peers"`
+	BannedPeers []p2p.BannedPeer `json:"banned_peers,omitempty"`
 }
 
 // Log from dialing seeds
@@ -129,6 +141,11 @@ type ResultDialPeers struct {
 	Log string `json:"log"`
 }
 
+// Result of unbanning a peer. Unbanned is false if the peer was not banned.
+type ResultUnbanPeer struct {
+	Unbanned bool `json:"unbanned"`
+}
+
 // A peer
 type Peer struct {
 	NodeInfo         p2p.DefaultNodeInfo  `json:"node_info"`
@@ -147,6 +164,13 @@ type ResultValidators struct {
 	Total int `json:"total"`
 }
 
+// Signed, missed and absent precommits of the validators over the windows of
+// heights ending at Height, the latest height tracked
+type ResultValidatorStats struct {
+	Height     int64                     `json:"height"`
+	Validators []liveness.ValidatorStats `json:"validators"`
+}
+
 // ConsensusParams for given height
 type ResultConsensusParams struct {
 	BlockHeight     int64                 `json:"block_height"`
@@ -171,6 +195,12 @@ type ResultConsensusState struct {
 	RoundState json.RawMessage `json:"round_state"`
 }
 
+// Timeline of the consensus events of a height.
+// UNSTABLE
+type ResultConsensusTrace struct {
+	Trace json.RawMessage `json:"trace"`
+}
+
 // CheckTx result
 type ResultBroadcastTx struct {
 	Code      uint32         `json:"code"`
@@ -239,6 +269,39 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// List of pending evidence
+type ResultPendingEvidence struct {
+	Count      int              `json:"n_evidence"`
+	Total      int              `json:"total"`
+	TotalBytes int64            `json:"total_bytes"`
+	Evidence   []types.Evidence `json:"evidence"`
+}
+
+// Evidence committed within a range of heights
+type ResultCommittedEvidence struct {
+	MinHeight int64                `json:"min_height"`
+	MaxHeight int64                `json:"max_height"`
+	Evidence  []*CommittedEvidence `json:"evidence"`
+}
+
+// CommittedEvidence is evidence and the height of the block it was committed in
+type CommittedEvidence struct {
+	Height   int64          `json:"height"`
+	Evidence types.Evidence `json:"evidence"`
+}
+
+// Progress of an event reindexing job
+type ResultReindexStatus struct {
+	Running     bool      `json:"running"`
+	StartHeight int64     `json:"start_height"`
+	EndHeight   int64     `json:"end_height"`
+	LastHeight  int64     `json:"last_height"`
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at"`
+	Cancelled   bool      `json:"cancelled"`
+	Error       string    `json:"error,omitempty"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
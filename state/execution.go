@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -44,6 +45,9 @@ type BlockExecutor struct {
 	// 1-element cache of validated blocks
 	lastValidatedBlock atomic.Pointer[types.Block]
 
+	// the latest upgrade required by the application, see GetUpgrade
+	lastUpgrade atomic.Pointer[appliedUpgrade]
+
 	// 1-element cache: validator set loaded for the current block cycle.
 	// The stored ValidatorSet is read-only; callers must not mutate it.
 	lastLoadedValidators atomic.Pointer[cachedValidators]
@@ -54,6 +58,25 @@ type BlockExecutor struct {
 
 	// blockTimeTolerance is the maximum allowed difference between proposed block time and wall clock.
 	blockTimeTolerance time.Duration
+
+	// number of most recent heights whose extended commits are kept, 0 to keep
+	// them as long as their blocks
+	extCommitRetainBlocks int64
+
+	// the wall clock, see SetClock
+	now func() time.Time
+
+	// verified signatures, shared with consensus and blocksync; may be nil
+	signatureCache *types.SignatureCache
+
+	// the last optimistic execution, see ExecuteBlockOptimistically
+	optimisticMtx sync.Mutex
+	optimistic    *optimisticExecution
+}
+
+type appliedUpgrade struct {
+	height  int64
+	upgrade *abci.Upgrade
 }
 
 type cachedValidators struct {
@@ -75,6 +98,24 @@ func BlockExecutorWithBlockTimeTolerance(d time.Duration) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithExtendedCommitRetainBlocks prunes the extended commits
+// older than the n most recent heights after each block. 0 keeps them as long as
+// their blocks.
+func BlockExecutorWithExtendedCommitRetainBlocks(n int64) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.extCommitRetainBlocks = n
+	}
+}
+
+// BlockExecutorWithSignatureCache sets the cache of verified signatures used
+// to verify the LastCommit of blocks. It is also available to the users of the
+// executor through SignatureCache.
+func BlockExecutorWithSignatureCache(cache *types.SignatureCache) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.signatureCache = cache
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -108,12 +149,24 @@ func (blockExec *BlockExecutor) Store() Store {
 	return blockExec.store
 }
 
+// SignatureCache returns the cache of verified signatures, nil if none was
+// set with BlockExecutorWithSignatureCache.
+func (blockExec *BlockExecutor) SignatureCache() *types.SignatureCache {
+	return blockExec.signatureCache
+}
+
 // SetEventBus - sets the event bus for publishing block related events.
 // If not called, it defaults to types.NopEventBus.
 func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher) {
 	blockExec.eventBus = eventBus
 }
 
+// SetClock sets the wall clock the time of proposed blocks is validated
+// against. If not called, it defaults to time.Now.
+func (blockExec *BlockExecutor) SetClock(now func() time.Time) {
+	blockExec.now = now
+}
+
 // CreateProposalBlock calls state.MakeBlock with evidence from the evpool
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // The block space is first allocated to outstanding evidence.
@@ -203,8 +256,20 @@ func (blockExec *BlockExecutor) ProcessProposal(
 	if resp.IsStatusUnknown() {
 		panic(fmt.Sprintf("ProcessProposal responded with status %s", resp.Status.String()))
 	}
+	if !resp.IsAccepted() {
+		return false, nil
+	}
 
-	return resp.IsAccepted(), nil
+	for _, ev := range block.Evidence.Evidence {
+		if err := blockExec.eventBus.PublishEventEvidenceProposed(types.EventDataNewEvidence{
+			Evidence: ev,
+			Height:   block.Height,
+		}); err != nil {
+			blockExec.logger.Error("failed publishing proposed evidence", "err", err)
+		}
+	}
+
+	return true, nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -233,8 +298,8 @@ func (blockExec *BlockExecutor) validateBlockAndCheckEvidence(state State, block
 
 	// safe to call with nil
 	if !lastValidated.HashesTo(block.Hash()) || block.Height != expectedHeight {
-		// always use blocktime tolerance set on the struct
-		if err := validateBlock(state, block, append(opts, blockExec.withBlockTimeTolerance)...); err != nil {
+		// always use the blocktime tolerance and signature cache set on the struct
+		if err := validateBlock(state, block, append(opts, blockExec.withExecutorOptions)...); err != nil {
 			return err
 		}
 		blockExec.setLastValidatedBlock(lastValidated, block)
@@ -243,8 +308,10 @@ func (blockExec *BlockExecutor) validateBlockAndCheckEvidence(state State, block
 	return blockExec.evpool.CheckEvidence(block.Evidence.Evidence)
 }
 
-func (blockExec *BlockExecutor) withBlockTimeTolerance(opts *blockValidationOptions) {
+func (blockExec *BlockExecutor) withExecutorOptions(opts *blockValidationOptions) {
 	opts.blockTimeTolerance = blockExec.blockTimeTolerance
+	opts.now = blockExec.now
+	opts.signatureCache = blockExec.signatureCache
 }
 
 func withSkipLastCommit(opts *blockValidationOptions) {
@@ -276,7 +343,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 
 	// safe to call with nil
 	if !lastValidated.HashesTo(block.Hash()) || block.Height != expectedHeight {
-		if err := validateBlock(state, block, blockExec.withBlockTimeTolerance); err != nil {
+		if err := validateBlock(state, block, blockExec.withExecutorOptions); err != nil {
 			return state, ErrInvalidBlock(err)
 		}
 		blockExec.setLastValidatedBlock(lastValidated, block)
@@ -286,19 +353,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 }
 
 func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, block *types.Block) (State, error) {
-	startTime := time.Now().UnixNano()
-	abciResponse, err := blockExec.proxyApp.FinalizeBlock(context.TODO(), &abci.RequestFinalizeBlock{
-		Hash:               block.Hash(),
-		NextValidatorsHash: block.NextValidatorsHash,
-		ProposerAddress:    block.ProposerAddress,
-		Height:             block.Height,
-		Time:               block.Time,
-		DecidedLastCommit:  blockExec.buildLastCommitInfo(block, state.InitialHeight),
-		Misbehavior:        block.Evidence.Evidence.ToABCI(),
-		Txs:                block.Txs.ToSliceOfBytes(),
-	})
-	endTime := time.Now().UnixNano()
-	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
+	abciResponse, err := blockExec.finalizeBlock(state, blockID, block)
 	if err != nil {
 		blockExec.logger.Error("error in proxyAppConn.FinalizeBlock", "err", err)
 		return state, err
@@ -346,6 +401,11 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 		blockExec.metrics.ConsensusParamUpdates.Add(1)
 	}
 
+	if abciResponse.Upgrade != nil {
+		blockExec.logger.Info("application requires an upgrade",
+			"height", block.Height, "name", abciResponse.Upgrade.Name, "info", abciResponse.Upgrade.Info)
+	}
+
 	// Update the state with the block and responses.
 	state, err = updateState(state, blockID, &block.Header, abciResponse, validatorUpdates)
 	if err != nil {
@@ -368,6 +428,9 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 	if err := blockExec.store.Save(state); err != nil {
 		return state, err
 	}
+	if abciResponse.Upgrade != nil {
+		blockExec.lastUpgrade.Store(&appliedUpgrade{height: block.Height, upgrade: abciResponse.Upgrade})
+	}
 
 	fail.Fail() // XXX
 
@@ -380,6 +443,14 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 			blockExec.logger.Debug("pruned blocks", "pruned", pruned, "retain_height", retainHeight)
 		}
 	}
+	if extRetainHeight := block.Height - blockExec.extCommitRetainBlocks + 1; blockExec.extCommitRetainBlocks > 0 && extRetainHeight > 0 {
+		pruned, err := blockExec.blockStore.PruneExtendedCommits(extRetainHeight)
+		if err != nil {
+			blockExec.logger.Error("failed to prune extended commits", "retain_height", extRetainHeight, "err", err)
+		} else if pruned > 0 {
+			blockExec.logger.Debug("pruned extended commits", "pruned", pruned, "retain_height", extRetainHeight)
+		}
+	}
 
 	// Events are fired after everything else.
 	// NOTE: if we crash between Commit and Save, events wont be fired during replay
@@ -388,6 +459,40 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 	return state, nil
 }
 
+// finalizeBlock executes the block against the app, unless it was executed
+// optimistically.
+func (blockExec *BlockExecutor) finalizeBlock(
+	state State, blockID types.BlockID, block *types.Block,
+) (*abci.ResponseFinalizeBlock, error) {
+	if abciResponse := blockExec.optimisticExecutionResponse(blockID, block.Height); abciResponse != nil {
+		return abciResponse, nil
+	}
+	return blockExec.execFinalizeBlock(context.TODO(), blockExec.finalizeBlockRequest(state, block))
+}
+
+func (blockExec *BlockExecutor) finalizeBlockRequest(state State, block *types.Block) *abci.RequestFinalizeBlock {
+	return &abci.RequestFinalizeBlock{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  blockExec.buildLastCommitInfo(block, state.InitialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+	}
+}
+
+func (blockExec *BlockExecutor) execFinalizeBlock(
+	ctx context.Context, req *abci.RequestFinalizeBlock,
+) (*abci.ResponseFinalizeBlock, error) {
+	startTime := time.Now().UnixNano()
+	abciResponse, err := blockExec.proxyApp.FinalizeBlock(ctx, req)
+	endTime := time.Now().UnixNano()
+	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
+	return abciResponse, err
+}
+
 func (blockExec *BlockExecutor) ExtendVote(
 	ctx context.Context,
 	vote *types.Vote,
@@ -521,6 +626,15 @@ func (blockExec *BlockExecutor) GetLastValidatedBlock() *types.Block {
 	return blockExec.lastValidatedBlock.Load()
 }
 
+// GetUpgrade returns the upgrade the application required after the block of
+// the given height, or nil. Only the latest upgrade is kept.
+func (blockExec *BlockExecutor) GetUpgrade(height int64) *abci.Upgrade {
+	if last := blockExec.lastUpgrade.Load(); last != nil && last.height == height {
+		return last.upgrade
+	}
+	return nil
+}
+
 func (blockExec *BlockExecutor) setLastValidatedBlock(old, new *types.Block) {
 	switch {
 	case old == nil:
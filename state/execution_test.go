@@ -1,6 +1,7 @@
 package state_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
@@ -80,10 +81,123 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+func TestApplyBlockPrunesExtendedCommits(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+	err := proxyApp.Start()
+	require.NoError(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).Return(nil)
+
+	// only the extended commit of the latest height is retained
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("PruneExtendedCommits", int64(1)).Return(uint64(0), nil).Once()
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mp, sm.EmptyEvidencePool{}, blockStore, sm.BlockExecutorWithExtendedCommitRetainBlocks(1))
+
+	block, err := makeBlock(state, 1, new(types.Commit))
+	require.NoError(t, err)
+	bps, err := block.MakePartSet(testPartSize)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: bps.Header()}
+
+	_, err = blockExec.ApplyBlock(state, blockID, block)
+	require.NoError(t, err)
+	blockStore.AssertExpectations(t)
+}
+
 // TestFinalizeBlockDecidedLastCommit ensures we correctly send the
 // DecidedLastCommit to the application. The test ensures that the
 // DecidedLastCommit properly reflects which validators signed the preceding
 // block.
+func TestApplyBlockOptimisticExecution(t *testing.T) {
+	testCases := []struct {
+		name       string
+		optimistic []int // indices of the blocks executed optimistically
+		decided    int
+		executed   []int // indices of the blocks of the FinalizeBlock calls
+	}{
+		{"no optimistic execution", nil, 0, []int{0}},
+		{"decided block", []int{0}, 0, []int{0}},
+		{"other block", []int{1}, 0, []int{1, 0}},
+		{"other block, then decided block", []int{1, 0}, 0, []int{1, 0}},
+		{"same block twice", []int{0, 0}, 0, []int{0}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, stateDB, _ := makeState(1, 1)
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: false,
+			})
+			blocks := make([]*types.Block, 2)
+			blockIDs := make([]types.BlockID, 2)
+			for i := range blocks {
+				var err error
+				blocks[i], err = state.MakeBlock(1, test.MakeNTxs(int64(i), 10), new(types.Commit), nil,
+					state.Validators.GetProposer().Address)
+				require.NoError(t, err)
+				bps, err := blocks[i].MakePartSet(testPartSize)
+				require.NoError(t, err)
+				blockIDs[i] = types.BlockID{Hash: blocks[i].Hash(), PartSetHeader: bps.Header()}
+			}
+
+			txResults := make([]*abci.ExecTxResult, 10)
+			for i := range txResults {
+				txResults[i] = &abci.ExecTxResult{Code: abci.CodeTypeOK}
+			}
+			var executed []int
+			app := &abcimocks.Application{}
+			app.On("FinalizeBlock", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				req := args.Get(1).(*abci.RequestFinalizeBlock)
+				for i, block := range blocks {
+					if bytes.Equal(block.Hash(), req.Hash) {
+						executed = append(executed, i)
+					}
+				}
+			}).Return(&abci.ResponseFinalizeBlock{TxResults: txResults}, nil)
+			app.On("Commit", mock.Anything, mock.Anything).Return(&abci.ResponseCommit{}, nil)
+			cc := proxy.NewLocalClientCreator(app)
+			proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+			require.NoError(t, proxyApp.Start())
+			defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+			mp := &mpmocks.Mempool{}
+			mp.On("Lock").Return()
+			mp.On("Unlock").Return()
+			mp.On("FlushAppConn", mock.Anything).Return(nil)
+			mp.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything).Return(nil)
+			blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+				mp, sm.EmptyEvidencePool{}, store.NewBlockStore(dbm.NewMemDB()))
+
+			for _, i := range tc.optimistic {
+				blockExec.ExecuteBlockOptimistically(state, blockIDs[i], blocks[i])
+			}
+			_, err := blockExec.ApplyBlock(state, blockIDs[tc.decided], blocks[tc.decided])
+			require.NoError(t, err)
+			require.Equal(t, tc.executed, executed)
+		})
+	}
+}
+
 func TestFinalizeBlockDecidedLastCommit(t *testing.T) {
 	app := &testApp{}
 	baseTime := time.Now()
@@ -451,6 +565,68 @@ func TestProcessProposal(t *testing.T) {
 	app.AssertCalled(t, "ProcessProposal", context.TODO(), expectedRpp)
 }
 
+func TestProcessProposalPublishesProposedEvidence(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		status   abci.ResponseProcessProposal_ProposalStatus
+		expected bool
+	}{
+		{"accepted", abci.ResponseProcessProposal_ACCEPT, true},
+		{"rejected", abci.ResponseProcessProposal_REJECT, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			app := &abcimocks.Application{}
+			app.On("ProcessProposal", mock.Anything, mock.Anything).Return(&abci.ResponseProcessProposal{Status: tc.status}, nil)
+
+			cc := proxy.NewLocalClientCreator(app)
+			proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+			require.NoError(t, proxyApp.Start())
+			defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+			state, stateDB, privVals := makeState(1, 1)
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+			eventBus := types.NewEventBus()
+			require.NoError(t, eventBus.Start())
+			defer eventBus.Stop() //nolint:errcheck // ignore for tests
+
+			sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryEvidenceProposed)
+			require.NoError(t, err)
+
+			blockExec := sm.NewBlockExecutor(
+				stateStore,
+				log.NewNopLogger(),
+				proxyApp.Consensus(),
+				new(mpmocks.Mempool),
+				sm.EmptyEvidencePool{},
+				store.NewBlockStore(dbm.NewMemDB()),
+			)
+			blockExec.SetEventBus(eventBus)
+
+			privVal := privVals[state.Validators.Validators[0].Address.String()]
+			ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(1, time.Now(), privVal, state.ChainID)
+			require.NoError(t, err)
+
+			block, err := makeBlock(state, 1, new(types.Commit))
+			require.NoError(t, err)
+			block.Evidence = types.EvidenceData{Evidence: types.EvidenceList{ev}}
+
+			accepted, err := blockExec.ProcessProposal(block, state)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, accepted)
+
+			select {
+			case msg := <-sub.Out():
+				require.True(t, tc.expected, "evidence of a rejected proposal was published")
+				edt := msg.Data().(types.EventDataNewEvidence)
+				assert.Equal(t, ev, edt.Evidence)
+				assert.EqualValues(t, 1, edt.Height)
+			case <-time.After(100 * time.Millisecond):
+				require.False(t, tc.expected, "did not receive proposed evidence event")
+			}
+		})
+	}
+}
+
 func TestValidateValidatorUpdates(t *testing.T) {
 	pubkey1 := ed25519.GenPrivKey().PubKey()
 	pubkey2 := ed25519.GenPrivKey().PubKey()
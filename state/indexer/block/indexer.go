@@ -11,6 +11,7 @@ import (
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
 	"github.com/cometbft/cometbft/state/indexer/sink/psql"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlite"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/kv"
 	"github.com/cometbft/cometbft/state/txindex/null"
@@ -31,6 +32,11 @@ func IndexerFromConfig(cfg *config.Config, dbProvider config.DBProvider, chainID
 func IndexerFromConfigWithDisabledIndexers(cfg *config.Config, dbProvider config.DBProvider, chainID string) (
 	txIdx txindex.TxIndexer, blockIdx indexer.BlockIndexer, allIndexersDisabled bool, err error,
 ) {
+	filter, err := AttributeFilterFromConfig(cfg.TxIndex)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
 	switch cfg.TxIndex.Indexer {
 	case "kv":
 		store, err := dbProvider(&config.DBContext{ID: "tx_index", Config: cfg})
@@ -38,20 +44,39 @@ func IndexerFromConfigWithDisabledIndexers(cfg *config.Config, dbProvider config
 			return nil, nil, false, err
 		}
 
-		return kv.NewTxIndex(store), blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events"))), false, nil
+		return kv.NewTxIndex(store, kv.WithAttributeFilter(filter)),
+			blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")), blockidxkv.WithAttributeFilter(filter)),
+			false, nil
 
 	case "psql":
 		conn := cfg.TxIndex.PsqlConn
 		if conn == "" {
 			return nil, nil, false, errors.New("the psql connection settings cannot be empty")
 		}
-		es, err := psql.NewEventSink(cfg.TxIndex.PsqlConn, chainID)
+		es, err := psql.NewEventSink(cfg.TxIndex.PsqlConn, chainID, psql.WithAttributeFilter(filter))
 		if err != nil {
 			return nil, nil, false, fmt.Errorf("creating psql indexer: %w", err)
 		}
 		return es.TxIndexer(), es.BlockIndexer(), false, nil
 
+	case "sqlite":
+		es, err := sqlite.NewEventSink(cfg.TxIndex.SqliteFile(), chainID, sqlite.WithAttributeFilter(filter))
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("creating sqlite indexer: %w", err)
+		}
+		return es.TxIndexer(), es.BlockIndexer(), false, nil
+
 	default:
 		return &null.TxIndex{}, &blockidxnull.BlockerIndexer{}, true, nil
 	}
 }
+
+// AttributeFilterFromConfig returns the filter selecting the event attributes
+// to index, as configured by the allow and deny lists of the tx_index section.
+func AttributeFilterFromConfig(cfg *config.TxIndexConfig) (*indexer.AttributeFilter, error) {
+	filter, err := indexer.NewAttributeFilter(cfg.AllowKeys, cfg.DenyKeys, cfg.MaxValueSize)
+	if err != nil {
+		return nil, fmt.Errorf("creating indexer attribute filter: %w", err)
+	}
+	return filter, nil
+}
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/google/orderedcode"
 
@@ -31,16 +32,36 @@ var _ indexer.BlockIndexer = (*BlockerIndexer)(nil)
 type BlockerIndexer struct {
 	store dbm.DB
 
+	// Serializes indexing, which may happen concurrently when reindexing
+	// a running node
+	mtx sync.Mutex
 	// Add unique event identifier to use when querying
 	// Matching will be done both on height AND eventSeq
 	eventSeq int64
-	log      log.Logger
+	// Selects the event attributes to index; nil indexes all of them
+	filter *indexer.AttributeFilter
+	log    log.Logger
 }
 
-func New(store dbm.DB) *BlockerIndexer {
-	return &BlockerIndexer{
+// IndexerOption sets an optional parameter on the BlockerIndexer.
+type IndexerOption func(*BlockerIndexer)
+
+// WithAttributeFilter restricts the event attributes indexed by the
+// BlockerIndexer to those allowed by f.
+func WithAttributeFilter(f *indexer.AttributeFilter) IndexerOption {
+	return func(idx *BlockerIndexer) {
+		idx.filter = f
+	}
+}
+
+func New(store dbm.DB, options ...IndexerOption) *BlockerIndexer {
+	idx := &BlockerIndexer{
 		store: store,
 	}
+	for _, option := range options {
+		option(idx)
+	}
+	return idx
 }
 
 func (idx *BlockerIndexer) SetLogger(l log.Logger) {
@@ -64,6 +85,9 @@ func (idx *BlockerIndexer) Has(height int64) (bool, error) {
 // primary key: encode(block.height | height) => encode(height)
 // FinalizeBlock events: encode(eventType.eventAttr|eventValue|height|finalize_block|eventSeq) => encode(height)
 func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockEvents) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
 	batch := idx.store.NewBatch()
 	defer batch.Close()
 
@@ -596,7 +620,7 @@ func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, hei
 				return fmt.Errorf("event type and attribute key \"%s\" is reserved; please use a different key", compositeKey)
 			}
 
-			if attr.GetIndex() {
+			if attr.GetIndex() && idx.filter.Allows(compositeKey, attr.Value) {
 				key, err := eventKey(compositeKey, attr.Value, height, idx.eventSeq)
 				if err != nil {
 					return fmt.Errorf("failed to create block index key: %w", err)
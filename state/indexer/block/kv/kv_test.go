@@ -11,6 +11,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/types"
 )
@@ -142,6 +143,48 @@ func TestBlockIndexer(t *testing.T) {
 	}
 }
 
+func TestBlockIndexerWithAttributeFilter(t *testing.T) {
+	filter, err := indexer.NewAttributeFilter(nil, []string{"begin_event.*"}, 3)
+	require.NoError(t, err)
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	idx := blockidxkv.New(store, blockidxkv.WithAttributeFilter(filter))
+
+	require.NoError(t, idx.Index(types.EventDataNewBlockEvents{
+		Height: 1,
+		Events: []abci.Event{
+			{
+				Type:       "begin_event",
+				Attributes: []abci.EventAttribute{{Key: "proposer", Value: "FCAA001", Index: true}},
+			},
+			{
+				Type: "end_event",
+				Attributes: []abci.EventAttribute{
+					{Key: "foo", Value: "100", Index: true},
+					{Key: "bar", Value: "1000", Index: true},
+				},
+			},
+		},
+	}))
+
+	testCases := map[string]struct {
+		q       *query.Query
+		results []int64
+	}{
+		"block.height = 1":                 {q: query.MustCompile(`block.height = 1`), results: []int64{1}},
+		"end_event.foo = 100":              {q: query.MustCompile(`end_event.foo = 100`), results: []int64{1}},
+		"end_event.bar = 1000":             {q: query.MustCompile(`end_event.bar = 1000`), results: []int64{}},
+		"begin_event.proposer = 'FCAA001'": {q: query.MustCompile(`begin_event.proposer = 'FCAA001'`), results: []int64{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			results, err := idx.Search(context.Background(), tc.q)
+			require.NoError(t, err)
+			require.Equal(t, tc.results, results)
+		})
+	}
+}
+
 func TestBlockIndexerMulti(t *testing.T) {
 	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
 	indexer := blockidxkv.New(store)
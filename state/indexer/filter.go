@@ -0,0 +1,69 @@
+package indexer
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+// AttributeFilter selects which event attributes flagged for indexing by the
+// application are actually indexed. Attributes are matched by their composite
+// key ("type.key") against glob patterns, as implemented by path.Match (e.g.
+// "transfer.*" or "*.sender").
+//
+// The reserved keys (tx.hash, tx.height and block.height) are always indexed.
+// A nil *AttributeFilter indexes every attribute.
+type AttributeFilter struct {
+	allow        []string
+	deny         []string
+	maxValueSize int
+}
+
+// NewAttributeFilter returns a filter indexing the attributes whose composite
+// key matches any of the allow patterns (or all attributes, if allow is empty)
+// and none of the deny patterns. If maxValueSize is positive, attributes whose
+// value is longer than maxValueSize bytes are not indexed.
+func NewAttributeFilter(allow, deny []string, maxValueSize int) (*AttributeFilter, error) {
+	for _, pattern := range append(append([]string{}, allow...), deny...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	if maxValueSize < 0 {
+		return nil, fmt.Errorf("max value size can't be negative, got %d", maxValueSize)
+	}
+	return &AttributeFilter{
+		allow:        allow,
+		deny:         deny,
+		maxValueSize: maxValueSize,
+	}, nil
+}
+
+// Allows reports whether the attribute with the given composite key and value
+// should be indexed. It does not consider the attribute's Index flag.
+func (f *AttributeFilter) Allows(compositeKey, value string) bool {
+	if f == nil {
+		return true
+	}
+	switch compositeKey {
+	case types.TxHashKey, types.TxHeightKey, types.BlockHeightKey:
+		return true
+	}
+	if f.maxValueSize > 0 && len(value) > f.maxValueSize {
+		return false
+	}
+	if matchAny(f.deny, compositeKey) {
+		return false
+	}
+	return len(f.allow) == 0 || matchAny(f.allow, compositeKey)
+}
+
+func matchAny(patterns []string, compositeKey string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, compositeKey); ok {
+			return true
+		}
+	}
+	return false
+}
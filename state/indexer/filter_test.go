@@ -0,0 +1,60 @@
+package indexer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestAttributeFilter(t *testing.T) {
+	testCases := map[string]struct {
+		allow, deny  []string
+		maxValueSize int
+		key, value   string
+		allowed      bool
+	}{
+		"no restrictions":         {key: "transfer.sender", value: "Ivan", allowed: true},
+		"allowed by type":         {allow: []string{"transfer.*"}, key: "transfer.sender", value: "Ivan", allowed: true},
+		"not in allow list":       {allow: []string{"transfer.*"}, key: "message.action", value: "send", allowed: false},
+		"allowed by key":          {allow: []string{"*.sender"}, key: "transfer.sender", value: "Ivan", allowed: true},
+		"denied":                  {deny: []string{"transfer.memo"}, key: "transfer.memo", value: "hi", allowed: false},
+		"not denied":              {deny: []string{"transfer.memo"}, key: "transfer.sender", value: "Ivan", allowed: true},
+		"deny overrides allow":    {allow: []string{"transfer.*"}, deny: []string{"*.memo"}, key: "transfer.memo", value: "hi", allowed: false},
+		"value within limit":      {maxValueSize: 4, key: "transfer.sender", value: "Ivan", allowed: true},
+		"value too large":         {maxValueSize: 3, key: "transfer.sender", value: "Ivan", allowed: false},
+		"reserved tx hash":        {allow: []string{"transfer.*"}, key: types.TxHashKey, value: "AB", allowed: true},
+		"reserved tx height":      {deny: []string{"*"}, key: types.TxHeightKey, value: "1", allowed: true},
+		"reserved block height":   {deny: []string{"block.*"}, maxValueSize: 1, key: types.BlockHeightKey, value: "100", allowed: true},
+		"deny everything":         {deny: []string{"*"}, key: "transfer.sender", value: "Ivan", allowed: false},
+		"pattern matches exactly": {allow: []string{"transfer"}, key: "transfer.sender", value: "Ivan", allowed: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f, err := indexer.NewAttributeFilter(tc.allow, tc.deny, tc.maxValueSize)
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, f.Allows(tc.key, tc.value))
+		})
+	}
+}
+
+func TestAttributeFilterNil(t *testing.T) {
+	var f *indexer.AttributeFilter
+	assert.True(t, f.Allows("transfer.memo", strings.Repeat("a", 1024)))
+}
+
+func TestNewAttributeFilterInvalid(t *testing.T) {
+	_, err := indexer.NewAttributeFilter([]string{"transfer.[a"}, nil, 0)
+	require.Error(t, err)
+
+	_, err = indexer.NewAttributeFilter(nil, []string{"["}, 0)
+	require.Error(t, err)
+
+	_, err = indexer.NewAttributeFilter(nil, nil, -1)
+	require.Error(t, err)
+}
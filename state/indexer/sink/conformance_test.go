@@ -0,0 +1,151 @@
+package sink_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer/sink/psql"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlite"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/types"
+
+	// Register the Postgres database driver.
+	_ "github.com/lib/pq"
+)
+
+const (
+	chainID = "test-chainID"
+
+	// psqlConnEnv names the environment variable holding the connection
+	// string of a PostgreSQL database to include in the conformance test.
+	// The database is reset by the test.
+	psqlConnEnv = "CMT_PSQL_TEST_CONN"
+)
+
+// TestTxSearchConformance checks that every tx indexer returns the same
+// results for the same queries.
+func TestTxSearchConformance(t *testing.T) {
+	amounts := []string{
+		"-5",
+		"8atom",
+		"10",
+		"2.5",
+		"100000000000000000000000000001",
+	}
+
+	var txrs []*abci.TxResult
+	for i, amount := range amounts {
+		txrs = append(txrs, &abci.TxResult{
+			Height: int64(i + 1),
+			Tx:     types.Tx(amount),
+			Result: abci.ExecTxResult{Events: []abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: amount, Index: true},
+					{Key: "owner", Value: "Ivan", Index: i == 0},
+				}},
+			}},
+		})
+	}
+
+	// The heights of the transactions matching each query.
+	testCases := map[string]struct {
+		q       string
+		heights []int64
+	}{
+		"negative":              {`transfer.amount < 0`, []int64{1}},
+		"less than":             {`transfer.amount <= 3`, []int64{1, 4}},
+		"greater than":          {`transfer.amount > 2`, []int64{3, 4, 5}},
+		"range":                 {`transfer.amount >= 10 AND transfer.amount < 11`, []int64{3}},
+		"big number":            {`transfer.amount > 100000000000000000000000000000`, []int64{5}},
+		"number equality":       {`transfer.amount = 10`, []int64{3}},
+		"equality is textual":   {`transfer.amount = 10.0`, nil},
+		"string equality":       {`transfer.amount = '8atom'`, []int64{2}},
+		"contains":              {`transfer.amount CONTAINS 'atom'`, []int64{2}},
+		"exists":                {`transfer.amount EXISTS`, []int64{1, 2, 3, 4, 5}},
+		"same event":            {`transfer.owner = 'Ivan' AND transfer.amount < 0`, []int64{1}},
+		"height and range":      {`tx.height >= 2 AND transfer.amount < 5`, []int64{4}},
+		"height range only":     {`tx.height > 3`, []int64{4, 5}},
+		"range without numbers": {`transfer.owner > 0`, nil},
+	}
+
+	indexers := map[string]txindex.TxIndexer{
+		"kv":     kv.NewTxIndex(db.NewMemDB()),
+		"sqlite": newSQLite(t).TxIndexer(),
+	}
+	if conn := os.Getenv(psqlConnEnv); conn != "" {
+		indexers["psql"] = newPSQL(t, conn).TxIndexer()
+	} else {
+		t.Logf("skipping psql; set %s to include it", psqlConnEnv)
+	}
+
+	for name, indexer := range indexers {
+		for _, txr := range txrs {
+			require.NoError(t, indexer.Index(txr), name)
+		}
+
+		for tcName, tc := range testCases {
+			t.Run(name+"/"+tcName, func(t *testing.T) {
+				results, err := indexer.Search(context.Background(), query.MustCompile(tc.q))
+				require.NoError(t, err)
+
+				var heights []int64
+				for _, r := range results {
+					heights = append(heights, r.Height)
+				}
+				slices.Sort(heights)
+				assert.Equal(t, tc.heights, heights)
+			})
+		}
+	}
+}
+
+// blockSink is implemented by the SQL sinks, which only index transactions
+// of blocks that have been indexed.
+type blockSink interface {
+	IndexBlockEvents(types.EventDataNewBlockEvents) error
+}
+
+func indexBlocks(t *testing.T, es blockSink) {
+	t.Helper()
+	for h := int64(1); h <= 5; h++ {
+		require.NoError(t, es.IndexBlockEvents(types.EventDataNewBlockEvents{Height: h}))
+	}
+}
+
+func newSQLite(t *testing.T) *sqlite.EventSink {
+	t.Helper()
+	es, err := sqlite.NewEventSink(filepath.Join(t.TempDir(), "tx_index.sqlite"), chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, es.Stop()) })
+	indexBlocks(t, es)
+	return es
+}
+
+func newPSQL(t *testing.T, conn string) *psql.EventSink {
+	t.Helper()
+	es, err := psql.NewEventSink(conn, chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, es.Stop()) })
+
+	schema, err := os.ReadFile(filepath.Join("psql", "schema.sql"))
+	require.NoError(t, err)
+	_, err = es.DB().Exec(`DROP TABLE IF EXISTS blocks,tx_results,events,attributes CASCADE;
+DROP VIEW IF EXISTS event_attributes,block_events,tx_events CASCADE;`)
+	require.NoError(t, err)
+	_, err = es.DB().Exec(string(schema))
+	require.NoError(t, err)
+
+	indexBlocks(t, es)
+	return es
+}
@@ -0,0 +1,86 @@
+package sink
+
+import (
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+)
+
+// MatchValue reports whether an attribute value satisfies condition c. Both
+// SQL sinks evaluate attribute conditions with the semantics of the kv
+// indexer, so that a query returns the same results whichever indexer is
+// configured:
+//   - equality compares the value with the text of the argument;
+//   - numeric comparisons parse the whole value as a number, which may be
+//     negative, and never match values that are not numbers, such as "8atom";
+//   - date and time comparisons parse the value as a date or a timestamp.
+func MatchValue(c syntax.Condition, value string) bool {
+	if c.Op == syntax.TExists {
+		return true
+	}
+	if c.Arg == nil {
+		return false
+	}
+	switch c.Op {
+	case syntax.TEq:
+		return value == c.Arg.Value()
+	case syntax.TContains:
+		return strings.Contains(value, c.Arg.Value())
+	}
+
+	var cmp int
+	switch c.Arg.Type {
+	case syntax.TNumber:
+		v, ok := parseNumber(value)
+		arg := c.Arg.Number()
+		if !ok || arg == nil {
+			return false
+		}
+		cmp = v.Cmp(arg)
+	case syntax.TDate, syntax.TTime:
+		v, err := parseTime(c.Arg.Type, value)
+		if err != nil {
+			return false
+		}
+		cmp = v.Compare(c.Arg.Time())
+	default:
+		return false
+	}
+
+	switch c.Op {
+	case syntax.TLt:
+		return cmp < 0
+	case syntax.TLeq:
+		return cmp <= 0
+	case syntax.TGt:
+		return cmp > 0
+	case syntax.TGeq:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+// parseNumber parses s as the kv indexer does when matching a range: as an
+// integer if possible, so that big integers are compared exactly, and as a
+// decimal otherwise.
+func parseNumber(s string) (*big.Float, bool) {
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		prec := uint(n.BitLen())
+		if prec < 64 {
+			prec = 64
+		}
+		return new(big.Float).SetPrec(prec).SetInt(n), true
+	}
+	f, _, err := big.ParseFloat(s, 10, 125, big.ToNearestEven)
+	return f, err == nil
+}
+
+func parseTime(typ syntax.Token, s string) (time.Time, error) {
+	if typ == syntax.TDate {
+		return syntax.ParseDate(s)
+	}
+	return syntax.ParseTime(s)
+}
@@ -4,7 +4,6 @@ package psql
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -16,6 +15,9 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/indexer/sink"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -33,20 +35,37 @@ const (
 type EventSink struct {
 	store   *sql.DB
 	chainID string
+	// Selects the event attributes to index; nil indexes all of them
+	filter *indexer.AttributeFilter
+}
+
+// EventSinkOption sets an optional parameter on the EventSink.
+type EventSinkOption func(*EventSink)
+
+// WithAttributeFilter restricts the event attributes indexed by the sink to
+// those allowed by f.
+func WithAttributeFilter(f *indexer.AttributeFilter) EventSinkOption {
+	return func(es *EventSink) {
+		es.filter = f
+	}
 }
 
 // NewEventSink constructs an event sink associated with the PostgreSQL
 // database specified by connStr. Events written to the sink are attributed to
 // the specified chainID.
-func NewEventSink(connStr, chainID string) (*EventSink, error) {
+func NewEventSink(connStr, chainID string, options ...EventSinkOption) (*EventSink, error) {
 	db, err := sql.Open(driverName, connStr)
 	if err != nil {
 		return nil, err
 	}
-	return &EventSink{
+	es := &EventSink{
 		store:   db,
 		chainID: chainID,
-	}, nil
+	}
+	for _, option := range options {
+		option(es)
+	}
+	return es, nil
 }
 
 // DB returns the underlying Postgres connection used by the sink.
@@ -100,7 +119,7 @@ var (
 	attrInsertColumns  = []string{"event_id", "key", "composite_key", "value"}
 )
 
-func bulkInsertEvents(blockID, txID int64, events []abci.Event) (eventInserts, attrInserts [][]any) {
+func bulkInsertEvents(blockID, txID int64, events []abci.Event, filter *indexer.AttributeFilter) (eventInserts, attrInserts [][]any) {
 	// Populate the transaction ID field iff one is defined (> 0).
 	var txIDArg any
 	if txID > 0 {
@@ -114,10 +133,10 @@ func bulkInsertEvents(blockID, txID int64, events []abci.Event) (eventInserts, a
 		eventID := randomBigserial()
 		eventInserts = append(eventInserts, []any{eventID, blockID, txIDArg, event.Type})
 		for _, attr := range event.Attributes {
-			if !attr.Index {
+			compositeKey := event.Type + "." + attr.Key
+			if !attr.Index || !filter.Allows(compositeKey, attr.Value) {
 				continue
 			}
-			compositeKey := event.Type + "." + attr.Key
 			attrInserts = append(attrInserts, []any{eventID, attr.Key, compositeKey, attr.Value})
 		}
 	}
@@ -161,7 +180,7 @@ INSERT INTO `+tableBlocks+` (height, chain_id, created_at)
 	// Insert the special block meta-event for height.
 	events := append([]abci.Event{makeIndexedEvent(types.BlockHeightKey, strconv.FormatInt(h.Height, 10))}, h.Events...)
 	// Insert all the block events. Order is important here,
-	eventInserts, attrInserts := bulkInsertEvents(blockID, 0, events)
+	eventInserts, attrInserts := bulkInsertEvents(blockID, 0, events, es.filter)
 	if err := runBulkInsert(es.store, tableEvents, eventInsertColumns, eventInserts); err != nil {
 		return fmt.Errorf("failed bulk insert of events: %w", err)
 	}
@@ -236,7 +255,7 @@ func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
 		},
 			txr.Result.Events...,
 		)
-		newEventInserts, newAttrInserts := bulkInsertEvents(blockIDs[i], txID, events)
+		newEventInserts, newAttrInserts := bulkInsertEvents(blockIDs[i], txID, events, es.filter)
 		eventInserts = append(eventInserts, newEventInserts...)
 		attrInserts = append(attrInserts, newAttrInserts...)
 	}
@@ -252,24 +271,244 @@ func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
 	return nil
 }
 
-// SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) SearchBlockEvents(_ context.Context, _ *query.Query) ([]int64, error) {
-	return nil, errors.New("block search is not supported via the postgres event sink")
+// SearchBlockEvents returns the heights of the blocks whose events match q,
+// in ascending order.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	conditions := q.Syntax()
+
+	// If the query is only a block height equality, check for the block
+	// directly rather than scanning events.
+	if len(conditions) == 1 && conditions[0].Tag == types.BlockHeightKey && sink.IsHeightEq(conditions[0]) {
+		h, _ := conditions[0].Arg.Number().Int64()
+		ok, err := es.HasBlock(h)
+		if err != nil || !ok {
+			return []int64{}, err
+		}
+		return []int64{h}, nil
+	}
+
+	sq, err := buildSearch(conditions, types.BlockHeightKey,
+		"events.tx_id IS NULL AND events.block_id = blocks.rowid", es.chainID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := es.store.QueryContext(ctx, `
+SELECT blocks.height`+sq.candidates()+` FROM `+tableBlocks+`
+  WHERE blocks.chain_id = $1`+sq.where+`
+  ORDER BY blocks.height;
+`, sq.args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching block events: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		heights    = make([]int64, 0)
+		candidates [][]int64
+	)
+	for rows.Next() {
+		var (
+			h        int64
+			eventIDs pq.Int64Array
+		)
+		dest := []any{&h}
+		if len(sq.deferred) > 0 {
+			dest = append(dest, &eventIDs)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("reading block search results: %w", err)
+		}
+		heights = append(heights, h)
+		candidates = append(candidates, eventIDs)
+	}
+	if err := rows.Err(); err != nil && ctx.Err() == nil {
+		return nil, fmt.Errorf("reading block search results: %w", err)
+	}
+
+	if len(sq.deferred) == 0 {
+		return heights, nil
+	}
+	keep, err := es.matchDeferred(ctx, sq.deferred, candidates)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]int64, 0, len(heights))
+	for i, h := range heights {
+		if keep[i] {
+			filtered = append(filtered, h)
+		}
+	}
+	return filtered, nil
 }
 
-// SearchTxEvents is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) SearchTxEvents(_ context.Context, _ *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("tx search is not supported via the postgres event sink")
+// SearchTxEvents returns the transaction results whose events match q. Results
+// are ordered by height and index within the block.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	conditions := q.Syntax()
+
+	// If a tx.hash condition is present, the result is determined by the hash
+	// alone.
+	for _, c := range conditions {
+		if c.Tag == types.TxHashKey && c.Op == syntax.TEq && c.Arg != nil {
+			hash, err := sink.HexHash(c.Arg.Value())
+			if err != nil {
+				return nil, err
+			}
+			res, err := es.GetTxByHash(hash)
+			if err != nil || res == nil {
+				return []*abci.TxResult{}, err
+			}
+			return []*abci.TxResult{res}, nil
+		}
+	}
+
+	sq, err := buildSearch(conditions, types.TxHeightKey, "events.tx_id = tx_results.rowid", es.chainID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := es.store.QueryContext(ctx, `
+SELECT tx_results.tx_hash, tx_results.tx_result`+sq.candidates()+` FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON blocks.rowid = tx_results.block_id
+  WHERE blocks.chain_id = $1`+sq.where+`
+  ORDER BY blocks.height, tx_results.index;
+`, sq.args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching tx events: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		hashes     []string
+		results    []*abci.TxResult
+		candidates [][]int64
+	)
+	for rows.Next() {
+		var (
+			txHash   string
+			data     []byte
+			eventIDs pq.Int64Array
+		)
+		dest := []any{&txHash, &data}
+		if len(sq.deferred) > 0 {
+			dest = append(dest, &eventIDs)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("reading tx search results: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(data, txr); err != nil {
+			return nil, fmt.Errorf("decoding tx_result: %w", err)
+		}
+		hashes = append(hashes, txHash)
+		results = append(results, txr)
+		candidates = append(candidates, eventIDs)
+	}
+	if err := rows.Err(); err != nil && ctx.Err() == nil {
+		return nil, fmt.Errorf("reading tx search results: %w", err)
+	}
+
+	var keep []bool
+	if len(sq.deferred) > 0 {
+		if keep, err = es.matchDeferred(ctx, sq.deferred, candidates); err != nil {
+			return nil, err
+		}
+	}
+
+	var deduped sink.TxResults
+	for i, txr := range results {
+		if keep != nil && !keep[i] {
+			continue
+		}
+		deduped.Add(hashes[i], txr)
+	}
+	return deduped.Results(), nil
+}
+
+// candidates returns the select expression listing the IDs of the candidate
+// events of a result, if the query has deferred conditions.
+func (sq *searchQuery) candidates() string {
+	if len(sq.deferred) == 0 {
+		return ""
+	}
+	return ", ARRAY(SELECT events.rowid FROM " + tableEvents + " WHERE " + sq.events + ")"
+}
+
+// matchDeferred checks the deferred conditions against the attributes of the
+// candidate events of each result, and reports which results have at least
+// one event satisfying all of them.
+func (es *EventSink) matchDeferred(ctx context.Context, conds []*deferredCondition, candidates [][]int64) ([]bool, error) {
+	var (
+		eventIDs []int64
+		keys     []string
+	)
+	for _, ids := range candidates {
+		eventIDs = append(eventIDs, ids...)
+	}
+	for _, c := range conds {
+		keys = append(keys, c.tag)
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT event_id, composite_key, value FROM `+tableAttributes+`
+  WHERE event_id = ANY($1) AND composite_key = ANY($2);
+`, pq.Array(eventIDs), pq.Array(keys))
+	if err != nil {
+		return nil, fmt.Errorf("loading event attributes: %w", err)
+	}
+	defer rows.Close()
+
+	values := make(map[int64]map[string][]string)
+	for rows.Next() {
+		var (
+			eventID int64
+			key     string
+			value   sql.NullString
+		)
+		if err := rows.Scan(&eventID, &key, &value); err != nil {
+			return nil, fmt.Errorf("reading event attributes: %w", err)
+		}
+		if values[eventID] == nil {
+			values[eventID] = make(map[string][]string)
+		}
+		values[eventID][key] = append(values[eventID][key], value.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading event attributes: %w", err)
+	}
+
+	keep := make([]bool, len(candidates))
+	for i, ids := range candidates {
+	events:
+		for _, id := range ids {
+			for _, c := range conds {
+				if !c.matches(values[id][c.tag]) {
+					continue events
+				}
+			}
+			keep[i] = true
+			break
+		}
+	}
+	return keep, nil
 }
 
-// GetTxByHash is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) GetTxByHash(_ []byte) (*abci.TxResult, error) {
-	return nil, errors.New("getTxByHash is not supported via the postgres event sink")
+// GetTxByHash returns the transaction result with the given hash, or nil if
+// no such transaction has been indexed. If the transaction was indexed more
+// than once, the most recent successful result is reported.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	return sink.GetTxByHash(es.store, hash, `
+SELECT tx_results.tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON blocks.rowid = tx_results.block_id
+  WHERE tx_results.tx_hash = $1 AND blocks.chain_id = $2
+  ORDER BY blocks.height DESC, tx_results.index DESC;
+`, fmt.Sprintf("%X", hash), es.chainID)
 }
 
-// HasBlock is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) HasBlock(_ int64) (bool, error) {
-	return false, errors.New("hasBlock is not supported via the postgres event sink")
+// HasBlock reports whether the block at the given height has been indexed.
+func (es *EventSink) HasBlock(h int64) (bool, error) {
+	return sink.HasBlock(es.store, h, `
+SELECT EXISTS(SELECT 1 FROM `+tableBlocks+` WHERE height = $1 AND chain_id = $2);
+`, h, es.chainID)
 }
 
 // Stop closes the underlying PostgreSQL database.
@@ -0,0 +1,149 @@
+package psql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/state/indexer/sink"
+)
+
+// searchQuery is the translation of a query into SQL over the sink schema.
+type searchQuery struct {
+	// where holds the predicates to append to a WHERE clause over the blocks
+	// table. It is either empty or begins with " AND".
+	where string
+	// events is the filter selecting the candidate events of a result, or
+	// empty if the query has no event conditions.
+	events string
+	args   []any
+
+	// deferred holds the conditions that are not translated into SQL, and
+	// must be checked against the attributes of the candidate events.
+	deferred []*deferredCondition
+}
+
+// deferredCondition is a condition evaluated by sink.MatchValue rather than
+// by the database, for comparisons whose operands cannot be safely converted
+// in SQL, such as numbers and timestamps.
+type deferredCondition struct {
+	tag  string
+	cond syntax.Condition
+}
+
+// matches reports whether any of the values satisfies the condition.
+func (c *deferredCondition) matches(values []string) bool {
+	for _, v := range values {
+		if sink.MatchValue(c.cond, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// bind appends arg to the query arguments and returns its placeholder.
+func (sq *searchQuery) bind(arg any) string {
+	sq.args = append(sq.args, arg)
+	return "$" + strconv.Itoa(len(sq.args))
+}
+
+// buildSearch translates the query conditions into SQL. The leading args are
+// bound first, so that the caller can refer to them as $1, $2, ...
+// Conditions on heightKey are applied to the block height directly. All other
+// conditions must be satisfied by the attributes of a single event, selected
+// by eventFilter.
+func buildSearch(conditions []syntax.Condition, heightKey, eventFilter string, args ...any) (*searchQuery, error) {
+	sq := &searchQuery{args: args}
+
+	var (
+		where  strings.Builder
+		events strings.Builder
+	)
+	for _, c := range conditions {
+		if c.Tag == heightKey {
+			where.WriteString(sq.heightPredicate(c))
+			continue
+		}
+
+		events.WriteString("\n    AND EXISTS (SELECT 1 FROM " + tableAttributes +
+			" WHERE attributes.event_id = events.rowid AND attributes.composite_key = " + sq.bind(c.Tag))
+		pred, deferred, err := sq.valuePredicate(c, "attributes.value")
+		if err != nil {
+			return nil, err
+		}
+		if deferred {
+			sq.deferred = append(sq.deferred, &deferredCondition{tag: c.Tag, cond: c})
+		}
+		events.WriteString(pred + ")")
+	}
+
+	if events.Len() > 0 {
+		sq.events = eventFilter + events.String()
+		where.WriteString("\n  AND EXISTS (SELECT 1 FROM " + tableEvents + " WHERE " + sq.events + ")")
+	}
+	sq.where = where.String()
+	return sq, nil
+}
+
+// heightPredicate translates a condition on the block height.
+func (sq *searchQuery) heightPredicate(c syntax.Condition) string {
+	if c.Op == syntax.TExists {
+		return ""
+	}
+	switch c.Arg.Type {
+	case syntax.TNumber:
+		if op := sqlOperator(c.Op); op != "" {
+			return " AND blocks.height " + op + " " + sq.bind(c.Arg.Value()) + "::numeric"
+		}
+	case syntax.TString:
+		switch c.Op {
+		case syntax.TEq:
+			return " AND blocks.height::text = " + sq.bind(c.Arg.Value())
+		case syntax.TContains:
+			return " AND strpos(blocks.height::text, " + sq.bind(c.Arg.Value()) + ") > 0"
+		}
+	}
+	// A height is never a valid date or timestamp.
+	return " AND FALSE"
+}
+
+// valuePredicate translates the operator and argument of c into a predicate
+// on the text column col. If the condition cannot be evaluated in SQL, the
+// predicate is empty and deferred is true.
+func (sq *searchQuery) valuePredicate(c syntax.Condition, col string) (pred string, deferred bool, err error) {
+	if c.Op == syntax.TExists {
+		return "", false, nil
+	}
+	if c.Arg == nil {
+		return "", false, fmt.Errorf("missing argument for %v", c.Op)
+	}
+	switch {
+	case c.Op == syntax.TEq:
+		return " AND " + col + " = " + sq.bind(c.Arg.Value()), false, nil
+	case c.Op == syntax.TContains && c.Arg.Type == syntax.TString:
+		return " AND strpos(" + col + ", " + sq.bind(c.Arg.Value()) + ") > 0", false, nil
+	case c.Arg.Type != syntax.TString && sqlOperator(c.Op) != "":
+		return "", true, nil
+	}
+	return "", false, fmt.Errorf("invalid op/arg combination (%v, %v)", c.Op, c.Arg.Type)
+}
+
+// sqlOperator returns the SQL comparison operator for op, or "" if op is not
+// a comparison.
+func sqlOperator(op syntax.Token) string {
+	switch op {
+	case syntax.TEq:
+		return "="
+	case syntax.TLt:
+		return "<"
+	case syntax.TLeq:
+		return "<="
+	case syntax.TGt:
+		return ">"
+	case syntax.TGeq:
+		return ">="
+	default:
+		return ""
+	}
+}
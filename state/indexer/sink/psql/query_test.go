@@ -0,0 +1,116 @@
+package psql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestBuildSearch(t *testing.T) {
+	const eventFilter = "events.tx_id = tx_results.rowid"
+
+	testCases := map[string]struct {
+		q        string
+		where    string
+		args     []any
+		deferred int
+	}{
+		"height only": {
+			q:     `tx.height >= 5 AND tx.height < 10`,
+			where: ` AND blocks.height >= $2::numeric AND blocks.height < $3::numeric`,
+			args:  []any{chainID, "5", "10"},
+		},
+		"string equality": {
+			q: `account.owner = 'Ivan'`,
+			where: "\n  AND EXISTS (SELECT 1 FROM events WHERE " + eventFilter +
+				"\n    AND EXISTS (SELECT 1 FROM attributes WHERE attributes.event_id = events.rowid AND attributes.composite_key = $2 AND attributes.value = $3))",
+			args: []any{chainID, "account.owner", "Ivan"},
+		},
+		"numeric range is deferred": {
+			q: `tx.height = 3 AND account.number > 1`,
+			where: " AND blocks.height = $2::numeric" +
+				"\n  AND EXISTS (SELECT 1 FROM events WHERE " + eventFilter +
+				"\n    AND EXISTS (SELECT 1 FROM attributes WHERE attributes.event_id = events.rowid AND attributes.composite_key = $3))",
+			args:     []any{chainID, "3", "account.number"},
+			deferred: 1,
+		},
+		"numeric equality": {
+			q: `account.number = 1`,
+			where: "\n  AND EXISTS (SELECT 1 FROM events WHERE " + eventFilter +
+				"\n    AND EXISTS (SELECT 1 FROM attributes WHERE attributes.event_id = events.rowid AND attributes.composite_key = $2 AND attributes.value = $3))",
+			args: []any{chainID, "account.number", "1"},
+		},
+		"contains and exists": {
+			q: `account.owner CONTAINS 'Iv' AND account.number EXISTS`,
+			where: "\n  AND EXISTS (SELECT 1 FROM events WHERE " + eventFilter +
+				"\n    AND EXISTS (SELECT 1 FROM attributes WHERE attributes.event_id = events.rowid AND attributes.composite_key = $2 AND strpos(attributes.value, $3) > 0)" +
+				"\n    AND EXISTS (SELECT 1 FROM attributes WHERE attributes.event_id = events.rowid AND attributes.composite_key = $4))",
+			args: []any{chainID, "account.owner", "Iv", "account.number"},
+		},
+		"time is deferred": {
+			q: `account.time > TIME 2013-05-03T14:45:00Z`,
+			where: "\n  AND EXISTS (SELECT 1 FROM events WHERE " + eventFilter +
+				"\n    AND EXISTS (SELECT 1 FROM attributes WHERE attributes.event_id = events.rowid AND attributes.composite_key = $2))",
+			args:     []any{chainID, "account.time"},
+			deferred: 1,
+		},
+		"height as date never matches": {
+			q:     `tx.height > DATE 2013-05-03`,
+			where: ` AND FALSE`,
+			args:  []any{chainID},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sq, err := buildSearch(query.MustCompile(tc.q).Syntax(), types.TxHeightKey, eventFilter, chainID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.where, sq.where)
+			assert.Equal(t, tc.args, sq.args)
+			assert.Len(t, sq.deferred, tc.deferred)
+		})
+	}
+}
+
+func TestDeferredCondition(t *testing.T) {
+	sq, err := buildSearch(query.MustCompile(`account.date >= DATE 2013-05-03`).Syntax(),
+		types.TxHeightKey, "", chainID)
+	require.NoError(t, err)
+	require.Len(t, sq.deferred, 1)
+
+	c := sq.deferred[0]
+	assert.True(t, c.matches([]string{"2013-05-03"}))
+	assert.True(t, c.matches([]string{"2012-01-01", "2013-05-04"}))
+	assert.False(t, c.matches([]string{"2013-05-02"}))
+	assert.False(t, c.matches([]string{"not a date"}))
+	assert.False(t, c.matches(nil))
+}
+
+func TestBulkInsertEventsWithAttributeFilter(t *testing.T) {
+	filter, err := indexer.NewAttributeFilter(nil, []string{"account.memo"}, 4)
+	require.NoError(t, err)
+
+	events := []abci.Event{
+		makeIndexedEvent(types.TxHashKey, "ABCDEF"),
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "number", Value: "1", Index: true},
+			{Key: "memo", Value: "hi", Index: true},
+			{Key: "owner", Value: "Ivan Ivanovich", Index: true},
+			{Key: "note", Value: "x", Index: false},
+		}},
+	}
+	eventInserts, attrInserts := bulkInsertEvents(1, 2, events, filter)
+	require.Len(t, eventInserts, 2)
+
+	var keys []string
+	for _, insert := range attrInserts {
+		keys = append(keys, insert[2].(string))
+	}
+	assert.Equal(t, []string{types.TxHashKey, "account.number"}, keys)
+}
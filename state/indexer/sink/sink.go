@@ -0,0 +1,109 @@
+// Package sink holds the helpers shared by the SQL event sinks.
+package sink
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+)
+
+// IsHeightEq reports whether c is an equality condition against a number.
+func IsHeightEq(c syntax.Condition) bool {
+	return c.Op == syntax.TEq && c.Arg != nil && c.Arg.Type == syntax.TNumber
+}
+
+// HexHash decodes the hex-encoded transaction hash from a tx.hash condition.
+func HexHash(s string) ([]byte, error) {
+	hash, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
+	}
+	return hash, nil
+}
+
+// GetTxByHash runs query, which must select the encoded tx_result of every
+// indexing of a transaction, most recent first, and returns the most recent
+// successful result. If the transaction never succeeded, the most recent
+// result is returned, and nil if it has not been indexed.
+func GetTxByHash(db *sql.DB, hash []byte, query string, args ...any) (*abci.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, errors.New("tx hash cannot be empty")
+	}
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("looking up tx by hash: %w", err)
+	}
+	defer rows.Close()
+
+	var found *abci.TxResult
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("reading tx_result: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(data, txr); err != nil {
+			return nil, fmt.Errorf("decoding tx_result: %w", err)
+		}
+		if txr.Result.IsOK() {
+			return txr, nil
+		}
+		if found == nil {
+			found = txr
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading tx_result: %w", err)
+	}
+	return found, nil
+}
+
+// HasBlock runs query, which must select whether the block at height h has
+// been indexed.
+func HasBlock(db *sql.DB, h int64, query string, args ...any) (bool, error) {
+	var found bool
+	if err := db.QueryRow(query, args...).Scan(&found); err != nil {
+		return false, fmt.Errorf("checking for block %d: %w", h, err)
+	}
+	return found, nil
+}
+
+// TxResults collects the results of a tx search. A transaction may have been
+// included (and indexed) more than once; it is reported once, preferring the
+// most recent successful execution as the kv indexer does.
+type TxResults struct {
+	results []*abci.TxResult
+	byHash  map[string]int
+}
+
+// Add records txr, the result of the transaction with the given hash. Results
+// must be added in ascending order of height.
+func (r *TxResults) Add(hash string, txr *abci.TxResult) {
+	if r.byHash == nil {
+		r.byHash = make(map[string]int)
+	}
+	if i, ok := r.byHash[hash]; ok {
+		if r.results[i].Result.IsOK() && !txr.Result.IsOK() {
+			return
+		}
+		r.results[i] = txr
+		return
+	}
+	r.byHash[hash] = len(r.results)
+	r.results = append(r.results, txr)
+}
+
+// Results returns the deduplicated results, in the order they were first
+// added.
+func (r *TxResults) Results() []*abci.TxResult {
+	if r.results == nil {
+		return []*abci.TxResult{}
+	}
+	return r.results
+}
@@ -0,0 +1,31 @@
+package sink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+func TestTxResults(t *testing.T) {
+	var (
+		okA     = &abci.TxResult{Height: 1, Result: abci.ExecTxResult{}}
+		failedB = &abci.TxResult{Height: 1, Index: 1, Result: abci.ExecTxResult{Code: 1}}
+		failedA = &abci.TxResult{Height: 2, Result: abci.ExecTxResult{Code: 1}}
+		okB     = &abci.TxResult{Height: 3, Result: abci.ExecTxResult{}}
+	)
+
+	var r TxResults
+	assert.Empty(t, r.Results())
+	assert.NotNil(t, r.Results())
+
+	r.Add("A", okA)
+	r.Add("B", failedB)
+	r.Add("A", failedA)
+	r.Add("B", okB)
+
+	// Each transaction is reported once, at the position it was first seen,
+	// with its most recent successful result.
+	assert.Equal(t, []*abci.TxResult{okA, okB}, r.Results())
+}
@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxIndexer returns a transaction indexer backed by es.
+func (es *EventSink) TxIndexer() TxIndexer {
+	return TxIndexer{sqlite: es}
+}
+
+// TxIndexer implements the txindex.TxIndexer interface by delegating to an
+// underlying SQLite event sink.
+type TxIndexer struct{ sqlite *EventSink }
+
+var _ txindex.TxIndexer = TxIndexer{}
+
+// AddBatch indexes a batch of transactions in SQLite, as part of TxIndexer.
+func (t TxIndexer) AddBatch(batch *txindex.Batch) error {
+	return t.sqlite.IndexTxEvents(batch.Ops)
+}
+
+// Index indexes a single transaction result in SQLite, as part of TxIndexer.
+func (t TxIndexer) Index(txr *abci.TxResult) error {
+	return t.sqlite.IndexTxEvents([]*abci.TxResult{txr})
+}
+
+// Get returns the transaction result with the given hash, or nil if it has
+// not been indexed, as part of TxIndexer.
+func (t TxIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	return t.sqlite.GetTxByHash(hash)
+}
+
+// Search returns the transaction results matching q, as part of TxIndexer.
+func (t TxIndexer) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return t.sqlite.SearchTxEvents(ctx, q)
+}
+
+func (TxIndexer) SetLogger(log.Logger) {}
+
+// BlockIndexer returns a block indexer backed by es.
+func (es *EventSink) BlockIndexer() BlockIndexer {
+	return BlockIndexer{sqlite: es}
+}
+
+// BlockIndexer implements the indexer.BlockIndexer interface by delegating
+// to an underlying SQLite event sink.
+type BlockIndexer struct{ sqlite *EventSink }
+
+// Has reports whether the block at the given height has been indexed, as part
+// of BlockIndexer.
+func (b BlockIndexer) Has(height int64) (bool, error) {
+	return b.sqlite.HasBlock(height)
+}
+
+// Index indexes block begin and end events for the specified block.  It is
+// part of the BlockIndexer interface.
+func (b BlockIndexer) Index(block types.EventDataNewBlockEvents) error {
+	return b.sqlite.IndexBlockEvents(block)
+}
+
+// Search returns the heights of the blocks matching q, as part of
+// BlockIndexer.
+func (b BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.sqlite.SearchBlockEvents(ctx, q)
+}
+
+func (BlockIndexer) SetLogger(log.Logger) {}
@@ -0,0 +1,161 @@
+package sqlite
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"sync"
+
+	"modernc.org/sqlite"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/state/indexer/sink"
+)
+
+// matchFunc is the name of the SQL function used to evaluate conditions that
+// cannot be expressed as plain SQL comparisons, such as numeric ranges over
+// text attribute values or timestamp comparisons. It applies sink.MatchValue.
+const matchFunc = "cmt_match"
+
+// matchTag is the placeholder tag of the conditions passed to matchFunc.
+const matchTag = "cmt.v"
+
+// maxCachedMatchers bounds the number of parsed conditions kept by matchFunc.
+const maxCachedMatchers = 1024
+
+var matchers = struct {
+	sync.Mutex
+	m map[string]syntax.Condition
+}{m: make(map[string]syntax.Condition)}
+
+func init() {
+	sqlite.MustRegisterDeterministicScalarFunction(matchFunc, 2, evalMatch)
+}
+
+// evalMatch implements matchFunc(condition, value). The condition is the text
+// of a query with a single condition on matchTag.
+func evalMatch(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	cond, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: invalid condition %v", matchFunc, args[0])
+	}
+	var value string
+	switch v := args[1].(type) {
+	case nil:
+		return false, nil
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		value = fmt.Sprint(v)
+	}
+
+	matchers.Lock()
+	c, ok := matchers.m[cond]
+	if !ok {
+		q, err := syntax.Parse(cond)
+		if err != nil {
+			matchers.Unlock()
+			return nil, fmt.Errorf("%s: %w", matchFunc, err)
+		}
+		if len(q) != 1 {
+			matchers.Unlock()
+			return nil, fmt.Errorf("%s: expected a single condition, got %q", matchFunc, cond)
+		}
+		if len(matchers.m) >= maxCachedMatchers {
+			clear(matchers.m)
+		}
+		c = q[0]
+		matchers.m[cond] = c
+	}
+	matchers.Unlock()
+
+	return sink.MatchValue(c, value), nil
+}
+
+// matchCondition returns the text of a query applying the operator and
+// argument of c to matchTag.
+func matchCondition(c syntax.Condition) string {
+	return matchTag + strings.TrimPrefix(c.String(), c.Tag)
+}
+
+// buildSearch translates the query conditions into SQL predicates to be
+// appended to a WHERE clause over the blocks table. Conditions on heightKey
+// are applied to the block height directly. All other conditions must be
+// satisfied by the attributes of a single event, selected by eventFilter.
+// The returned predicate is either empty or begins with " AND".
+func buildSearch(conditions []syntax.Condition, heightKey, eventFilter string) (string, []any) {
+	var (
+		sb   strings.Builder
+		args []any
+	)
+
+	var attrConds []syntax.Condition
+	for _, c := range conditions {
+		if c.Tag != heightKey {
+			attrConds = append(attrConds, c)
+			continue
+		}
+		pred, predArgs := heightPredicate(c)
+		sb.WriteString(pred)
+		args = append(args, predArgs...)
+	}
+
+	if len(attrConds) == 0 {
+		return sb.String(), args
+	}
+
+	sb.WriteString("\n  AND EXISTS (SELECT 1 FROM " + tableEvents + " WHERE " + eventFilter)
+	for _, c := range attrConds {
+		sb.WriteString("\n    AND EXISTS (SELECT 1 FROM " + tableAttributes +
+			" WHERE attributes.event_id = events.rowid AND attributes.composite_key = ?")
+		args = append(args, c.Tag)
+		pred, predArgs := valuePredicate(c, "attributes.value")
+		sb.WriteString(pred)
+		args = append(args, predArgs...)
+		sb.WriteString(")")
+	}
+	sb.WriteString(")")
+	return sb.String(), args
+}
+
+// heightPredicate translates a condition on the block height. Numeric
+// comparisons are evaluated natively so that they can use the height index.
+func heightPredicate(c syntax.Condition) (string, []any) {
+	if c.Arg != nil && c.Arg.Type == syntax.TNumber {
+		var op string
+		switch c.Op {
+		case syntax.TEq:
+			op = "="
+		case syntax.TLt:
+			op = "<"
+		case syntax.TLeq:
+			op = "<="
+		case syntax.TGt:
+			op = ">"
+		case syntax.TGeq:
+			op = ">="
+		}
+		if op != "" {
+			f, _ := c.Arg.Number().Float64()
+			return " AND blocks.height " + op + " ?", []any{f}
+		}
+	}
+	return valuePredicate(c, "CAST(blocks.height AS TEXT)")
+}
+
+// valuePredicate translates the operator and argument of c into a predicate
+// on the text column col.
+func valuePredicate(c syntax.Condition, col string) (string, []any) {
+	switch {
+	case c.Op == syntax.TExists:
+		return "", nil
+	case c.Op == syntax.TContains:
+		return " AND instr(" + col + ", ?) > 0", []any{c.Arg.Value()}
+	case c.Op == syntax.TEq:
+		return " AND " + col + " = ?", []any{c.Arg.Value()}
+	default:
+		return " AND " + matchFunc + "(?, " + col + ")", []any{matchCondition(c)}
+	}
+}
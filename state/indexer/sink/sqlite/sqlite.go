@@ -0,0 +1,377 @@
+// Package sqlite implements an event sink backed by an embedded SQLite
+// database. Unlike the psql sink, it serves transaction and block searches in
+// addition to indexing, so it can be used as a drop-in replacement for the kv
+// indexer.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	_ "modernc.org/sqlite" // registers the "sqlite" database/sql driver
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/indexer/sink"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	tableBlocks     = "blocks"
+	tableTxResults  = "tx_results"
+	tableEvents     = "events"
+	tableAttributes = "attributes"
+	driverName      = "sqlite"
+)
+
+//go:embed schema.sql
+var schema string
+
+// EventSink is an indexer backend providing the tx/block index services.  This
+// implementation stores records in a SQLite database file using the schema
+// defined in state/indexer/sink/sqlite/schema.sql.
+type EventSink struct {
+	store   *sql.DB
+	chainID string
+	// Selects the event attributes to index; nil indexes all of them
+	filter *indexer.AttributeFilter
+}
+
+// EventSinkOption sets an optional parameter on the EventSink.
+type EventSinkOption func(*EventSink)
+
+// WithAttributeFilter restricts the event attributes indexed by the sink to
+// those allowed by f.
+func WithAttributeFilter(f *indexer.AttributeFilter) EventSinkOption {
+	return func(es *EventSink) {
+		es.filter = f
+	}
+}
+
+// NewEventSink constructs an event sink associated with the SQLite database
+// stored at path, creating the database and installing its schema if needed.
+// Events written to the sink are attributed to the specified chainID.
+func NewEventSink(path, chainID string, options ...EventSinkOption) (*EventSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("creating database directory: %w", err)
+	}
+	// WAL journaling lets searches proceed while blocks are being indexed, and
+	// the busy timeout makes concurrent writers wait instead of failing.
+	dsn := "file:" + path + "?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)&_pragma=foreign_keys(1)"
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("installing schema: %w", err)
+	}
+	es := &EventSink{
+		store:   db,
+		chainID: chainID,
+	}
+	for _, option := range options {
+		option(es)
+	}
+	return es, nil
+}
+
+// DB returns the underlying SQLite connection used by the sink.
+// This is exported to support testing.
+func (es *EventSink) DB() *sql.DB { return es.store }
+
+// runInTransaction executes query in a fresh database transaction.
+// If query reports an error, the transaction is rolled back and the
+// error from query is reported to the caller.
+// Otherwise, the result of committing the transaction is returned.
+func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// insertEvents inserts events and their indexed attributes, attributing them
+// to the given block and, if txID > 0, transaction.
+func insertEvents(dbtx *sql.Tx, blockID, txID int64, events []abci.Event, filter *indexer.AttributeFilter) error {
+	// Populate the transaction ID field iff one is defined (> 0).
+	var txIDArg any
+	if txID > 0 {
+		txIDArg = txID
+	}
+	eventStmt, err := dbtx.Prepare(`INSERT INTO ` + tableEvents + ` (block_id, tx_id, type) VALUES (?, ?, ?);`)
+	if err != nil {
+		return fmt.Errorf("preparing event insert statement: %w", err)
+	}
+	defer eventStmt.Close()
+	attrStmt, err := dbtx.Prepare(`INSERT INTO ` + tableAttributes + ` (event_id, key, composite_key, value) VALUES (?, ?, ?, ?);`)
+	if err != nil {
+		return fmt.Errorf("preparing attribute insert statement: %w", err)
+	}
+	defer attrStmt.Close()
+
+	for _, event := range events {
+		// Skip events with an empty type.
+		if event.Type == "" {
+			continue
+		}
+		res, err := eventStmt.Exec(blockID, txIDArg, event.Type)
+		if err != nil {
+			return fmt.Errorf("inserting event: %w", err)
+		}
+		eventID, err := res.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("reading event id: %w", err)
+		}
+		for _, attr := range event.Attributes {
+			compositeKey := event.Type + "." + attr.Key
+			if !attr.Index || !filter.Allows(compositeKey, attr.Value) {
+				continue
+			}
+			if _, err := attrStmt.Exec(eventID, attr.Key, compositeKey, attr.Value); err != nil {
+				return fmt.Errorf("inserting attribute: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// makeIndexedEvent constructs an event from the specified composite key and
+// value. If the key has the form "type.name", the event will have a single
+// attribute with that name and the value; otherwise the event will have only
+// a type and no attributes.
+func makeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	if i < 0 {
+		return abci.Event{Type: compositeKey}
+	}
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: compositeKey[i+1:], Value: value, Index: true},
+	}}
+}
+
+// IndexBlockEvents indexes the specified block header, part of the
+// indexer.EventSink interface.
+func (es *EventSink) IndexBlockEvents(h types.EventDataNewBlockEvents) error {
+	ts := time.Now().UTC().Format(time.RFC3339Nano)
+
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		// Add the block to the blocks table and report back its row ID for use
+		// in indexing the events for the block.
+		res, err := dbtx.Exec(`
+INSERT INTO `+tableBlocks+` (height, chain_id, created_at)
+  VALUES (?, ?, ?)
+  ON CONFLICT DO NOTHING;
+`, h.Height, es.chainID, ts)
+		if err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		} else if n == 0 {
+			return nil // we already saw this block; quietly succeed
+		}
+		blockID, err := res.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		}
+
+		// Insert the special block meta-event for height.
+		events := append([]abci.Event{makeIndexedEvent(types.BlockHeightKey, strconv.FormatInt(h.Height, 10))}, h.Events...)
+		if err := insertEvents(dbtx, blockID, 0, events, es.filter); err != nil {
+			return fmt.Errorf("indexing block events: %w", err)
+		}
+		return nil
+	})
+}
+
+// IndexTxEvents indexes the specified transaction results, part of the
+// indexer.EventSink interface. Every block header must have been indexed
+// prior to the transactions belonging to it.
+func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
+	ts := time.Now().UTC().Format(time.RFC3339Nano)
+
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		for _, txr := range txrs {
+			var blockID int64
+			err := dbtx.QueryRow(`SELECT rowid FROM `+tableBlocks+` WHERE height = ? AND chain_id = ?;`,
+				txr.Height, es.chainID).Scan(&blockID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("block %d has not been indexed", txr.Height)
+			} else if err != nil {
+				return fmt.Errorf("getting block id for tx: %w", err)
+			}
+
+			// Encode the result message in protobuf wire format for indexing.
+			resultData, err := proto.Marshal(txr)
+			if err != nil {
+				return fmt.Errorf("marshaling tx_result: %w", err)
+			}
+			// Index the hash of the underlying transaction as a hex string.
+			txHash := fmt.Sprintf("%X", types.Tx(txr.Tx).Hash())
+
+			res, err := dbtx.Exec(`
+INSERT INTO `+tableTxResults+` (block_id, "index", created_at, tx_hash, tx_result)
+  VALUES (?, ?, ?, ?, ?)
+  ON CONFLICT DO NOTHING;
+`, blockID, txr.Index, ts, txHash, resultData)
+			if err != nil {
+				return fmt.Errorf("indexing tx_result: %w", err)
+			}
+			if n, err := res.RowsAffected(); err != nil {
+				return fmt.Errorf("indexing tx_result: %w", err)
+			} else if n == 0 {
+				continue // already indexed
+			}
+			txID, err := res.LastInsertId()
+			if err != nil {
+				return fmt.Errorf("indexing tx_result: %w", err)
+			}
+
+			// Insert the special transaction meta-events for hash and height.
+			events := append([]abci.Event{
+				makeIndexedEvent(types.TxHashKey, txHash),
+				makeIndexedEvent(types.TxHeightKey, strconv.FormatInt(txr.Height, 10)),
+			},
+				txr.Result.Events...,
+			)
+			if err := insertEvents(dbtx, blockID, txID, events, es.filter); err != nil {
+				return fmt.Errorf("indexing tx events: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// SearchBlockEvents returns the heights of the blocks whose events match q,
+// in ascending order.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	conditions := q.Syntax()
+
+	// If the query is only a block height equality, check for the block
+	// directly rather than scanning events.
+	if len(conditions) == 1 && conditions[0].Tag == types.BlockHeightKey && sink.IsHeightEq(conditions[0]) {
+		h, _ := conditions[0].Arg.Number().Int64()
+		ok, err := es.HasBlock(h)
+		if err != nil || !ok {
+			return []int64{}, err
+		}
+		return []int64{h}, nil
+	}
+
+	where, args := buildSearch(conditions, types.BlockHeightKey, "events.tx_id IS NULL AND events.block_id = blocks.rowid")
+	rows, err := es.store.QueryContext(ctx, `
+SELECT blocks.height FROM `+tableBlocks+`
+  WHERE blocks.chain_id = ?`+where+`
+  ORDER BY blocks.height;
+`, append([]any{es.chainID}, args...)...)
+	if err != nil {
+		return nil, fmt.Errorf("searching block events: %w", err)
+	}
+	defer rows.Close()
+
+	heights := make([]int64, 0)
+	for rows.Next() {
+		var h int64
+		if err := rows.Scan(&h); err != nil {
+			return nil, fmt.Errorf("reading block search results: %w", err)
+		}
+		heights = append(heights, h)
+	}
+	if err := rows.Err(); err != nil && ctx.Err() == nil {
+		return nil, fmt.Errorf("reading block search results: %w", err)
+	}
+	return heights, nil
+}
+
+// SearchTxEvents returns the transaction results whose events match q. Results
+// are ordered by height and index within the block.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	conditions := q.Syntax()
+
+	// If a tx.hash condition is present, the result is determined by the hash
+	// alone.
+	for _, c := range conditions {
+		if c.Tag == types.TxHashKey && c.Op == syntax.TEq && c.Arg != nil {
+			hash, err := sink.HexHash(c.Arg.Value())
+			if err != nil {
+				return nil, err
+			}
+			res, err := es.GetTxByHash(hash)
+			if err != nil || res == nil {
+				return []*abci.TxResult{}, err
+			}
+			return []*abci.TxResult{res}, nil
+		}
+	}
+
+	where, args := buildSearch(conditions, types.TxHeightKey, "events.tx_id = tx_results.rowid")
+	rows, err := es.store.QueryContext(ctx, `
+SELECT tx_results.tx_hash, tx_results.tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON blocks.rowid = tx_results.block_id
+  WHERE blocks.chain_id = ?`+where+`
+  ORDER BY blocks.height, tx_results."index";
+`, append([]any{es.chainID}, args...)...)
+	if err != nil {
+		return nil, fmt.Errorf("searching tx events: %w", err)
+	}
+	defer rows.Close()
+
+	var results sink.TxResults
+	for rows.Next() {
+		var (
+			txHash string
+			data   []byte
+		)
+		if err := rows.Scan(&txHash, &data); err != nil {
+			return nil, fmt.Errorf("reading tx search results: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(data, txr); err != nil {
+			return nil, fmt.Errorf("decoding tx_result: %w", err)
+		}
+		results.Add(txHash, txr)
+	}
+	if err := rows.Err(); err != nil && ctx.Err() == nil {
+		return nil, fmt.Errorf("reading tx search results: %w", err)
+	}
+	return results.Results(), nil
+}
+
+// GetTxByHash returns the transaction result with the given hash, or nil if
+// no such transaction has been indexed. If the transaction was indexed more
+// than once, the most recent successful result is reported.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	return sink.GetTxByHash(es.store, hash, `
+SELECT tx_results.tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON blocks.rowid = tx_results.block_id
+  WHERE tx_results.tx_hash = ? AND blocks.chain_id = ?
+  ORDER BY blocks.height DESC, tx_results."index" DESC;
+`, fmt.Sprintf("%X", hash), es.chainID)
+}
+
+// HasBlock reports whether the block at the given height has been indexed.
+func (es *EventSink) HasBlock(h int64) (bool, error) {
+	return sink.HasBlock(es.store, h, `
+SELECT EXISTS(SELECT 1 FROM `+tableBlocks+` WHERE height = ? AND chain_id = ?);
+`, h, es.chainID)
+}
+
+// Stop closes the underlying SQLite database.
+func (es *EventSink) Stop() error { return es.store.Close() }
@@ -0,0 +1,301 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+const chainID = "test-chainID"
+
+func newTestSink(t *testing.T) *EventSink {
+	t.Helper()
+	es, err := NewEventSink(filepath.Join(t.TempDir(), "data", "tx_index.sqlite"), chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, es.Stop()) })
+	return es
+}
+
+func TestType(t *testing.T) {
+	var _ txindex.TxIndexer = (*EventSink)(nil).TxIndexer()
+}
+
+func TestReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tx_index.sqlite")
+	es, err := NewEventSink(path, chainID)
+	require.NoError(t, err)
+	require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(1, "1")))
+	require.NoError(t, es.Stop())
+
+	// Reopening an existing database must not fail on the schema, and must
+	// retain the indexed data.
+	es, err = NewEventSink(path, chainID)
+	require.NoError(t, err)
+	defer es.Stop()
+	ok, err := es.HasBlock(1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestBlockSearch(t *testing.T) {
+	es := newTestSink(t)
+	indexer := es.BlockIndexer()
+
+	require.NoError(t, indexer.Index(newTestBlockEvents(1, "100")))
+	for i := 2; i < 12; i++ {
+		ev := newTestBlockEvents(int64(i), fmt.Sprintf("%d", i))
+		ev.Events[1].Attributes[0].Index = i%2 == 0
+		require.NoError(t, indexer.Index(ev))
+	}
+	// Indexing a block twice is a no-op.
+	require.NoError(t, indexer.Index(newTestBlockEvents(1, "100")))
+
+	ok, err := indexer.Has(5)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = indexer.Has(100)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	testCases := map[string]struct {
+		q       string
+		results []int64
+	}{
+		"height not indexed":         {`block.height = 100`, []int64{}},
+		"height":                     {`block.height = 5`, []int64{5}},
+		"missing key":                {`begin_event.key1 = 'value1'`, []int64{}},
+		"string equality":            {`begin_event.proposer = 'FCAA001'`, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}},
+		"numeric upper bound":        {`end_event.foo <= 5`, []int64{2, 4}},
+		"numeric lower bound":        {`end_event.foo >= 100`, []int64{1}},
+		"height range and attribute": {`block.height > 2 AND end_event.foo <= 8`, []int64{4, 6, 8}},
+		"empty range":                {`end_event.foo > 100`, []int64{}},
+		"height lower bound":         {`block.height >= 2 AND end_event.foo < 8`, []int64{2, 4, 6}},
+		"contains no match":          {`begin_event.proposer CONTAINS 'FFFFFFF'`, []int64{}},
+		"contains":                   {`end_event.foo CONTAINS '1'`, []int64{1, 10}},
+		"exists":                     {`end_event.foo EXISTS`, []int64{1, 2, 4, 6, 8, 10}},
+		"height only range":          {`block.height > 9`, []int64{10, 11}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			results, err := indexer.Search(context.Background(), query.MustCompile(tc.q))
+			require.NoError(t, err)
+			require.Equal(t, tc.results, results)
+		})
+	}
+}
+
+func TestTxSearch(t *testing.T) {
+	es := newTestSink(t)
+	indexer := es.TxIndexer()
+
+	for h := int64(1); h <= 3; h++ {
+		require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(h, "1")))
+	}
+
+	txr1 := txResultWithEvents(1, 0, "foo", []abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "number", Value: "1", Index: true},
+			{Key: "owner", Value: "Ivan", Index: true},
+		}},
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "number", Value: "2", Index: true},
+			{Key: "owner", Value: "Vlad", Index: true},
+		}},
+	})
+	txr2 := txResultWithEvents(2, 1, "bar", []abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "number", Value: "100000000000000000000000000001", Index: true},
+			{Key: "date", Value: "2013-05-03", Index: true},
+		}},
+	})
+	txr3 := txResultWithEvents(3, 1, "baz", []abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "number", Value: "3", Index: false},
+			{Key: "time", Value: "2013-05-03T14:45:00Z", Index: true},
+		}},
+	})
+
+	batch := txindex.NewBatch(2)
+	require.NoError(t, batch.Add(txr1))
+	require.NoError(t, batch.Add(txr2))
+	require.NoError(t, indexer.AddBatch(batch))
+	require.NoError(t, indexer.Index(txr3))
+	// Indexing a transaction twice is a no-op.
+	require.NoError(t, indexer.Index(txr3))
+
+	// A transaction whose block has not been indexed is rejected.
+	require.Error(t, indexer.Index(txResultWithEvents(10, 0, "qux", nil)))
+
+	got, err := indexer.Get(types.Tx("foo").Hash())
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(got, txr1))
+
+	got, err = indexer.Get(types.Tx("missing").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	testCases := map[string]struct {
+		q       string
+		results []*abci.TxResult
+	}{
+		"hash":                        {fmt.Sprintf("tx.hash = '%X'", types.Tx("bar").Hash()), []*abci.TxResult{txr2}},
+		"hash with other conditions":  {fmt.Sprintf("tx.hash = '%X' AND tx.height = 5", types.Tx("bar").Hash()), []*abci.TxResult{txr2}},
+		"height":                      {`tx.height = 1`, []*abci.TxResult{txr1}},
+		"height range":                {`tx.height >= 2`, []*abci.TxResult{txr2, txr3}},
+		"same event":                  {`account.number = 1 AND account.owner = 'Ivan'`, []*abci.TxResult{txr1}},
+		"different events":            {`account.number = 1 AND account.owner = 'Vlad'`, []*abci.TxResult{}},
+		"numeric range":               {`account.number >= 1 AND account.number <= 2`, []*abci.TxResult{txr1}},
+		"big number":                  {`account.number > 100000000000000000000000000000`, []*abci.TxResult{txr2}},
+		"not indexed":                 {`account.number = 3`, []*abci.TxResult{}},
+		"contains":                    {`account.owner CONTAINS 'Iv'`, []*abci.TxResult{txr1}},
+		"exists":                      {`account.owner EXISTS`, []*abci.TxResult{txr1}},
+		"exists across txs":           {`account.number EXISTS`, []*abci.TxResult{txr1, txr2}},
+		"date":                        {`account.date >= DATE 2013-05-03`, []*abci.TxResult{txr2}},
+		"time":                        {`account.time > TIME 2013-05-03T14:44:00Z`, []*abci.TxResult{txr3}},
+		"time after":                  {`account.time > TIME 2013-05-03T14:46:00Z`, []*abci.TxResult{}},
+		"height and attribute":        {`tx.height = 1 AND account.owner = 'Vlad'`, []*abci.TxResult{txr1}},
+		"height mismatch":             {`tx.height = 2 AND account.owner = 'Vlad'`, []*abci.TxResult{}},
+		"unmatched key":               {`account.unknown = 'x'`, []*abci.TxResult{}},
+		"numeric equality":            {`account.number = 2`, []*abci.TxResult{txr1}},
+		"numeric equality as decimal": {`account.number = 2.0`, []*abci.TxResult{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			results, err := indexer.Search(context.Background(), query.MustCompile(tc.q))
+			require.NoError(t, err)
+			require.Len(t, results, len(tc.results))
+			for i, want := range tc.results {
+				assert.True(t, proto.Equal(results[i], want), "result %d", i)
+			}
+		})
+	}
+}
+
+func TestTxSearchDeduplicatesByHash(t *testing.T) {
+	es := newTestSink(t)
+	indexer := es.TxIndexer()
+
+	for h := int64(1); h <= 3; h++ {
+		require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(h, "1")))
+	}
+
+	events := []abci.Event{{Type: "account", Attributes: []abci.EventAttribute{
+		{Key: "owner", Value: "Ivan", Index: true},
+	}}}
+	ok := txResultWithEvents(1, 0, "foo", events)
+	failed := txResultWithEvents(2, 0, "foo", events)
+	failed.Result.Code = abci.CodeTypeOK + 1
+	require.NoError(t, indexer.Index(ok))
+	require.NoError(t, indexer.Index(failed))
+
+	// The successful execution is preferred over a later failed one.
+	got, err := indexer.Get(types.Tx("foo").Hash())
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(got, ok))
+
+	results, err := indexer.Search(context.Background(), query.MustCompile(`account.owner = 'Ivan'`))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.True(t, proto.Equal(results[0], ok))
+
+	// A later successful execution replaces an earlier one.
+	again := txResultWithEvents(3, 0, "foo", events)
+	require.NoError(t, indexer.Index(again))
+	got, err = indexer.Get(types.Tx("foo").Hash())
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(got, again))
+}
+
+func TestAttributeFilter(t *testing.T) {
+	filter, err := indexer.NewAttributeFilter([]string{"end_event.*"}, nil, 2)
+	require.NoError(t, err)
+	es, err := NewEventSink(filepath.Join(t.TempDir(), "tx_index.sqlite"), chainID, WithAttributeFilter(filter))
+	require.NoError(t, err)
+	defer es.Stop()
+
+	require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(1, "10")))
+	require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(2, "100")))
+
+	testCases := map[string]struct {
+		q       string
+		results []int64
+	}{
+		"reserved key":      {`block.height > 0`, []int64{1, 2}},
+		"allowed":           {`end_event.foo EXISTS`, []int64{1}},
+		"not in allow list": {`begin_event.proposer EXISTS`, []int64{}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			results, err := es.SearchBlockEvents(context.Background(), query.MustCompile(tc.q))
+			require.NoError(t, err)
+			require.Equal(t, tc.results, results)
+		})
+	}
+}
+
+func TestChainIsolation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tx_index.sqlite")
+	es, err := NewEventSink(path, chainID)
+	require.NoError(t, err)
+	defer es.Stop()
+	other, err := NewEventSink(path, "other-chain")
+	require.NoError(t, err)
+	defer other.Stop()
+
+	require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(1, "1")))
+	ok, err := other.HasBlock(1)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	heights, err := other.SearchBlockEvents(context.Background(), query.MustCompile(`end_event.foo = 1`))
+	require.NoError(t, err)
+	assert.Empty(t, heights)
+}
+
+func newTestBlockEvents(height int64, foo string) types.EventDataNewBlockEvents {
+	return types.EventDataNewBlockEvents{
+		Height: height,
+		Events: []abci.Event{
+			{
+				Type: "begin_event",
+				Attributes: []abci.EventAttribute{
+					{Key: "proposer", Value: "FCAA001", Index: true},
+				},
+			},
+			{
+				Type: "end_event",
+				Attributes: []abci.EventAttribute{
+					{Key: "foo", Value: foo, Index: true},
+				},
+			},
+		},
+	}
+}
+
+func txResultWithEvents(height int64, index uint32, tx string, events []abci.Event) *abci.TxResult {
+	return &abci.TxResult{
+		Height: height,
+		Index:  index,
+		Tx:     types.Tx(tx),
+		Result: abci.ExecTxResult{
+			Data:   []byte{0},
+			Code:   abci.CodeTypeOK,
+			Log:    "",
+			Events: events,
+		},
+	}
+}
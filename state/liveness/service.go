@@ -0,0 +1,158 @@
+package liveness
+
+import (
+	"context"
+	"strconv"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/service"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	subscriber = "LivenessService"
+)
+
+// Service tracks how the validators sign the commits of the blocks published
+// on the event bus with a Tracker. It records how they signed each height to
+// db, the state database, so that the Tracker is restored on restart.
+type Service struct {
+	service.BaseService
+
+	tracker    *Tracker
+	db         dbm.DB
+	stateStore sm.Store
+	eventBus   *types.EventBus
+	metrics    *sm.Metrics
+
+	// the addresses whose stats are reported as metrics
+	reported map[string]struct{}
+}
+
+// NewService returns a new service tracking the precommits over the given
+// window sizes.
+func NewService(
+	windows []int64,
+	db dbm.DB,
+	stateStore sm.Store,
+	eventBus *types.EventBus,
+	metrics *sm.Metrics,
+) *Service {
+	s := &Service{
+		tracker:    NewTracker(windows),
+		db:         db,
+		stateStore: stateStore,
+		eventBus:   eventBus,
+		metrics:    metrics,
+		reported:   make(map[string]struct{}),
+	}
+	s.BaseService = *service.NewBaseService(nil, "LivenessService", s)
+	return s
+}
+
+// Tracker returns the tracker of the service.
+func (s *Service) Tracker() *Tracker {
+	return s.tracker
+}
+
+// OnStart implements service.Service by restoring the tracker from the
+// database and subscribing for new blocks.
+func (s *Service) OnStart() error {
+	height, err := loadHeight(s.db)
+	if err != nil {
+		return err
+	}
+	for h := max(height-s.tracker.MaxWindow()+1, 1); h <= height; h++ {
+		sigs, err := loadCommitSignatures(s.db, h)
+		if err != nil {
+			return err
+		}
+		if sigs != nil {
+			s.tracker.Record(sigs)
+		}
+	}
+	s.updateMetrics()
+
+	// Use SubscribeUnbuffered to ensure no block is skipped.
+	blockSub, err := s.eventBus.SubscribeUnbuffered(context.Background(), subscriber, types.EventQueryNewBlock)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case <-blockSub.Canceled():
+				return
+			case msg := <-blockSub.Out():
+				block := msg.Data().(types.EventDataNewBlock).Block
+				if err := s.record(block.LastCommit); err != nil {
+					s.Logger.Error("failed to record commit signatures", "height", block.LastCommit.Height, "err", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// OnStop implements service.Service by unsubscribing from new blocks.
+func (s *Service) OnStop() {
+	if s.eventBus.IsRunning() {
+		_ = s.eventBus.UnsubscribeAll(context.Background(), subscriber)
+	}
+}
+
+// record records how the validators signed a commit, which is skipped if its
+// height was already recorded, e.g. when blocks are replayed.
+func (s *Service) record(commit *types.Commit) error {
+	if commit == nil || commit.Height <= s.tracker.Height() || len(commit.Signatures) == 0 {
+		return nil
+	}
+	vals, err := s.stateStore.LoadValidators(commit.Height)
+	if err != nil {
+		return err
+	}
+	sigs, err := NewCommitSignatures(commit, vals)
+	if err != nil {
+		return err
+	}
+
+	prevHeight := s.tracker.Height()
+	if err := saveCommitSignatures(s.db, sigs, prevHeight, s.tracker.MaxWindow()); err != nil {
+		return err
+	}
+	s.tracker.Record(sigs)
+	s.updateMetrics()
+	s.Logger.Debug("recorded commit signatures", "height", commit.Height)
+	return nil
+}
+
+// updateMetrics reports the stats of the validators, and zeroes the ones of
+// the validators which were dropped from the largest window.
+func (s *Service) updateMetrics() {
+	stats := s.tracker.Stats()
+	current := make(map[string]struct{}, len(stats))
+	for _, vs := range stats {
+		current[string(vs.Address)] = struct{}{}
+		for _, ws := range vs.Windows {
+			s.setMetrics(vs.Address, ws)
+		}
+	}
+	for key := range s.reported {
+		if _, ok := current[key]; !ok {
+			for _, w := range s.tracker.windows {
+				s.setMetrics(types.Address(key), WindowStats{Window: w.size})
+			}
+		}
+	}
+	s.reported = current
+}
+
+func (s *Service) setMetrics(address types.Address, ws WindowStats) {
+	labels := []string{"validator_address", address.String(), "window", strconv.FormatInt(ws.Window, 10)}
+	s.metrics.LivenessSignedBlocks.With(labels...).Set(float64(ws.Signed))
+	s.metrics.LivenessMissedBlocks.With(labels...).Set(float64(ws.Missed))
+	s.metrics.LivenessAbsentBlocks.With(labels...).Set(float64(ws.Absent))
+}
@@ -0,0 +1,88 @@
+package liveness_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/liveness"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+func startService(t *testing.T, db dbm.DB, stateStore sm.Store, eventBus *types.EventBus) *liveness.Service {
+	t.Helper()
+	service := liveness.NewService([]int64{2, 3}, db, stateStore, eventBus, sm.NopMetrics())
+	service.SetLogger(log.TestingLogger())
+	require.NoError(t, service.Start())
+	return service
+}
+
+func TestServiceRecordsAndRestores(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	vals, _ := types.RandValidatorSet(2, 10)
+	stateStore := &mocks.Store{}
+	stateStore.On("LoadValidators", mock.Anything).Return(vals, nil)
+
+	db := dbm.NewMemDB()
+	service := startService(t, db, stateStore, eventBus)
+
+	flags := []types.BlockIDFlag{types.BlockIDFlagCommit, types.BlockIDFlagNil, types.BlockIDFlagAbsent, types.BlockIDFlagCommit}
+	for i, flag := range flags {
+		block := &types.Block{
+			Header: types.Header{Height: int64(i + 2)},
+			LastCommit: &types.Commit{
+				Height: int64(i + 1),
+				Signatures: []types.CommitSig{
+					{BlockIDFlag: types.BlockIDFlagCommit},
+					{BlockIDFlag: flag},
+				},
+			},
+		}
+		require.NoError(t, eventBus.PublishEventNewBlock(types.EventDataNewBlock{Block: block}))
+	}
+	require.Eventually(t, func() bool {
+		return service.Tracker().Height() == 4
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, service.Stop())
+
+	expected := []liveness.WindowStats{
+		{Window: 2, Heights: 2, Signed: 1, Absent: 1},
+		{Window: 3, Heights: 3, Signed: 1, Missed: 1, Absent: 1},
+	}
+	stats, ok := service.Tracker().ValidatorStats(vals.Validators[1].Address)
+	require.True(t, ok)
+	assert.Equal(t, expected, stats.Windows)
+
+	// the tracker is restored from the database
+	restored := startService(t, db, stateStore, eventBus)
+	t.Cleanup(func() {
+		if err := restored.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	assert.EqualValues(t, 4, restored.Tracker().Height())
+	stats, ok = restored.Tracker().ValidatorStats(vals.Validators[1].Address)
+	require.True(t, ok)
+	assert.Equal(t, expected, stats.Windows)
+
+	// the records which slid out of the largest window were pruned
+	has, err := db.Has([]byte("livenessCommitSignaturesKey:1"))
+	require.NoError(t, err)
+	assert.False(t, has)
+}
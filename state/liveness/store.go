@@ -0,0 +1,73 @@
+package liveness
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+)
+
+// the latest height recorded to the database
+var heightKey = []byte("livenessHeightKey")
+
+func calcCommitSignaturesKey(height int64) []byte {
+	return []byte(fmt.Sprintf("livenessCommitSignaturesKey:%v", height))
+}
+
+// loadHeight returns the latest height recorded to db, or 0 if none.
+func loadHeight(db dbm.DB) (int64, error) {
+	bz, err := db.Get(heightKey)
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	height, n := binary.Varint(bz)
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid liveness height %X", bz)
+	}
+	return height, nil
+}
+
+// loadCommitSignatures returns the CommitSignatures of a height recorded to db,
+// or nil if none.
+func loadCommitSignatures(db dbm.DB, height int64) (*CommitSignatures, error) {
+	bz, err := db.Get(calcCommitSignaturesKey(height))
+	if err != nil || len(bz) == 0 {
+		return nil, err
+	}
+	pb := new(cmtstate.CommitSignatures)
+	if err := proto.Unmarshal(bz, pb); err != nil {
+		return nil, fmt.Errorf("unmarshaling commit signatures of height %d: %w", height, err)
+	}
+	return CommitSignaturesFromProto(pb), nil
+}
+
+// saveCommitSignatures records sigs to db as the latest height, following
+// prevHeight, and prunes the records which slid out of the largest window, of
+// size maxWindow.
+func saveCommitSignatures(db dbm.DB, sigs *CommitSignatures, prevHeight, maxWindow int64) error {
+	bz, err := proto.Marshal(sigs.ToProto())
+	if err != nil {
+		return err
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(calcCommitSignaturesKey(sigs.Height), bz); err != nil {
+		return err
+	}
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(buf, sigs.Height)
+	if err := batch.Set(heightKey, buf[:n]); err != nil {
+		return err
+	}
+	for h := max(prevHeight-maxWindow+1, 1); h <= min(sigs.Height-maxWindow, prevHeight); h++ {
+		if err := batch.Delete(calcCommitSignaturesKey(h)); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
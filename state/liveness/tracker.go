@@ -0,0 +1,271 @@
+package liveness
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"sort"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// CommitSignature is how a validator signed the commit of a height.
+type CommitSignature struct {
+	ValidatorAddress types.Address
+	BlockIDFlag      types.BlockIDFlag
+}
+
+// CommitSignatures records how each validator of a height signed its commit.
+type CommitSignatures struct {
+	Height     int64
+	Signatures []CommitSignature
+}
+
+// NewCommitSignatures returns how each validator of vals, the validator set of
+// the height of commit, signed it.
+func NewCommitSignatures(commit *types.Commit, vals *types.ValidatorSet) (*CommitSignatures, error) {
+	if len(commit.Signatures) != vals.Size() {
+		return nil, fmt.Errorf("commit of height %d has %d signatures, expected %d",
+			commit.Height, len(commit.Signatures), vals.Size())
+	}
+	sigs := make([]CommitSignature, len(commit.Signatures))
+	for i, sig := range commit.Signatures {
+		sigs[i] = CommitSignature{
+			ValidatorAddress: vals.Validators[i].Address,
+			BlockIDFlag:      sig.BlockIDFlag,
+		}
+	}
+	return &CommitSignatures{Height: commit.Height, Signatures: sigs}, nil
+}
+
+// ToProto converts CommitSignatures to protobuf.
+func (cs *CommitSignatures) ToProto() *cmtstate.CommitSignatures {
+	pb := &cmtstate.CommitSignatures{
+		Height:     cs.Height,
+		Signatures: make([]cmtstate.CommitSignature, len(cs.Signatures)),
+	}
+	for i, sig := range cs.Signatures {
+		pb.Signatures[i] = cmtstate.CommitSignature{
+			ValidatorAddress: sig.ValidatorAddress,
+			BlockIdFlag:      cmtproto.BlockIDFlag(sig.BlockIDFlag),
+		}
+	}
+	return pb
+}
+
+// CommitSignaturesFromProto converts protobuf to CommitSignatures.
+func CommitSignaturesFromProto(pb *cmtstate.CommitSignatures) *CommitSignatures {
+	cs := &CommitSignatures{
+		Height:     pb.Height,
+		Signatures: make([]CommitSignature, len(pb.Signatures)),
+	}
+	for i, sig := range pb.Signatures {
+		cs.Signatures[i] = CommitSignature{
+			ValidatorAddress: sig.ValidatorAddress,
+			BlockIDFlag:      types.BlockIDFlag(sig.BlockIdFlag),
+		}
+	}
+	return cs
+}
+
+// WindowStats are the numbers of precommits of a validator within the latest
+// Window heights, by how it signed. Heights is the number of heights of the
+// window that were tracked, which the validator may not have been in the
+// validator set of.
+type WindowStats struct {
+	Window  int64 `json:"window"`
+	Heights int64 `json:"heights"`
+	// precommits for the block
+	Signed int64 `json:"signed"`
+	// precommits for nil
+	Missed int64 `json:"missed"`
+	// precommits missing from the commit
+	Absent int64 `json:"absent"`
+}
+
+// ValidatorStats are the WindowStats of a validator, by ascending window.
+type ValidatorStats struct {
+	Address types.Address `json:"address"`
+	Windows []WindowStats `json:"windows"`
+}
+
+type counts struct {
+	signed, missed, absent int64
+}
+
+func (c *counts) add(flag types.BlockIDFlag, n int64) {
+	switch flag {
+	case types.BlockIDFlagCommit:
+		c.signed += n
+	case types.BlockIDFlagNil:
+		c.missed += n
+	case types.BlockIDFlagAbsent:
+		c.absent += n
+	}
+}
+
+func (c *counts) isZero() bool {
+	return c.signed == 0 && c.missed == 0 && c.absent == 0
+}
+
+// window counts the precommits of the validators within the latest size
+// heights.
+type window struct {
+	size    int64
+	heights int64
+	counts  map[string]*counts // by validator address
+}
+
+func (w *window) add(sigs *CommitSignatures, n int64) {
+	w.heights += n
+	for _, sig := range sigs.Signatures {
+		key := string(sig.ValidatorAddress)
+		c, ok := w.counts[key]
+		if !ok {
+			c = &counts{}
+			w.counts[key] = c
+		}
+		c.add(sig.BlockIDFlag, n)
+		if c.isZero() {
+			delete(w.counts, key)
+		}
+	}
+}
+
+// Tracker counts the signed, missed and absent precommits of each validator
+// over sliding windows of heights ending at the latest recorded height. It
+// keeps the CommitSignatures of the heights within the largest window.
+//
+// Tracker is safe for concurrent use.
+type Tracker struct {
+	mtx     cmtsync.RWMutex
+	height  int64
+	windows []*window // by ascending size
+	records map[int64]*CommitSignatures
+}
+
+// NewTracker returns a Tracker counting the precommits over the given window
+// sizes, which must be positive.
+func NewTracker(sizes []int64) *Tracker {
+	sizes = slices.Clone(sizes)
+	slices.Sort(sizes)
+	sizes = slices.Compact(sizes)
+
+	t := &Tracker{records: make(map[int64]*CommitSignatures)}
+	for _, size := range sizes {
+		if size <= 0 {
+			panic(fmt.Sprintf("window size must be positive, got %d", size))
+		}
+		t.windows = append(t.windows, &window{size: size, counts: make(map[string]*counts)})
+	}
+	return t
+}
+
+// Height returns the latest recorded height, or 0 if none.
+func (t *Tracker) Height() int64 {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	return t.height
+}
+
+// MaxWindow returns the size of the largest window.
+func (t *Tracker) MaxWindow() int64 {
+	if len(t.windows) == 0 {
+		return 0
+	}
+	return t.windows[len(t.windows)-1].size
+}
+
+// Record records the signatures of a height, which slides the windows up to
+// it. Heights which aren't above the latest recorded height are ignored, and
+// false is returned.
+func (t *Tracker) Record(sigs *CommitSignatures) bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	height := sigs.Height
+	if height <= t.height {
+		return false
+	}
+	for _, w := range t.windows {
+		if height-t.height >= w.size {
+			w.heights = 0
+			clear(w.counts)
+		} else {
+			// the heights which slide out of the window
+			for h := t.height - w.size + 1; h <= height-w.size; h++ {
+				if old, ok := t.records[h]; ok {
+					w.add(old, -1)
+				}
+			}
+		}
+		w.add(sigs, 1)
+	}
+
+	if height-t.height >= t.MaxWindow() {
+		clear(t.records)
+	} else {
+		for h := t.height - t.MaxWindow() + 1; h <= height-t.MaxWindow(); h++ {
+			delete(t.records, h)
+		}
+	}
+	t.records[height] = sigs
+	t.height = height
+	return true
+}
+
+// Stats returns the stats of the validators which are in the validator set of
+// any height of the largest window, by ascending address.
+func (t *Tracker) Stats() []ValidatorStats {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	if len(t.windows) == 0 {
+		return nil
+	}
+	largest := t.windows[len(t.windows)-1]
+	addresses := make([]types.Address, 0, len(largest.counts))
+	for key := range largest.counts {
+		addresses = append(addresses, types.Address(key))
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i], addresses[j]) < 0
+	})
+
+	stats := make([]ValidatorStats, len(addresses))
+	for i, address := range addresses {
+		stats[i] = t.validatorStats(address)
+	}
+	return stats
+}
+
+// ValidatorStats returns the stats of a validator, and false if it isn't in
+// the validator set of any height of the largest window.
+func (t *Tracker) ValidatorStats(address types.Address) (ValidatorStats, bool) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	if len(t.windows) == 0 {
+		return ValidatorStats{}, false
+	}
+	if _, ok := t.windows[len(t.windows)-1].counts[string(address)]; !ok {
+		return ValidatorStats{}, false
+	}
+	return t.validatorStats(address), true
+}
+
+func (t *Tracker) validatorStats(address types.Address) ValidatorStats {
+	stats := ValidatorStats{Address: address, Windows: make([]WindowStats, len(t.windows))}
+	for i, w := range t.windows {
+		stats.Windows[i] = WindowStats{Window: w.size, Heights: w.heights}
+		if c, ok := w.counts[string(address)]; ok {
+			stats.Windows[i].Signed = c.signed
+			stats.Windows[i].Missed = c.missed
+			stats.Windows[i].Absent = c.absent
+		}
+	}
+	return stats
+}
@@ -0,0 +1,149 @@
+package liveness_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/state/liveness"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	addrA = types.Address("AAAAAAAAAAAAAAAAAAAA")
+	addrB = types.Address("BBBBBBBBBBBBBBBBBBBB")
+)
+
+func makeSigs(height int64, flagA, flagB types.BlockIDFlag) *liveness.CommitSignatures {
+	return &liveness.CommitSignatures{
+		Height: height,
+		Signatures: []liveness.CommitSignature{
+			{ValidatorAddress: addrA, BlockIDFlag: flagA},
+			{ValidatorAddress: addrB, BlockIDFlag: flagB},
+		},
+	}
+}
+
+func TestTrackerSlidingWindows(t *testing.T) {
+	tracker := liveness.NewTracker([]int64{4, 2, 4})
+	assert.EqualValues(t, 4, tracker.MaxWindow())
+	assert.Empty(t, tracker.Stats())
+
+	flagsB := []types.BlockIDFlag{
+		types.BlockIDFlagCommit,
+		types.BlockIDFlagNil,
+		types.BlockIDFlagAbsent,
+		types.BlockIDFlagNil,
+		types.BlockIDFlagCommit,
+	}
+	for i, flag := range flagsB {
+		require.True(t, tracker.Record(makeSigs(int64(i+1), types.BlockIDFlagCommit, flag)))
+	}
+	assert.EqualValues(t, 5, tracker.Height())
+
+	// heights which were already recorded are ignored
+	assert.False(t, tracker.Record(makeSigs(5, types.BlockIDFlagAbsent, types.BlockIDFlagAbsent)))
+	assert.False(t, tracker.Record(makeSigs(3, types.BlockIDFlagAbsent, types.BlockIDFlagAbsent)))
+
+	stats, ok := tracker.ValidatorStats(addrA)
+	require.True(t, ok)
+	assert.Equal(t, []liveness.WindowStats{
+		{Window: 2, Heights: 2, Signed: 2},
+		{Window: 4, Heights: 4, Signed: 4},
+	}, stats.Windows)
+
+	stats, ok = tracker.ValidatorStats(addrB)
+	require.True(t, ok)
+	assert.Equal(t, []liveness.WindowStats{
+		{Window: 2, Heights: 2, Signed: 1, Missed: 1},
+		{Window: 4, Heights: 4, Signed: 1, Missed: 2, Absent: 1},
+	}, stats.Windows)
+
+	all := tracker.Stats()
+	require.Len(t, all, 2)
+	assert.Equal(t, addrA, all[0].Address)
+	assert.Equal(t, addrB, all[1].Address)
+}
+
+func TestTrackerHeightGaps(t *testing.T) {
+	tracker := liveness.NewTracker([]int64{2, 3})
+
+	require.True(t, tracker.Record(makeSigs(1, types.BlockIDFlagNil, types.BlockIDFlagCommit)))
+	require.True(t, tracker.Record(makeSigs(3, types.BlockIDFlagCommit, types.BlockIDFlagCommit)))
+
+	stats, ok := tracker.ValidatorStats(addrA)
+	require.True(t, ok)
+	assert.Equal(t, []liveness.WindowStats{
+		{Window: 2, Heights: 1, Signed: 1},
+		{Window: 3, Heights: 2, Signed: 1, Missed: 1},
+	}, stats.Windows)
+
+	// a gap larger than every window resets them
+	require.True(t, tracker.Record(&liveness.CommitSignatures{
+		Height:     10,
+		Signatures: []liveness.CommitSignature{{ValidatorAddress: addrB, BlockIDFlag: types.BlockIDFlagAbsent}},
+	}))
+	_, ok = tracker.ValidatorStats(addrA)
+	assert.False(t, ok)
+	stats, ok = tracker.ValidatorStats(addrB)
+	require.True(t, ok)
+	assert.Equal(t, []liveness.WindowStats{
+		{Window: 2, Heights: 1, Absent: 1},
+		{Window: 3, Heights: 1, Absent: 1},
+	}, stats.Windows)
+}
+
+func TestTrackerValidatorLeavesSet(t *testing.T) {
+	tracker := liveness.NewTracker([]int64{1, 3})
+
+	require.True(t, tracker.Record(makeSigs(1, types.BlockIDFlagCommit, types.BlockIDFlagCommit)))
+	require.True(t, tracker.Record(&liveness.CommitSignatures{
+		Height:     2,
+		Signatures: []liveness.CommitSignature{{ValidatorAddress: addrA, BlockIDFlag: types.BlockIDFlagCommit}},
+	}))
+
+	// B is still in the largest window, but not in the smallest one
+	stats, ok := tracker.ValidatorStats(addrB)
+	require.True(t, ok)
+	assert.Equal(t, []liveness.WindowStats{
+		{Window: 1, Heights: 1},
+		{Window: 3, Heights: 2, Signed: 1},
+	}, stats.Windows)
+
+	require.True(t, tracker.Record(&liveness.CommitSignatures{
+		Height:     4,
+		Signatures: []liveness.CommitSignature{{ValidatorAddress: addrA, BlockIDFlag: types.BlockIDFlagCommit}},
+	}))
+	_, ok = tracker.ValidatorStats(addrB)
+	assert.False(t, ok)
+	assert.Len(t, tracker.Stats(), 1)
+}
+
+func TestCommitSignaturesProto(t *testing.T) {
+	sigs := makeSigs(7, types.BlockIDFlagCommit, types.BlockIDFlagAbsent)
+	assert.Equal(t, sigs, liveness.CommitSignaturesFromProto(sigs.ToProto()))
+}
+
+func TestNewCommitSignatures(t *testing.T) {
+	vals, _ := types.RandValidatorSet(2, 10)
+	commit := &types.Commit{
+		Height: 3,
+		Signatures: []types.CommitSig{
+			{BlockIDFlag: types.BlockIDFlagCommit},
+			{BlockIDFlag: types.BlockIDFlagNil},
+		},
+	}
+
+	sigs, err := liveness.NewCommitSignatures(commit, vals)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, sigs.Height)
+	assert.Equal(t, []liveness.CommitSignature{
+		{ValidatorAddress: vals.Validators[0].Address, BlockIDFlag: types.BlockIDFlagCommit},
+		{ValidatorAddress: vals.Validators[1].Address, BlockIDFlag: types.BlockIDFlagNil},
+	}, sigs.Signatures)
+
+	commit.Signatures = commit.Signatures[:1]
+	_, err = liveness.NewCommitSignatures(commit, vals)
+	require.Error(t, err)
+}
@@ -34,6 +34,30 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "validator_set_updates",
 			Help:      "ValidatorSetUpdates is the total number of times the application has updated the validator set since process start. metrics:Number of validator set updates returned by the application since process start.",
 		}, labels).With(labelsAndValues...),
+		OptimisticExecutions: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "optimistic_executions",
+			Help:      "Number of optimistic block executions, by outcome: used if the block was decided, discarded if another block was, failed on errors.",
+		}, append(labels, "outcome")).With(labelsAndValues...),
+		LivenessSignedBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "liveness_signed_blocks",
+			Help:      "Number of blocks signed by a validator within the latest window of heights tracked by the liveness service.",
+		}, append(labels, "validator_address", "window")).With(labelsAndValues...),
+		LivenessMissedBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "liveness_missed_blocks",
+			Help:      "Number of blocks a validator precommitted nil for within the latest window of heights tracked by the liveness service.",
+		}, append(labels, "validator_address", "window")).With(labelsAndValues...),
+		LivenessAbsentBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "liveness_absent_blocks",
+			Help:      "Number of blocks a validator's precommit is absent from within the latest window of heights tracked by the liveness service.",
+		}, append(labels, "validator_address", "window")).With(labelsAndValues...),
 	}
 }
 
@@ -42,5 +66,9 @@ func NopMetrics() *Metrics {
 		BlockProcessingTime:   discard.NewHistogram(),
 		ConsensusParamUpdates: discard.NewCounter(),
 		ValidatorSetUpdates:   discard.NewCounter(),
+		OptimisticExecutions:  discard.NewCounter(),
+		LivenessSignedBlocks:  discard.NewGauge(),
+		LivenessMissedBlocks:  discard.NewGauge(),
+		LivenessAbsentBlocks:  discard.NewGauge(),
 	}
 }
@@ -26,4 +26,20 @@ type Metrics struct {
 	// updated the validator set since process start.
 	// metrics:Number of validator set updates returned by the application since process start.
 	ValidatorSetUpdates metrics.Counter
+
+	// Number of optimistic block executions, by outcome: used if the block
+	// was decided, discarded if another block was, failed on errors.
+	OptimisticExecutions metrics.Counter `metrics_labels:"outcome"`
+
+	// Number of blocks signed by a validator within the latest window of
+	// heights tracked by the liveness service.
+	LivenessSignedBlocks metrics.Gauge `metrics_labels:"validator_address,window"`
+
+	// Number of blocks a validator precommitted nil for within the latest
+	// window of heights tracked by the liveness service.
+	LivenessMissedBlocks metrics.Gauge `metrics_labels:"validator_address,window"`
+
+	// Number of blocks a validator's precommit is absent from within the
+	// latest window of heights tracked by the liveness service.
+	LivenessAbsentBlocks metrics.Gauge `metrics_labels:"validator_address,window"`
 }
@@ -301,6 +301,34 @@ func (_m *BlockStore) PruneBlocks(height int64, _a1 state.State) (uint64, int64,
 	return r0, r1, r2
 }
 
+// PruneExtendedCommits provides a mock function with given fields: height
+func (_m *BlockStore) PruneExtendedCommits(height int64) (uint64, error) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneExtendedCommits")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (uint64, error)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) uint64); ok {
+		r0 = rf(height)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveBlock provides a mock function with given fields: block, blockParts, seenCommit
 func (_m *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 	_m.Called(block, blockParts, seenCommit)
@@ -0,0 +1,107 @@
+package state
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Outcomes of optimistic executions, see Metrics.OptimisticExecutions.
+const (
+	optimisticExecutionUsed      = "used"
+	optimisticExecutionDiscarded = "discarded"
+	optimisticExecutionFailed    = "failed"
+)
+
+// optimisticExecution is a FinalizeBlock call for a block which isn't decided
+// yet.
+type optimisticExecution struct {
+	blockID types.BlockID
+	height  int64
+
+	// closed once the call returns
+	done     chan struct{}
+	response *abci.ResponseFinalizeBlock
+	err      error
+}
+
+// ExecuteBlockOptimistically starts executing a block which isn't decided yet,
+// e.g. a proposal accepted by ProcessProposal, in the background. If the block
+// is then decided, ApplyBlock uses the result of this execution instead of
+// calling FinalizeBlock, so the execution time doesn't add to the block time.
+// Otherwise the result is discarded, and FinalizeBlock is called again for the
+// decided block. An optimistic execution of another block discards the
+// previous one.
+//
+// The application must support FinalizeBlock being called several times for a
+// height before Commit: the state changes of the previous calls must be
+// discarded, as if the node had crashed before Commit. The calls remain
+// sequential, but FinalizeBlock may be called before the node has voted, e.g.
+// before ExtendVote.
+func (blockExec *BlockExecutor) ExecuteBlockOptimistically(state State, blockID types.BlockID, block *types.Block) {
+	blockExec.optimisticMtx.Lock()
+	defer blockExec.optimisticMtx.Unlock()
+
+	prev := blockExec.optimistic
+	if prev != nil && prev.height == block.Height && prev.blockID.Equals(blockID) {
+		return
+	}
+
+	oe := &optimisticExecution{
+		blockID: blockID,
+		height:  block.Height,
+		done:    make(chan struct{}),
+	}
+	blockExec.optimistic = oe
+	req := blockExec.finalizeBlockRequest(state, block)
+
+	blockExec.logger.Debug("executing block optimistically", "height", block.Height, "hash", blockID.Hash)
+	go func() {
+		defer close(oe.done)
+		if prev != nil {
+			blockExec.discardOptimisticExecution(prev)
+		}
+		// The call isn't cancelled when the execution is discarded: socket
+		// and gRPC clients would stop waiting for the response, but not the
+		// application, which would then see the next call too early.
+		oe.response, oe.err = blockExec.execFinalizeBlock(context.Background(), req)
+	}()
+}
+
+// optimisticExecutionResponse returns the response of the optimistic execution
+// of the given block, waiting for it to complete, or nil if the block wasn't
+// executed optimistically or its execution failed. The optimistic executions of
+// other blocks are discarded.
+func (blockExec *BlockExecutor) optimisticExecutionResponse(blockID types.BlockID, height int64) *abci.ResponseFinalizeBlock {
+	blockExec.optimisticMtx.Lock()
+	oe := blockExec.optimistic
+	blockExec.optimistic = nil
+	blockExec.optimisticMtx.Unlock()
+
+	if oe == nil {
+		return nil
+	}
+	if oe.height != height || !oe.blockID.Equals(blockID) {
+		blockExec.discardOptimisticExecution(oe)
+		return nil
+	}
+
+	<-oe.done
+	if oe.err != nil {
+		blockExec.logger.Error("optimistic execution failed; executing the block again",
+			"height", height, "err", oe.err)
+		blockExec.metrics.OptimisticExecutions.With("outcome", optimisticExecutionFailed).Add(1)
+		return nil
+	}
+	blockExec.metrics.OptimisticExecutions.With("outcome", optimisticExecutionUsed).Add(1)
+	return oe.response
+}
+
+// discardOptimisticExecution waits for the optimistic execution to return, so
+// that the next call to the application follows its response.
+func (blockExec *BlockExecutor) discardOptimisticExecution(oe *optimisticExecution) {
+	<-oe.done
+	blockExec.logger.Debug("discarded optimistic execution", "height", oe.height, "hash", oe.blockID.Hash)
+	blockExec.metrics.OptimisticExecutions.With("outcome", optimisticExecutionDiscarded).Add(1)
+}
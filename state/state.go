@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
@@ -270,6 +271,24 @@ func (state State) ValidateBlock(block *types.Block) error {
 	return validateBlock(state, block)
 }
 
+// ProposerSelector returns the selector of the proposers of the rounds of the
+// next block, see types.ProposerParams.
+func (state State) ProposerSelector() types.ProposerSelector {
+	return types.NewProposerSelector(state.ConsensusParams.Proposer, state.LastBlockID.Hash)
+}
+
+// VerifyProposer returns an error if the next block, decided in the given
+// round, wasn't proposed by the proposer of that round. Only the proposers
+// selected by hash are verified: the proposer priorities of the later rounds
+// of the weighted round-robin may depend on the rounds a validator went
+// through, so its proposers were never part of the validity of a block.
+func (state State) VerifyProposer(block *types.Block, round int32) error {
+	if state.ConsensusParams.Proposer.Selection == cmtproto.ProposerSelectionRoundRobin {
+		return nil
+	}
+	return state.Validators.VerifyProposer(state.ProposerSelector(), round, block.ProposerAddress)
+}
+
 // MedianTime computes a median time for a given Commit (based on Timestamp field of votes messages) and the
 // corresponding validator set. The computed time is always between timestamps of
 // the votes sent by honest processes, i.e., a faulty processes can not arbitrarily increase or decrease the
@@ -19,6 +19,7 @@ import (
 	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	"github.com/cometbft/cometbft/internal/test"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
 )
@@ -72,6 +73,29 @@ func TestStateCopy(t *testing.T) {
 }
 
 // TestMakeGenesisStateNilValidators tests state's consistency when genesis file's validators field is nil.
+func TestStateVerifyProposer(t *testing.T) {
+	state, _, _ := makeState(4, 1)
+	block, err := makeBlock(state, state.LastBlockHeight+1, new(types.Commit))
+	require.NoError(t, err)
+
+	// the round-robin proposers aren't verified
+	require.NoError(t, state.VerifyProposer(block, 2))
+
+	state.ConsensusParams.Proposer.Selection = cmtproto.ProposerSelectionHash
+	for round := int32(0); round < 4; round++ {
+		proposer := state.ProposerSelector().Proposer(state.Validators, round)
+		for _, val := range state.Validators.Validators {
+			block.ProposerAddress = val.Address
+			err := state.VerifyProposer(block, round)
+			if bytes.Equal(val.Address, proposer.Address) {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		}
+	}
+}
+
 func TestMakeGenesisStateNilValidators(t *testing.T) {
 	doc := types.GenesisDoc{
 		ChainID:    "dummy",
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/cometbft/cometbft/libs/log"
 
@@ -36,17 +37,37 @@ var _ txindex.TxIndexer = (*TxIndex)(nil)
 // TxIndex is the simplest possible indexer, backed by key-value storage (levelDB).
 type TxIndex struct {
 	store dbm.DB
+	// Serializes indexing, which may happen concurrently when reindexing
+	// a running node
+	mtx sync.Mutex
 	// Number the events in the event list
 	eventSeq int64
+	// Selects the event attributes to index; nil indexes all of them
+	filter *indexer.AttributeFilter
 
 	log log.Logger
 }
 
+// IndexerOption sets an optional parameter on the TxIndex.
+type IndexerOption func(*TxIndex)
+
+// WithAttributeFilter restricts the event attributes indexed by the TxIndex
+// to those allowed by f.
+func WithAttributeFilter(f *indexer.AttributeFilter) IndexerOption {
+	return func(txi *TxIndex) {
+		txi.filter = f
+	}
+}
+
 // NewTxIndex creates new KV indexer.
-func NewTxIndex(store dbm.DB) *TxIndex {
-	return &TxIndex{
+func NewTxIndex(store dbm.DB, options ...IndexerOption) *TxIndex {
+	txi := &TxIndex{
 		store: store,
 	}
+	for _, option := range options {
+		option(txi)
+	}
+	return txi
 }
 
 func (txi *TxIndex) SetLogger(l log.Logger) {
@@ -82,6 +103,9 @@ func (txi *TxIndex) Get(hash []byte) (*abci.TxResult, error) {
 // the respective attribute's key delimited by a "." (eg. "account.number").
 // Any event with an empty type is not indexed.
 func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
+	txi.mtx.Lock()
+	defer txi.mtx.Unlock()
+
 	storeBatch := txi.store.NewBatch()
 	defer storeBatch.Close()
 
@@ -124,6 +148,9 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 // more transactions that successfully executed overwrite transactions that failed
 // or successful yet older transactions.
 func (txi *TxIndex) Index(result *abci.TxResult) error {
+	txi.mtx.Lock()
+	defer txi.mtx.Unlock()
+
 	b := txi.store.NewBatch()
 	defer b.Close()
 
@@ -186,7 +213,7 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 			if compositeTag == types.TxHashKey || compositeTag == types.TxHeightKey {
 				return fmt.Errorf("event type and attribute key \"%s\" is reserved; please use a different key", compositeTag)
 			}
-			if attr.GetIndex() {
+			if attr.GetIndex() && txi.filter.Allows(compositeTag, attr.Value) {
 				err := store.Set(keyForEvent(compositeTag, attr.Value, result, txi.eventSeq), hash)
 				if err != nil {
 					return err
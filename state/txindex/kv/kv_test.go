@@ -15,6 +15,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
@@ -154,6 +155,47 @@ func TestTxSearch(t *testing.T) {
 	}
 }
 
+func TestTxSearchWithAttributeFilter(t *testing.T) {
+	filter, err := indexer.NewAttributeFilter([]string{"account.*"}, []string{"account.memo"}, 8)
+	require.NoError(t, err)
+	txi := NewTxIndex(db.NewMemDB(), WithAttributeFilter(filter))
+
+	txResult := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "number", Value: "1", Index: true},
+			{Key: "memo", Value: "hello", Index: true},
+			{Key: "owner", Value: "Ivan Ivanovich", Index: true},
+		}},
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "send", Index: true}}},
+	})
+	require.NoError(t, txi.Index(txResult))
+
+	testCases := []struct {
+		q             string
+		resultsLength int
+	}{
+		// reserved keys are always indexed
+		{fmt.Sprintf("tx.hash = '%X'", types.Tx(txResult.Tx).Hash()), 1},
+		{"tx.height = 1", 1},
+		// allowed
+		{"account.number = 1", 1},
+		// denied
+		{"account.memo = 'hello'", 0},
+		// value too large
+		{"account.owner EXISTS", 0},
+		// not in the allow list
+		{"message.action = 'send'", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := txi.Search(context.Background(), query.MustCompile(tc.q))
+			require.NoError(t, err)
+			assert.Len(t, results, tc.resultsLength)
+		})
+	}
+}
+
 func TestTxSearchEventMatch(t *testing.T) {
 	indexer := NewTxIndex(db.NewMemDB())
 
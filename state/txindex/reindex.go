@@ -0,0 +1,251 @@
+package txindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	// ErrReindexRunning is returned when starting a reindex job while another
+	// one is in progress.
+	ErrReindexRunning = errors.New("a reindex job is already running")
+	// ErrReindexNotRunning is returned when cancelling a reindex job while
+	// none is in progress.
+	ErrReindexNotRunning = errors.New("no reindex job is running")
+)
+
+// ReindexBlockStore is the subset of the block store used to reindex events.
+type ReindexBlockStore interface {
+	Base() int64
+	Height() int64
+	LoadBlock(height int64) *types.Block
+}
+
+// ReindexStateStore is the subset of the state store used to reindex events.
+type ReindexStateStore interface {
+	LoadFinalizeBlockResponse(height int64) (*abci.ResponseFinalizeBlock, error)
+}
+
+// IndexHeight indexes the block and transaction events of the block at the
+// given height, as stored in the block and state stores. The block events are
+// indexed before the transactions, as done by the IndexerService.
+func IndexHeight(
+	height int64,
+	blockStore ReindexBlockStore,
+	stateStore ReindexStateStore,
+	txIdxr TxIndexer,
+	blockIdxr indexer.BlockIndexer,
+) error {
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return fmt.Errorf("not able to load block at height %d from the blockstore", height)
+	}
+
+	resp, err := stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return fmt.Errorf("not able to load ABCI Response at height %d from the statestore: %w", height, err)
+	}
+
+	numTxs := len(resp.TxResults)
+	e := types.EventDataNewBlockEvents{
+		Height: height,
+		Events: resp.Events,
+		NumTxs: int64(numTxs),
+	}
+	if err := blockIdxr.Index(e); err != nil {
+		return fmt.Errorf("block event re-index at height %d failed: %w", height, err)
+	}
+
+	if numTxs == 0 {
+		return nil
+	}
+	batch := NewBatch(int64(numTxs))
+	for idx, txResult := range resp.TxResults {
+		tr := abci.TxResult{
+			Height: height,
+			Index:  uint32(idx),
+			Tx:     block.Txs[idx],
+			Result: *txResult,
+		}
+		if err := batch.Add(&tr); err != nil {
+			return fmt.Errorf("adding tx to batch: %w", err)
+		}
+	}
+	if err := txIdxr.AddBatch(batch); err != nil {
+		return fmt.Errorf("tx event re-index at height %d failed: %w", height, err)
+	}
+	return nil
+}
+
+// ReindexStatus reports the progress of a reindex job.
+type ReindexStatus struct {
+	Running bool `json:"running"`
+	// The range of heights being reindexed, inclusive.
+	StartHeight int64 `json:"start_height"`
+	EndHeight   int64 `json:"end_height"`
+	// The last height reindexed, or StartHeight-1 if none was.
+	LastHeight int64     `json:"last_height"`
+	StartedAt  time.Time `json:"started_at"`
+	// Zero while the job is running.
+	FinishedAt time.Time `json:"finished_at"`
+	Cancelled  bool      `json:"cancelled"`
+	// The error that terminated the job, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Reindexer reindexes the events of a range of heights in the background,
+// from the blocks and FinalizeBlock responses saved by the node. It writes to
+// the same indexers as the IndexerService, which keeps indexing new blocks
+// while a job runs. At most one job runs at a time.
+type Reindexer struct {
+	blockStore ReindexBlockStore
+	stateStore ReindexStateStore
+	txIdxr     TxIndexer
+	blockIdxr  indexer.BlockIndexer
+	logger     log.Logger
+
+	mtx    sync.Mutex
+	status ReindexStatus
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewReindexer returns a Reindexer writing to the given indexers.
+func NewReindexer(
+	blockStore ReindexBlockStore,
+	stateStore ReindexStateStore,
+	txIdxr TxIndexer,
+	blockIdxr indexer.BlockIndexer,
+) *Reindexer {
+	return &Reindexer{
+		blockStore: blockStore,
+		stateStore: stateStore,
+		txIdxr:     txIdxr,
+		blockIdxr:  blockIdxr,
+		logger:     log.NewNopLogger(),
+	}
+}
+
+// SetLogger sets the logger of the Reindexer.
+func (r *Reindexer) SetLogger(l log.Logger) {
+	r.logger = l
+}
+
+// Start starts reindexing the heights from startHeight to endHeight,
+// inclusive, and returns the status of the new job. A zero startHeight
+// defaults to the base of the block store, and a zero endHeight to its
+// current height.
+func (r *Reindexer) Start(startHeight, endHeight int64) (ReindexStatus, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.status.Running {
+		return r.status, ErrReindexRunning
+	}
+
+	base, height := r.blockStore.Base(), r.blockStore.Height()
+	if startHeight == 0 {
+		startHeight = base
+	}
+	if endHeight == 0 {
+		endHeight = height
+	}
+	switch {
+	case startHeight < base || startHeight > height:
+		return ReindexStatus{}, fmt.Errorf("start height %d is not within the blockstore range [%d, %d]",
+			startHeight, base, height)
+	case endHeight < base || endHeight > height:
+		return ReindexStatus{}, fmt.Errorf("end height %d is not within the blockstore range [%d, %d]",
+			endHeight, base, height)
+	case endHeight < startHeight:
+		return ReindexStatus{}, fmt.Errorf("end height %d is less than the start height %d", endHeight, startHeight)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	r.status = ReindexStatus{
+		Running:     true,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		LastHeight:  startHeight - 1,
+		StartedAt:   time.Now(),
+	}
+	go r.run(ctx, r.done)
+
+	r.logger.Info("Started reindexing events", "start_height", startHeight, "end_height", endHeight)
+	return r.status, nil
+}
+
+func (r *Reindexer) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	r.mtx.Lock()
+	start, end := r.status.StartHeight, r.status.EndHeight
+	r.mtx.Unlock()
+
+	var err error
+	for height := start; height <= end && ctx.Err() == nil; height++ {
+		if err = IndexHeight(height, r.blockStore, r.stateStore, r.txIdxr, r.blockIdxr); err != nil {
+			break
+		}
+		r.mtx.Lock()
+		r.status.LastHeight = height
+		r.mtx.Unlock()
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.status.Running = false
+	r.status.FinishedAt = time.Now()
+	switch {
+	case err != nil:
+		r.status.Error = err.Error()
+		r.logger.Error("Reindexing events failed", "last_height", r.status.LastHeight, "err", err)
+	case ctx.Err() != nil:
+		r.status.Cancelled = true
+		r.logger.Info("Reindexing events cancelled", "last_height", r.status.LastHeight)
+	default:
+		r.logger.Info("Finished reindexing events", "start_height", start, "end_height", end)
+	}
+	r.cancel()
+}
+
+// Cancel stops the running job, waits for it to terminate and returns its
+// final status.
+func (r *Reindexer) Cancel() (ReindexStatus, error) {
+	r.mtx.Lock()
+	if !r.status.Running {
+		defer r.mtx.Unlock()
+		return r.status, ErrReindexNotRunning
+	}
+	cancel, done := r.cancel, r.done
+	r.mtx.Unlock()
+
+	cancel()
+	<-done
+	return r.Status(), nil
+}
+
+// Status returns the status of the running job, or of the last one if none
+// is running.
+func (r *Reindexer) Status() ReindexStatus {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.status
+}
+
+// Stop cancels the running job, if any, and waits for it to terminate.
+func (r *Reindexer) Stop() {
+	if _, err := r.Cancel(); err != nil && !errors.Is(err, ErrReindexNotRunning) {
+		r.logger.Error("Failed to cancel reindexing", "err", err)
+	}
+}
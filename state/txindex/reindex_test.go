@@ -0,0 +1,153 @@
+package txindex_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/types"
+)
+
+// reindexStores serves blocks of one transaction each, for the heights from
+// base to height. If gate is not nil, loading a FinalizeBlock response
+// blocks until gate is closed.
+type reindexStores struct {
+	base, height int64
+	gate         chan struct{}
+}
+
+func (s *reindexStores) Base() int64   { return s.base }
+func (s *reindexStores) Height() int64 { return s.height }
+
+func (s *reindexStores) LoadBlock(height int64) *types.Block {
+	if height < s.base || height > s.height {
+		return nil
+	}
+	return &types.Block{Data: types.Data{Txs: types.Txs{reindexTx(height)}}}
+}
+
+func (s *reindexStores) LoadFinalizeBlockResponse(height int64) (*abci.ResponseFinalizeBlock, error) {
+	if s.gate != nil {
+		<-s.gate
+	}
+	if height == 0 {
+		return nil, fmt.Errorf("no response at height %d", height)
+	}
+	return &abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{{Code: abci.CodeTypeOK}},
+		Events: []abci.Event{{
+			Type:       "begin_event",
+			Attributes: []abci.EventAttribute{{Key: "proposer", Value: "FCAA001", Index: true}},
+		}},
+	}, nil
+}
+
+func reindexTx(height int64) types.Tx {
+	return types.Tx(fmt.Sprintf("tx at height %d", height))
+}
+
+func waitReindexed(t *testing.T, r *txindex.Reindexer) txindex.ReindexStatus {
+	t.Helper()
+	require.Eventually(t, func() bool { return !r.Status().Running }, 5*time.Second, 10*time.Millisecond)
+	return r.Status()
+}
+
+func TestReindexer(t *testing.T) {
+	stores := &reindexStores{base: 3, height: 10}
+	store := db.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
+
+	r := txindex.NewReindexer(stores, stores, txIndexer, blockIndexer)
+
+	status, err := r.Start(0, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, status.StartHeight)
+	assert.EqualValues(t, 10, status.EndHeight)
+
+	status = waitReindexed(t, r)
+	assert.EqualValues(t, 10, status.LastHeight)
+	assert.Empty(t, status.Error)
+	assert.False(t, status.Cancelled)
+	assert.False(t, status.FinishedAt.IsZero())
+
+	for height := int64(3); height <= 10; height++ {
+		has, err := blockIndexer.Has(height)
+		require.NoError(t, err)
+		assert.True(t, has, "height %d", height)
+
+		txr, err := txIndexer.Get(reindexTx(height).Hash())
+		require.NoError(t, err)
+		require.NotNil(t, txr, "height %d", height)
+		assert.Equal(t, height, txr.Height)
+	}
+
+	_, err = r.Cancel()
+	require.ErrorIs(t, err, txindex.ErrReindexNotRunning)
+}
+
+func TestReindexerInvalidRange(t *testing.T) {
+	stores := &reindexStores{base: 3, height: 10}
+	r := txindex.NewReindexer(stores, stores, kv.NewTxIndex(db.NewMemDB()), blockidxkv.New(db.NewMemDB()))
+
+	testCases := []struct {
+		start, end int64
+	}{
+		{2, 10},
+		{3, 11},
+		{11, 0},
+		{8, 5},
+	}
+	for _, tc := range testCases {
+		_, err := r.Start(tc.start, tc.end)
+		require.Error(t, err, "range [%d, %d]", tc.start, tc.end)
+	}
+	assert.False(t, r.Status().Running)
+}
+
+func TestReindexerError(t *testing.T) {
+	stores := &reindexStores{base: 0, height: 5}
+	r := txindex.NewReindexer(stores, stores, kv.NewTxIndex(db.NewMemDB()), blockidxkv.New(db.NewMemDB()))
+
+	_, err := r.Start(0, 5)
+	require.NoError(t, err)
+
+	status := waitReindexed(t, r)
+	assert.EqualValues(t, -1, status.LastHeight)
+	assert.Contains(t, status.Error, "height 0")
+	assert.False(t, status.Cancelled)
+}
+
+func TestReindexerCancel(t *testing.T) {
+	stores := &reindexStores{base: 1, height: 100, gate: make(chan struct{})}
+	r := txindex.NewReindexer(stores, stores, kv.NewTxIndex(db.NewMemDB()), blockidxkv.New(db.NewMemDB()))
+
+	_, err := r.Start(1, 100)
+	require.NoError(t, err)
+
+	_, err = r.Start(1, 100)
+	require.ErrorIs(t, err, txindex.ErrReindexRunning)
+
+	// Let the height being loaded complete once the job is cancelled.
+	time.AfterFunc(50*time.Millisecond, func() { close(stores.gate) })
+	status, err := r.Cancel()
+	require.NoError(t, err)
+	assert.False(t, status.Running)
+	assert.True(t, status.Cancelled)
+	assert.Less(t, status.LastHeight, int64(100))
+
+	// A new job can be started once the previous one terminated.
+	_, err = r.Start(1, 100)
+	require.NoError(t, err)
+	r.Stop()
+	assert.False(t, r.Status().Running)
+}
@@ -16,6 +16,10 @@ import (
 type blockValidationOptions struct {
 	blockTimeTolerance         time.Duration
 	skipLastCommitVerification bool
+	// wall clock, time.Now if nil
+	now func() time.Time
+	// verified signatures of the LastCommit, may be nil
+	signatureCache *types.SignatureCache
 }
 
 func validateBlock(state State, block *types.Block, opts ...func(*blockValidationOptions)) error {
@@ -99,8 +103,8 @@ func validateBlock(state State, block *types.Block, opts ...func(*blockValidatio
 		}
 	} else if !vopts.skipLastCommitVerification {
 		// LastCommit.Signatures length is checked in VerifyCommit.
-		if err := state.LastValidators.VerifyCommit(
-			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit); err != nil {
+		if err := state.LastValidators.VerifyCommitWithCache(
+			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit, vopts.signatureCache); err != nil {
 			return err
 		}
 	}
@@ -121,11 +125,17 @@ func validateBlock(state State, block *types.Block, opts ...func(*blockValidatio
 	}
 
 	// Validate block Time
-	if tol := vopts.blockTimeTolerance; tol > 0 && !block.Time.Before(time.Now().Add(tol)) {
-		return fmt.Errorf(
-			"block time %v is too far in the future (wall clock %v + tolerance %v)",
-			block.Time, time.Now(), tol,
-		)
+	now := time.Now
+	if vopts.now != nil {
+		now = vopts.now
+	}
+	if tol := vopts.blockTimeTolerance; tol > 0 {
+		if wallClock := now(); !block.Time.Before(wallClock.Add(tol)) {
+			return fmt.Errorf(
+				"block time %v is too far in the future (wall clock %v + tolerance %v)",
+				block.Time, wallClock, tol,
+			)
+		}
 	}
 	switch {
 	case block.Height > state.InitialHeight:
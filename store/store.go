@@ -3,6 +3,7 @@ package store
 import (
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 
 	cmterrors "github.com/cometbft/cometbft/types/errors"
@@ -57,6 +58,9 @@ type BlockStore struct {
 	mtx    cmtsync.RWMutex
 	base   int64
 	height int64
+	// extCommitBase is the first height whose extended commit may still be
+	// stored, see PruneExtendedCommits.
+	extCommitBase int64
 
 	seenCommitCache          *lru.Cache[int64, *types.Commit]
 	blockCommitCache         *lru.Cache[int64, *types.Commit]
@@ -68,9 +72,10 @@ type BlockStore struct {
 func NewBlockStore(db dbm.DB) *BlockStore {
 	bs := LoadBlockStoreState(db)
 	bStore := &BlockStore{
-		base:   bs.Base,
-		height: bs.Height,
-		db:     db,
+		base:          bs.Base,
+		height:        bs.Height,
+		extCommitBase: bs.ExtendedCommitBase,
+		db:            db,
 	}
 	bStore.addCaches()
 	return bStore
@@ -142,17 +147,20 @@ func (bs *BlockStore) LoadBlockProto(height int64) *cmtproto.Block {
 	}
 
 	pbb := new(cmtproto.Block)
-	buf := []byte{}
-	for i := 0; i < int(blockMeta.BlockID.PartSetHeader.Total); i++ {
+	parts := make([]*types.Part, blockMeta.BlockID.PartSetHeader.Total)
+	for i := range parts {
 		part := bs.LoadBlockPart(height, i)
 		// If the part is missing (e.g. since it has been deleted after we
 		// loaded the block meta) we consider the whole block to be missing.
 		if part == nil {
 			return nil
 		}
-		buf = append(buf, part.Bytes...)
+		parts[i] = part
+	}
+	buf, err := io.ReadAll(types.NewBlockPartsReader(parts))
+	if err == nil {
+		err = proto.Unmarshal(buf, pbb)
 	}
-	err := proto.Unmarshal(buf, pbb)
 	if err != nil {
 		// NOTE: The existence of meta should imply the existence of the
 		// block. So, make sure meta is only saved after blocks are saved.
@@ -609,11 +617,64 @@ func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part, b
 	}
 }
 
+// PruneExtendedCommits removes the extended commits up to (but not including)
+// a height, keeping their blocks and commits. It returns the number of heights
+// whose extended commits were pruned.
+func (bs *BlockStore) PruneExtendedCommits(height int64) (uint64, error) {
+	if height <= 0 {
+		return 0, fmt.Errorf("height must be greater than 0")
+	}
+	bs.mtx.RLock()
+	if height > bs.height {
+		bs.mtx.RUnlock()
+		return 0, fmt.Errorf("cannot prune beyond the latest height %v", bs.height)
+	}
+	base := max(bs.base, bs.extCommitBase)
+	bs.mtx.RUnlock()
+	if height <= base {
+		return 0, nil
+	}
+
+	pruned := uint64(0)
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+	flush := func(batch dbm.Batch, base int64) error {
+		bs.mtx.Lock()
+		defer batch.Close()
+		defer bs.mtx.Unlock()
+		bs.extCommitBase = base
+		return bs.saveStateAndWriteDB(batch, "failed to prune extended commits")
+	}
+
+	for h := base; h < height; h++ {
+		if err := batch.Delete(calcExtCommitKey(h)); err != nil {
+			return 0, err
+		}
+		bs.blockExtendedCommitCache.Remove(h)
+		pruned++
+
+		// flush every 1000 heights to avoid batches becoming too large
+		if pruned%1000 == 0 {
+			if err := flush(batch, h); err != nil {
+				return 0, err
+			}
+			batch = bs.db.NewBatch()
+			defer batch.Close()
+		}
+	}
+
+	if err := flush(batch, height); err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
+
 // Contract: the caller MUST have, at least, a read lock on `bs`.
 func (bs *BlockStore) saveStateAndWriteDB(batch dbm.Batch, errMsg string) error {
 	bss := cmtstore.BlockStoreState{
-		Base:   bs.base,
-		Height: bs.height,
+		Base:               bs.base,
+		Height:             bs.height,
+		ExtendedCommitBase: bs.extCommitBase,
 	}
 	SaveBlockStoreStateBatch(&bss, batch)
 
@@ -89,6 +89,10 @@ func TestLoadBlockStoreState(t *testing.T) {
 		},
 		{"empty", &cmtstore.BlockStoreState{}, cmtstore.BlockStoreState{}},
 		{"no base", &cmtstore.BlockStoreState{Height: 1000}, cmtstore.BlockStoreState{Base: 1, Height: 1000}},
+		{
+			"extended commit base", &cmtstore.BlockStoreState{Base: 100, Height: 1000, ExtendedCommitBase: 500},
+			cmtstore.BlockStoreState{Base: 100, Height: 1000, ExtendedCommitBase: 500},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -409,6 +413,26 @@ func TestLoadBlockProto(t *testing.T) {
 	require.Equal(t, direct.Hash(), loaded.Hash())
 }
 
+func TestLoadErasureCodedBlock(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore()
+	defer cleanup()
+
+	block, err := state.MakeBlock(1, nil, new(types.Commit), nil, state.Validators.GetProposer().Address)
+	require.NoError(t, err)
+	partSet, err := block.MakeErasureCodedPartSet(types.BlockPartSizeBytes, 1)
+	require.NoError(t, err)
+	seenCommit := makeTestExtCommit(1, cmttime.Now()).ToCommit()
+	bs.SaveBlock(block, partSet, seenCommit)
+
+	// all the parts are saved, and the block is decoded from them
+	require.EqualValues(t, 2, bs.LoadBlockMeta(1).BlockID.PartSetHeader.Total)
+	require.Equal(t, partSet.GetPart(1), bs.LoadBlockPart(1, 1))
+
+	loaded := bs.LoadBlock(1)
+	require.NotNil(t, loaded)
+	require.Equal(t, block.Hash(), loaded.Hash())
+}
+
 // TestSaveBlockWithExtendedCommitPanicOnAbsentExtension tests that saving a
 // block with an extended commit panics when the extension data is absent.
 func TestSaveBlockWithExtendedCommitPanicOnAbsentExtension(t *testing.T) {
@@ -684,6 +708,72 @@ func TestPruneBlocks(t *testing.T) {
 	assert.Nil(t, bs.LoadBlock(1501))
 }
 
+func TestPruneExtendedCommits(t *testing.T) {
+	config := test.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	db := dbm.NewMemDB()
+	bs := NewBlockStore(db)
+
+	// pruning an empty store should error
+	_, err = bs.PruneExtendedCommits(1)
+	require.Error(t, err)
+
+	// make more than 1000 blocks, to test batch deletions
+	for h := int64(1); h <= 1500; h++ {
+		block, err := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		require.NoError(t, err)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		seenCommit := makeTestExtCommit(h, cmttime.Now())
+		bs.SaveBlockWithExtendedCommit(block, partSet, seenCommit)
+	}
+	require.NotNil(t, bs.LoadBlockExtendedCommit(1100)) // cached
+
+	pruned, err := bs.PruneExtendedCommits(1200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1199, pruned)
+
+	// the blocks and their commits are kept
+	assert.EqualValues(t, 1, bs.Base())
+	require.NotNil(t, bs.LoadBlock(1))
+	require.NotNil(t, bs.LoadBlockCommit(1))
+	require.NotNil(t, bs.LoadSeenCommit(1))
+	for h := int64(1); h < 1200; h++ {
+		require.Nil(t, bs.LoadBlockExtendedCommit(h))
+	}
+	require.NotNil(t, bs.LoadBlockExtendedCommit(1200))
+
+	// pruning below the current extended commit base is a no-op
+	pruned, err = bs.PruneExtendedCommits(1100)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	// pruning beyond the current height should error
+	_, err = bs.PruneExtendedCommits(1501)
+	require.Error(t, err)
+
+	// the extended commit base is persisted
+	bs = NewBlockStore(db)
+	assert.EqualValues(t, 1200, LoadBlockStoreState(db).ExtendedCommitBase)
+	pruned, err = bs.PruneExtendedCommits(1300)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, pruned)
+	require.Nil(t, bs.LoadBlockExtendedCommit(1299))
+	require.NotNil(t, bs.LoadBlockExtendedCommit(1300))
+
+	// pruning blocks beyond the extended commit base moves it along
+	_, _, err = bs.PruneBlocks(1400, state)
+	require.NoError(t, err)
+	pruned, err = bs.PruneExtendedCommits(1450)
+	require.NoError(t, err)
+	assert.EqualValues(t, 50, pruned)
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := newInMemoryBlockStore()
 	height := int64(10)
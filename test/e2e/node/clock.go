@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// clockSkewPollInterval is how often the clock skew file is read.
+const clockSkewPollInterval = time.Second
+
+// skewedClock is the consensus clock of the node, skewed by the duration in
+// the e2e.ClockSkewFile of its home directory, which the runner writes to
+// perturb the node.
+type skewedClock struct {
+	file   string
+	logger log.Logger
+	skew   atomic.Int64
+}
+
+// newSkewedClock returns the clock of the node with the given home directory,
+// reading the clock skew file periodically.
+func newSkewedClock(home string, logger log.Logger) *skewedClock {
+	c := &skewedClock{
+		file:   filepath.Join(home, e2e.ClockSkewFile),
+		logger: logger,
+	}
+	c.update()
+	go func() {
+		for range time.Tick(clockSkewPollInterval) {
+			c.update()
+		}
+	}()
+	return c
+}
+
+// Now returns the current time of the clock.
+func (c *skewedClock) Now() time.Time {
+	return cmttime.Now().Add(time.Duration(c.skew.Load()))
+}
+
+func (c *skewedClock) update() {
+	var skew time.Duration
+	bz, err := os.ReadFile(c.file)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		c.logger.Error("failed to read clock skew", "file", c.file, "err", err)
+		return
+	default:
+		skew, err = time.ParseDuration(strings.TrimSpace(string(bz)))
+		if err != nil {
+			c.logger.Error("invalid clock skew", "file", c.file, "err", err)
+			return
+		}
+	}
+	if old := time.Duration(c.skew.Swap(int64(skew))); old != skew {
+		c.logger.Info("clock skew changed", "skew", skew, "previous", old)
+	}
+}
@@ -76,6 +76,8 @@ services:
     entrypoint: /usr/bin/entrypoint-builtin
 {{- end }}
     init: true
+    cap_add:
+    - NET_ADMIN
     ports:
     - 26656
     - {{ if .ProxyPort }}{{ .ProxyPort }}:{{ end }}26657
@@ -102,6 +104,8 @@ services:
     entrypoint: /usr/bin/entrypoint-builtin
 {{- end }}
     init: true
+    cap_add:
+    - NET_ADMIN
     ports:
     - 26656
     - {{ if .ProxyPort }}{{ .ProxyPort }}:{{ end }}26657
@@ -156,3 +160,8 @@ func ExecComposeVerbose(ctx context.Context, dir string, args ...string) error {
 func Exec(ctx context.Context, args ...string) error {
 	return exec.Command(ctx, append([]string{"docker"}, args...)...)
 }
+
+// ExecOutput runs a Docker command and returns the command's output.
+func ExecOutput(ctx context.Context, args ...string) ([]byte, error) {
+	return exec.CommandOutput(ctx, append([]string{"docker"}, args...)...)
+}
@@ -0,0 +1,75 @@
+package docker
+
+import (
+	"context"
+	"net"
+	"strings"
+)
+
+// netemInterface is the network interface of the containers of a testnet.
+const netemInterface = "eth0"
+
+// SetNetem applies a netem queueing discipline with the given parameters, e.g.
+// "delay 100ms 10ms" or "loss 100%", to the traffic a container sends to the
+// given IPs. It requires the NET_ADMIN capability and replaces any previous
+// one, see ResetNetem.
+//
+// The traffic to other IPs goes through a band of a prio queueing discipline
+// whose priority map routes all traffic to it, while the traffic to the given
+// IPs is filtered into a band with the netem discipline.
+func SetNetem(ctx context.Context, container string, dsts []net.IP, netem ...string) error {
+	if err := ResetNetem(ctx, container); err != nil {
+		return err
+	}
+
+	err := tc(ctx, container, "qdisc", "add", "dev", netemInterface, "root", "handle", "1:",
+		"prio", "bands", "4", "priomap", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1")
+	if err != nil {
+		return err
+	}
+	err = tc(ctx, container, append([]string{"qdisc", "add", "dev", netemInterface, "parent", "1:4", "handle", "40:", "netem"}, netem...)...)
+	if err != nil {
+		return err
+	}
+	for _, dst := range dsts {
+		protocol, match, prefix := "ip", "ip", "/32"
+		if dst.To4() == nil {
+			protocol, match, prefix = "ipv6", "ip6", "/128"
+		}
+		err := tc(ctx, container, "filter", "add", "dev", netemInterface, "parent", "1:", "protocol", protocol,
+			"prio", "1", "u32", "match", match, "dst", dst.String()+prefix, "flowid", "1:4")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetNetem removes the queueing disciplines set by SetNetem.
+func ResetNetem(ctx context.Context, container string) error {
+	// deleting the root discipline fails if there is none, i.e. it is the
+	// default one, which is why the error is only returned if one is set
+	out, err := ExecOutput(ctx, "exec", container, "tc", "qdisc", "show", "dev", netemInterface, "root")
+	if err != nil {
+		return err
+	}
+	if len(out) == 0 || !containsHandle(string(out), "1:") {
+		return nil
+	}
+	return tc(ctx, container, "qdisc", "del", "dev", netemInterface, "root")
+}
+
+func tc(ctx context.Context, container string, args ...string) error {
+	return Exec(ctx, append([]string{"exec", container, "tc"}, args...)...)
+}
+
+// containsHandle returns true if the output of tc qdisc show lists a
+// discipline with the given handle.
+func containsHandle(out, handle string) bool {
+	for _, field := range strings.Fields(out) {
+		if field == handle {
+			return true
+		}
+	}
+	return false
+}
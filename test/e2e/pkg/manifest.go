@@ -48,6 +48,17 @@ type Manifest struct {
 	// Nodes specifies the network nodes. At least one node must be given.
 	Nodes map[string]*ManifestNode `toml:"node"`
 
+	// NetworkPerturbations lists perturbations of the network to apply, in
+	// order, after the perturbations of the nodes. Each one is reverted after
+	// its duration, and the runner waits for the network to recover before
+	// applying the next one. For example:
+	//
+	// [[network_perturbation]]
+	// type = "partition"
+	// groups = [["validator01", "validator02"], ["validator03", "validator04"]]
+	// duration = "20s"
+	NetworkPerturbations []ManifestNetworkPerturbation `toml:"network_perturbation"`
+
 	// KeyType sets the curve that will be used by validators.
 	// Options are ed25519, secp256k1, bls12381, ml_dsa_65, and secp256k1eth.
 	KeyType string `toml:"key_type"`
@@ -189,6 +200,8 @@ type ManifestNode struct {
 	// kill:       kills the node with SIGKILL then restarts it
 	// pause:      temporarily pauses (freezes) the node
 	// restart:    restarts the node, shutting it down with SIGTERM
+	//
+	// Perturbations involving several nodes are listed in network_perturbation.
 	Perturb []string `toml:"perturb"`
 
 	// SendNoLoad determines if the e2e test should send load to this node.
@@ -206,6 +219,46 @@ type ManifestNode struct {
 	MempoolType string `toml:"mempool_type"`
 }
 
+// ManifestNetworkPerturbation represents a perturbation of the network in a
+// testnet manifest.
+type ManifestNetworkPerturbation struct {
+	// Type is the type of perturbation:
+	//
+	// partition:  splits the nodes into groups that can't reach each other
+	// latency:    delays the traffic between nodes and peers by delay, plus a
+	//             random jitter
+	// bandwidth:  caps the bandwidth between nodes and peers to rate
+	// clock_skew: skews the consensus clock of nodes by skew
+	Type string `toml:"type"`
+
+	// Groups are the groups of node names of a partition. Nodes in no group
+	// form a group of their own.
+	Groups [][]string `toml:"groups"`
+
+	// Nodes are the names of the nodes to perturb.
+	Nodes []string `toml:"nodes"`
+
+	// Peers are the names of the nodes whose traffic with Nodes is perturbed
+	// by latency and bandwidth perturbations, in both directions. Defaults to
+	// all other nodes.
+	Peers []string `toml:"peers"`
+
+	// Delay and Jitter are the latency added by latency perturbations.
+	Delay  time.Duration `toml:"delay"`
+	Jitter time.Duration `toml:"jitter"`
+
+	// Rate is the bandwidth of bandwidth perturbations, in tc units, e.g.
+	// "1mbit" or "500kbps".
+	Rate string `toml:"rate"`
+
+	// Skew is the offset of the clock of clock_skew perturbations, which is
+	// only supported by nodes using the builtin ABCI protocols.
+	Skew time.Duration `toml:"skew"`
+
+	// Duration is how long the perturbation lasts. Defaults to 10s.
+	Duration time.Duration `toml:"duration"`
+}
+
 // Save saves the testnet manifest to a file.
 func (m Manifest) Save(file string) error {
 	f, err := os.Create(file)
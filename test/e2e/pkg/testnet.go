@@ -9,6 +9,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"text/template"
@@ -63,6 +64,20 @@ const (
 	PerturbationRestart    Perturbation = "restart"
 	PerturbationUpgrade    Perturbation = "upgrade"
 
+	PerturbationPartition Perturbation = "partition"
+	PerturbationLatency   Perturbation = "latency"
+	PerturbationBandwidth Perturbation = "bandwidth"
+	PerturbationClockSkew Perturbation = "clock_skew"
+
+	// DefaultNetworkPerturbationDuration is how long network perturbations
+	// last by default.
+	DefaultNetworkPerturbationDuration = 10 * time.Second
+
+	// ClockSkewFile is the file, relative to the home directory of a node,
+	// holding the skew of its consensus clock as a duration. It is read
+	// periodically by nodes using the builtin ABCI protocols.
+	ClockSkewFile = "clock_skew"
+
 	EvidenceAgeHeight int64         = 14
 	EvidenceAgeTime   time.Duration = 1500 * time.Millisecond
 )
@@ -100,6 +115,21 @@ type Testnet struct {
 	VoteExtensionSize                                    uint
 	ExperimentalMaxGossipConnectionsToPersistentPeers    uint
 	ExperimentalMaxGossipConnectionsToNonPersistentPeers uint
+	NetworkPerturbations                                 []NetworkPerturbation
+}
+
+// NetworkPerturbation represents a perturbation of the network, see
+// ManifestNetworkPerturbation.
+type NetworkPerturbation struct {
+	Type     Perturbation
+	Groups   [][]*Node
+	Nodes    []*Node
+	Peers    []*Node
+	Delay    time.Duration
+	Jitter   time.Duration
+	Rate     string
+	Skew     time.Duration
+	Duration time.Duration
 }
 
 // Node represents a CometBFT node in a testnet.
@@ -354,9 +384,61 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 		testnet.ValidatorUpdates[int64(height)] = valUpdate
 	}
 
+	// Set up network perturbations.
+	for i, mp := range manifest.NetworkPerturbations {
+		p, err := newNetworkPerturbation(testnet, mp)
+		if err != nil {
+			return nil, fmt.Errorf("network perturbation %d: %w", i, err)
+		}
+		testnet.NetworkPerturbations = append(testnet.NetworkPerturbations, p)
+	}
+
 	return testnet, testnet.Validate()
 }
 
+// newNetworkPerturbation resolves the nodes of a network perturbation of the
+// manifest.
+func newNetworkPerturbation(testnet *Testnet, mp ManifestNetworkPerturbation) (NetworkPerturbation, error) {
+	lookupNodes := func(names []string) ([]*Node, error) {
+		nodes := make([]*Node, 0, len(names))
+		for _, name := range names {
+			node := testnet.LookupNode(name)
+			if node == nil {
+				return nil, fmt.Errorf("unknown node %q", name)
+			}
+			nodes = append(nodes, node)
+		}
+		return nodes, nil
+	}
+
+	p := NetworkPerturbation{
+		Type:     Perturbation(mp.Type),
+		Delay:    mp.Delay,
+		Jitter:   mp.Jitter,
+		Rate:     mp.Rate,
+		Skew:     mp.Skew,
+		Duration: mp.Duration,
+	}
+	if p.Duration == 0 {
+		p.Duration = DefaultNetworkPerturbationDuration
+	}
+	for _, names := range mp.Groups {
+		group, err := lookupNodes(names)
+		if err != nil {
+			return p, err
+		}
+		p.Groups = append(p.Groups, group)
+	}
+	var err error
+	if p.Nodes, err = lookupNodes(mp.Nodes); err != nil {
+		return p, err
+	}
+	if p.Peers, err = lookupNodes(mp.Peers); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
 // Validate validates a testnet.
 func (t Testnet) Validate() error {
 	if t.Name == "" {
@@ -407,6 +489,74 @@ func (t Testnet) Validate() error {
 			return fmt.Errorf("invalid node %q: %w", node.Name, err)
 		}
 	}
+	for i, p := range t.NetworkPerturbations {
+		if err := p.Validate(t); err != nil {
+			return fmt.Errorf("invalid network perturbation %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// tcRateRegexp matches the bandwidth rates tc accepts.
+var tcRateRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?([kmgt]i?)?(bit|bps)$`)
+
+// Validate validates a network perturbation.
+func (p NetworkPerturbation) Validate(testnet Testnet) error {
+	if p.Duration < 0 {
+		return fmt.Errorf("negative duration %v", p.Duration)
+	}
+	switch p.Type {
+	case PerturbationPartition:
+		if len(p.Groups) == 0 {
+			return errors.New("partition has no groups")
+		}
+		seen := map[*Node]bool{}
+		for _, group := range p.Groups {
+			if len(group) == 0 {
+				return errors.New("partition has an empty group")
+			}
+			for _, node := range group {
+				if seen[node] {
+					return fmt.Errorf("node %q is in several groups", node.Name)
+				}
+				seen[node] = true
+			}
+		}
+		if len(p.Groups) == 1 && len(seen) == len(testnet.Nodes) {
+			return errors.New("partition has a single group")
+		}
+		return nil
+
+	case PerturbationLatency:
+		if p.Delay <= 0 {
+			return errors.New("latency must have a positive delay")
+		}
+		if p.Jitter < 0 {
+			return fmt.Errorf("negative jitter %v", p.Jitter)
+		}
+
+	case PerturbationBandwidth:
+		if !tcRateRegexp.MatchString(p.Rate) {
+			return fmt.Errorf("invalid rate %q", p.Rate)
+		}
+
+	case PerturbationClockSkew:
+		if p.Skew == 0 {
+			return errors.New("clock skew must have a non-zero skew")
+		}
+		for _, node := range p.Nodes {
+			if node.ABCIProtocol != ProtocolBuiltin && node.ABCIProtocol != ProtocolBuiltinConnSync {
+				return fmt.Errorf("clock skew of node %q requires a builtin ABCI protocol", node.Name)
+			}
+		}
+
+	default:
+		return fmt.Errorf("invalid network perturbation %q", p.Type)
+	}
+
+	if len(p.Nodes) == 0 {
+		return fmt.Errorf("%s perturbation has no nodes", p.Type)
+	}
 	return nil
 }
 
@@ -542,6 +692,9 @@ func (t Testnet) IPv6() bool {
 
 // HasPerturbations returns whether the network has any perturbations.
 func (t Testnet) HasPerturbations() bool {
+	if len(t.NetworkPerturbations) > 0 {
+		return true
+	}
 	for _, node := range t.Nodes {
 		if len(node.Perturbations) > 0 {
 			return true
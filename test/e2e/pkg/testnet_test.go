@@ -0,0 +1,88 @@
+package e2e_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
+)
+
+func TestNetworkPerturbations(t *testing.T) {
+	manifestPath, err := filepath.Abs(filepath.Join("..", "networks", "network-perturb.toml"))
+	require.NoError(t, err)
+	manifest, err := e2e.LoadManifest(manifestPath)
+	require.NoError(t, err)
+	ifd, err := e2e.NewDockerInfrastructureData(manifest)
+	require.NoError(t, err)
+
+	testnet, err := e2e.LoadTestnet(manifestPath, ifd)
+	require.NoError(t, err)
+	require.True(t, testnet.HasPerturbations())
+	require.Len(t, testnet.NetworkPerturbations, 4)
+
+	partition := testnet.NetworkPerturbations[0]
+	require.Equal(t, e2e.PerturbationPartition, partition.Type)
+	require.Len(t, partition.Groups, 2)
+	require.Equal(t, testnet.LookupNode("validator03"), partition.Groups[1][0])
+	require.Equal(t, 20*time.Second, partition.Duration)
+
+	latency := testnet.NetworkPerturbations[1]
+	require.Equal(t, e2e.PerturbationLatency, latency.Type)
+	require.Equal(t, 300*time.Millisecond, latency.Delay)
+	require.Equal(t, 100*time.Millisecond, latency.Jitter)
+	require.Equal(t, e2e.DefaultNetworkPerturbationDuration, latency.Duration)
+
+	bandwidth := testnet.NetworkPerturbations[2]
+	require.Equal(t, "1mbit", bandwidth.Rate)
+	require.Len(t, bandwidth.Peers, 2)
+
+	require.Equal(t, -5*time.Second, testnet.NetworkPerturbations[3].Skew)
+}
+
+func TestNetworkPerturbationValidation(t *testing.T) {
+	testCases := []struct {
+		name         string
+		perturbation e2e.ManifestNetworkPerturbation
+		abciProtocol string
+		expectErr    bool
+	}{
+		{"partition", e2e.ManifestNetworkPerturbation{Type: "partition", Groups: [][]string{{"validator01"}}}, "", false},
+		{"partition no groups", e2e.ManifestNetworkPerturbation{Type: "partition"}, "", true},
+		{"partition single group", e2e.ManifestNetworkPerturbation{Type: "partition", Groups: [][]string{{"validator01", "validator02"}}}, "", true},
+		{"partition overlapping groups", e2e.ManifestNetworkPerturbation{Type: "partition", Groups: [][]string{{"validator01"}, {"validator01"}}}, "", true},
+		{"partition unknown node", e2e.ManifestNetworkPerturbation{Type: "partition", Groups: [][]string{{"validator03"}}}, "", true},
+		{"latency", e2e.ManifestNetworkPerturbation{Type: "latency", Nodes: []string{"validator01"}, Delay: time.Second}, "", false},
+		{"latency no delay", e2e.ManifestNetworkPerturbation{Type: "latency", Nodes: []string{"validator01"}}, "", true},
+		{"latency no nodes", e2e.ManifestNetworkPerturbation{Type: "latency", Delay: time.Second}, "", true},
+		{"bandwidth", e2e.ManifestNetworkPerturbation{Type: "bandwidth", Nodes: []string{"validator01"}, Rate: "1.5mbit"}, "", false},
+		{"bandwidth invalid rate", e2e.ManifestNetworkPerturbation{Type: "bandwidth", Nodes: []string{"validator01"}, Rate: "1 MB"}, "", true},
+		{"clock skew", e2e.ManifestNetworkPerturbation{Type: "clock_skew", Nodes: []string{"validator01"}, Skew: time.Second}, "", false},
+		{"clock skew no skew", e2e.ManifestNetworkPerturbation{Type: "clock_skew", Nodes: []string{"validator01"}}, "", true},
+		{"clock skew socket app", e2e.ManifestNetworkPerturbation{Type: "clock_skew", Nodes: []string{"validator01"}, Skew: time.Second}, "tcp", true},
+		{"unknown type", e2e.ManifestNetworkPerturbation{Type: "kill", Nodes: []string{"validator01"}}, "", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest := e2e.Manifest{
+				ABCIProtocol: tc.abciProtocol,
+				Nodes: map[string]*e2e.ManifestNode{
+					"validator01": {},
+					"validator02": {},
+				},
+				NetworkPerturbations: []e2e.ManifestNetworkPerturbation{tc.perturbation},
+			}
+			ifd, err := e2e.NewDockerInfrastructureData(manifest)
+			require.NoError(t, err)
+
+			_, err = e2e.NewTestnetFromManifest(manifest, filepath.Join(t.TempDir(), "test.toml"), ifd)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
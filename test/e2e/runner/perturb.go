@@ -2,7 +2,11 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -22,22 +26,37 @@ func Perturb(ctx context.Context, testnet *e2e.Testnet) error {
 			time.Sleep(3 * time.Second) // give network some time to recover between each
 		}
 	}
+	for _, perturbation := range testnet.NetworkPerturbations {
+		if err := PerturbNetwork(ctx, testnet, perturbation); err != nil {
+			return err
+		}
+		time.Sleep(3 * time.Second) // give network some time to recover between each
+	}
 	return nil
 }
 
+// containerName returns the name of the running container of a node, which
+// is its alternate container once it was upgraded.
+func containerName(node *e2e.Node) (name string, upgraded bool, err error) {
+	out, err := docker.ExecComposeOutput(context.Background(), node.Testnet.Dir, "ps", "-q", node.Name)
+	if err != nil {
+		return "", false, err
+	}
+	if len(out) == 0 {
+		return node.Name + "_u", true, nil
+	}
+	return node.Name, false, nil
+}
+
 // PerturbNode perturbs a node with a given perturbation, returning its status
 // after recovering.
 func PerturbNode(ctx context.Context, node *e2e.Node, perturbation e2e.Perturbation) (*rpctypes.ResultStatus, error) {
 	testnet := node.Testnet
-	out, err := docker.ExecComposeOutput(context.Background(), testnet.Dir, "ps", "-q", node.Name)
+	name, upgraded, err := containerName(node)
 	if err != nil {
 		return nil, err
 	}
-	name := node.Name
-	upgraded := false
-	if len(out) == 0 {
-		name += "_u"
-		upgraded = true
+	if upgraded {
 		logger.Info("perturb node", "msg",
 			log.NewLazySprintf("Node %v already upgraded, operating on alternate container %v",
 				node.Name, name))
@@ -117,3 +136,182 @@ func PerturbNode(ctx context.Context, node *e2e.Node, perturbation e2e.Perturbat
 		log.NewLazySprintf("Node %v recovered at height %v", node.Name, status.SyncInfo.LatestBlockHeight))
 	return status, nil
 }
+
+// PerturbNetwork perturbs the network of a testnet with a given perturbation
+// for its duration, then reverts it and waits for the network to recover.
+func PerturbNetwork(ctx context.Context, testnet *e2e.Testnet, perturbation e2e.NetworkPerturbation) error {
+	var err error
+	switch perturbation.Type {
+	case e2e.PerturbationPartition:
+		logger.Info("perturb network", "msg", log.NewLazySprintf("Partitioning the network into %v...",
+			nodeGroupNames(perturbation.Groups)))
+		err = setNetem(ctx, testnet, partitionLinks(testnet, perturbation.Groups), "loss", "100%")
+
+	case e2e.PerturbationLatency:
+		logger.Info("perturb network", "msg", log.NewLazySprintf("Adding %v latency (%v jitter) to nodes %v...",
+			perturbation.Delay, perturbation.Jitter, nodeNames(perturbation.Nodes)))
+		netem := []string{"delay", tcDuration(perturbation.Delay)}
+		if perturbation.Jitter > 0 {
+			netem = append(netem, tcDuration(perturbation.Jitter))
+		}
+		err = setNetem(ctx, testnet, peerLinks(testnet, perturbation.Nodes, perturbation.Peers), netem...)
+
+	case e2e.PerturbationBandwidth:
+		logger.Info("perturb network", "msg", log.NewLazySprintf("Capping the bandwidth of nodes %v to %v...",
+			nodeNames(perturbation.Nodes), perturbation.Rate))
+		err = setNetem(ctx, testnet, peerLinks(testnet, perturbation.Nodes, perturbation.Peers), "rate", perturbation.Rate)
+
+	case e2e.PerturbationClockSkew:
+		logger.Info("perturb network", "msg", log.NewLazySprintf("Skewing the clock of nodes %v by %v...",
+			nodeNames(perturbation.Nodes), perturbation.Skew))
+		for _, node := range perturbation.Nodes {
+			if err = setClockSkew(node, perturbation.Skew); err != nil {
+				break
+			}
+		}
+
+	default:
+		return fmt.Errorf("unexpected network perturbation %q", perturbation.Type)
+	}
+
+	if err == nil {
+		time.Sleep(perturbation.Duration)
+	}
+
+	// revert the perturbation even if it was partially applied
+	logger.Info("perturb network", "msg", log.NewLazySprintf("Reverting %v perturbation...", perturbation.Type))
+	var revertErr error
+	if perturbation.Type == e2e.PerturbationClockSkew {
+		for _, node := range perturbation.Nodes {
+			revertErr = errors.Join(revertErr, setClockSkew(node, 0))
+		}
+	} else {
+		revertErr = resetNetem(ctx, testnet)
+	}
+	if err := errors.Join(err, revertErr); err != nil {
+		return err
+	}
+
+	if err := Wait(ctx, testnet, 2); err != nil {
+		return err
+	}
+	logger.Info("perturb network", "msg", log.NewLazySprintf("Network recovered from %v perturbation", perturbation.Type))
+	return nil
+}
+
+// partitionLinks returns the nodes every node can't reach in a partition of
+// the given groups, where the nodes in no group form a group of their own.
+func partitionLinks(testnet *e2e.Testnet, groups [][]*e2e.Node) map[*e2e.Node][]*e2e.Node {
+	groupOf := map[*e2e.Node]int{}
+	for i, group := range groups {
+		for _, node := range group {
+			groupOf[node] = i + 1
+		}
+	}
+	links := map[*e2e.Node][]*e2e.Node{}
+	for _, node := range testnet.Nodes {
+		for _, peer := range testnet.Nodes {
+			if groupOf[node] != groupOf[peer] {
+				links[node] = append(links[node], peer)
+			}
+		}
+	}
+	return links
+}
+
+// peerLinks returns the peers of every node in the links between nodes and
+// peers, in both directions. Peers defaults to all the nodes of the testnet.
+func peerLinks(testnet *e2e.Testnet, nodes, peers []*e2e.Node) map[*e2e.Node][]*e2e.Node {
+	if len(peers) == 0 {
+		peers = testnet.Nodes
+	}
+	linked := map[[2]*e2e.Node]bool{}
+	links := map[*e2e.Node][]*e2e.Node{}
+	link := func(from, to *e2e.Node) {
+		if from == to || linked[[2]*e2e.Node{from, to}] {
+			return
+		}
+		linked[[2]*e2e.Node{from, to}] = true
+		links[from] = append(links[from], to)
+	}
+	for _, node := range nodes {
+		for _, peer := range peers {
+			link(node, peer)
+			link(peer, node)
+		}
+	}
+	return links
+}
+
+// setNetem applies netem to the traffic from every node to its linked nodes.
+func setNetem(ctx context.Context, testnet *e2e.Testnet, links map[*e2e.Node][]*e2e.Node, netem ...string) error {
+	for _, node := range testnet.Nodes {
+		if len(links[node]) == 0 {
+			continue
+		}
+		name, _, err := containerName(node)
+		if err != nil {
+			return err
+		}
+		dsts := make([]net.IP, len(links[node]))
+		for i, peer := range links[node] {
+			dsts[i] = peer.InternalIP
+		}
+		if err := docker.SetNetem(ctx, name, dsts, netem...); err != nil {
+			return fmt.Errorf("applying netem to node %v: %w", node.Name, err)
+		}
+	}
+	return nil
+}
+
+// resetNetem removes netem from the traffic of all nodes.
+func resetNetem(ctx context.Context, testnet *e2e.Testnet) error {
+	var errs error
+	for _, node := range testnet.Nodes {
+		name, _, err := containerName(node)
+		if err == nil {
+			err = docker.ResetNetem(ctx, name)
+		}
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("removing netem from node %v: %w", node.Name, err))
+		}
+	}
+	return errs
+}
+
+// setClockSkew sets the skew of the consensus clock of a node, which it reads
+// from e2e.ClockSkewFile in its home directory. A skew of 0 removes the file.
+func setClockSkew(node *e2e.Node, skew time.Duration) error {
+	file := filepath.Join(node.Testnet.Dir, node.Name, e2e.ClockSkewFile)
+	if skew == 0 {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	//nolint: gosec
+	// G306: Expect WriteFile permissions to be 0600 or less
+	return os.WriteFile(file, []byte(skew.String()), 0o644)
+}
+
+// tcDuration formats a duration for tc, which doesn't accept durations like
+// 1m0s.
+func tcDuration(d time.Duration) string {
+	return fmt.Sprintf("%dus", d.Microseconds())
+}
+
+func nodeNames(nodes []*e2e.Node) []string {
+	names := make([]string, len(nodes))
+	for i, node := range nodes {
+		names[i] = node.Name
+	}
+	return names
+}
+
+func nodeGroupNames(groups [][]*e2e.Node) [][]string {
+	names := make([][]string, len(groups))
+	for i, group := range groups {
+		names[i] = nodeNames(group)
+	}
+	return names
+}
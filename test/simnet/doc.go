@@ -0,0 +1,24 @@
+// Package simnet runs networks of full nodes in-process, connected through a
+// simulated network with programmable latency, loss, partitions and clock
+// skew, to reproduce consensus liveness bugs in go test, without the Docker
+// infrastructure of the e2e tests.
+//
+// A Testnet runs node.Node instances whose Switch is replaced by a Switch
+// passing messages through a Network, and whose consensus clock is a Clock
+// whose skew is adjustable. Scenarios are run as a sequence of steps:
+//
+//	tn := simnet.NewTestnet(t, simnet.TestnetConfig{Validators: 4, Seed: 1})
+//	tn.Start()
+//	tn.Run(
+//		simnet.WaitForHeight(2, 10*time.Second),
+//		simnet.Partition([]int{0, 1}, []int{2, 3}),
+//		simnet.ExpectNoProgress(time.Second),
+//		simnet.Heal(),
+//		simnet.WaitForBlocks(2, 10*time.Second),
+//	)
+//
+// The decisions of the Network, such as which messages are dropped and their
+// delays, are drawn from a seeded source, so a scenario can be run again with
+// the same seed. Runs are reproducible to the extent that the goroutines of
+// the nodes are scheduled the same way.
+package simnet
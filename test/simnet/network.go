@@ -0,0 +1,412 @@
+package simnet
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// routeQueueSize is the number of messages in flight on a route before
+	// Send blocks and TrySend fails, like the send queue of a connection.
+	routeQueueSize = 4096
+
+	// DefaultReconnectInterval is how often switches dial their persistent
+	// peers they are not connected to.
+	DefaultReconnectInterval = 100 * time.Millisecond
+)
+
+var (
+	ErrUnknownPeer      = errors.New("unknown peer")
+	ErrSwitchNotRunning = errors.New("switch is not running")
+	ErrAlreadyConnected = errors.New("already connected")
+	ErrPartitioned      = errors.New("peers are partitioned")
+	ErrSelfConnection   = errors.New("can't connect to self")
+	ErrNetworkShutdown  = errors.New("network is shut down")
+)
+
+// Link describes the properties of a directed link between two nodes.
+type Link struct {
+	// Latency is the time a message takes to be delivered.
+	Latency time.Duration
+	// Jitter is the maximum random delay added to the latency.
+	Jitter time.Duration
+	// Loss is the probability a message is dropped, in [0, 1].
+	//
+	// Reactors assume the links between nodes are reliable, as they are over
+	// TCP, so a lossy link models a faulty link rather than a lossy one.
+	Loss float64
+}
+
+// route is a directed link between two nodes.
+type route struct {
+	from, to p2p.ID
+}
+
+// conn is a connection between two switches.
+type conn struct {
+	// a and b are the peers of the connection on either side, a is in the
+	// switch that dialed.
+	a, b *Peer
+	// ready is closed once both sides added their peer, messages are only
+	// delivered then.
+	ready chan struct{}
+	// closed is closed once the connection is closed, messages in flight are
+	// dropped then.
+	closed chan struct{}
+}
+
+// delivery is a message in flight on a route.
+type delivery struct {
+	conn      *conn
+	to        *Peer // the peer of the sender in the recipient switch
+	chID      byte
+	msgBytes  []byte
+	deliverAt time.Time
+}
+
+// queue delivers the messages sent on a route, in order.
+type queue struct {
+	ch chan delivery
+	// the delivery time of the last message, to preserve the order of
+	// messages whatever their jitter
+	last time.Time
+}
+
+// Network is a simulated network connecting switches in-process. Messages
+// between two nodes are delivered in order, after the latency of their link,
+// unless the link drops them. The network can be partitioned to disconnect
+// groups of nodes from each other.
+//
+// All the random decisions of the network are taken from a seeded source, so
+// runs with the same seed are reproducible, to the extent that the goroutines
+// of the nodes are scheduled the same way.
+type Network struct {
+	logger log.Logger
+
+	mtx         sync.Mutex
+	rand        *rand.Rand
+	defaultLink Link
+	links       map[route]Link
+	// partition group of every node, nil if the network is not partitioned
+	groups   map[p2p.ID]int
+	switches map[p2p.ID]*Switch
+	conns    map[route]*conn // by route from the dialer
+	queues   map[route]*queue
+
+	reconnectInterval time.Duration
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NetworkOption sets an optional parameter on the Network.
+type NetworkOption func(*Network)
+
+// WithSeed sets the seed of the random decisions of the network.
+func WithSeed(seed int64) NetworkOption {
+	return func(n *Network) { n.rand = rand.New(rand.NewSource(seed)) } //nolint:gosec
+}
+
+// WithDefaultLink sets the properties of the links without specific ones.
+func WithDefaultLink(link Link) NetworkOption {
+	return func(n *Network) { n.defaultLink = link }
+}
+
+// WithReconnectInterval sets how often switches dial their persistent peers
+// they are not connected to.
+func WithReconnectInterval(interval time.Duration) NetworkOption {
+	return func(n *Network) { n.reconnectInterval = interval }
+}
+
+// WithLogger sets the logger of the network.
+func WithLogger(logger log.Logger) NetworkOption {
+	return func(n *Network) { n.logger = logger }
+}
+
+// NewNetwork returns a new simulated network without links latency or loss,
+// and with a seed of 0.
+func NewNetwork(options ...NetworkOption) *Network {
+	n := &Network{
+		logger:            log.NewNopLogger(),
+		rand:              rand.New(rand.NewSource(0)), //nolint:gosec
+		links:             make(map[route]Link),
+		switches:          make(map[p2p.ID]*Switch),
+		conns:             make(map[route]*conn),
+		queues:            make(map[route]*queue),
+		reconnectInterval: DefaultReconnectInterval,
+		quit:              make(chan struct{}),
+	}
+	for _, option := range options {
+		option(n)
+	}
+	return n
+}
+
+// Shutdown drops the messages in flight and stops delivering messages. It
+// must be called once the switches are stopped.
+func (n *Network) Shutdown() {
+	n.mtx.Lock()
+	select {
+	case <-n.quit:
+	default:
+		close(n.quit)
+	}
+	n.mtx.Unlock()
+	n.wg.Wait()
+}
+
+// SetDefaultLink sets the properties of the links without specific ones.
+func (n *Network) SetDefaultLink(link Link) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.defaultLink = link
+}
+
+// SetLink sets the properties of the link from a node to another.
+func (n *Network) SetLink(from, to p2p.ID, link Link) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.links[route{from, to}] = link
+}
+
+// ResetLinks removes the properties set by SetLink.
+func (n *Network) ResetLinks() {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.links = make(map[route]Link)
+}
+
+// Partition partitions the network into the given groups of nodes. Nodes in
+// no group form a group of their own. The connections between nodes of
+// different groups are closed and can't be established until Heal is called.
+func (n *Network) Partition(groups ...[]p2p.ID) {
+	n.mtx.Lock()
+	n.groups = make(map[p2p.ID]int)
+	for i, group := range groups {
+		for _, id := range group {
+			n.groups[id] = i + 1
+		}
+	}
+	var cut []*conn
+	for r, c := range n.conns {
+		if n.partitioned(r.from, r.to) {
+			cut = append(cut, c)
+		}
+	}
+	n.mtx.Unlock()
+
+	for _, c := range cut {
+		n.disconnect(c, ErrPartitioned)
+	}
+}
+
+// Heal removes the partition of the network. Switches reconnect to their
+// persistent peers afterwards.
+func (n *Network) Heal() {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.groups = nil
+}
+
+// Connected returns true if the two nodes are connected.
+func (n *Network) Connected(a, b p2p.ID) bool {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.conns[route{a, b}] != nil || n.conns[route{b, a}] != nil
+}
+
+// CONTRACT: n.mtx is held.
+func (n *Network) partitioned(a, b p2p.ID) bool {
+	return n.groups != nil && n.groups[a] != n.groups[b]
+}
+
+func (n *Network) addSwitch(sw *Switch) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.switches[sw.NodeInfo().ID()] = sw
+}
+
+// connect connects the switch from to the switch of the node to.
+func (n *Network) connect(from *Switch, to p2p.ID) error {
+	fromID := from.NodeInfo().ID()
+
+	n.mtx.Lock()
+	toSw, ok := n.switches[to]
+	switch {
+	case n.isShutdown():
+		n.mtx.Unlock()
+		return ErrNetworkShutdown
+	case fromID == to:
+		n.mtx.Unlock()
+		return ErrSelfConnection
+	case !ok:
+		n.mtx.Unlock()
+		return fmt.Errorf("%w: %s", ErrUnknownPeer, to)
+	case !from.IsRunning() || !toSw.IsRunning():
+		n.mtx.Unlock()
+		return ErrSwitchNotRunning
+	case n.partitioned(fromID, to):
+		n.mtx.Unlock()
+		return ErrPartitioned
+	case n.conns[route{fromID, to}] != nil || n.conns[route{to, fromID}] != nil:
+		n.mtx.Unlock()
+		return ErrAlreadyConnected
+	}
+	c := &conn{ready: make(chan struct{}), closed: make(chan struct{})}
+	c.a = newPeer(n, c, from, toSw.NodeInfo(), true)
+	c.b = newPeer(n, c, toSw, from.NodeInfo(), false)
+	n.conns[route{fromID, to}] = c
+	n.mtx.Unlock()
+
+	if err := toSw.addPeer(c.b); err != nil {
+		n.disconnect(c, err)
+		return err
+	}
+	if err := from.addPeer(c.a); err != nil {
+		n.disconnect(c, err)
+		return err
+	}
+
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.conns[route{fromID, to}] != c {
+		// closed while the peers were being added
+		return ErrPartitioned
+	}
+	close(c.ready)
+	return nil
+}
+
+// disconnect closes the connection, removing the peers of both switches.
+func (n *Network) disconnect(c *conn, reason any) {
+	n.mtx.Lock()
+	r := route{c.b.ID(), c.a.ID()}
+	if n.conns[r] != c {
+		n.mtx.Unlock()
+		return
+	}
+	delete(n.conns, r)
+	close(c.closed)
+	n.mtx.Unlock()
+
+	c.a.sw.removePeer(c.a, reason)
+	c.b.sw.removePeer(c.b, reason)
+}
+
+// send sends a message from a peer to its remote node, through the link
+// between them. It blocks if the route is full unless try is true, in which
+// case it returns false.
+func (n *Network) send(from *Peer, chID byte, msgBytes []byte, try bool) bool {
+	to := from.conn.b
+	if from == to {
+		to = from.conn.a
+	}
+	r := route{from.sw.NodeInfo().ID(), from.ID()}
+
+	n.mtx.Lock()
+	link, ok := n.links[r]
+	if !ok {
+		link = n.defaultLink
+	}
+	if link.Loss > 0 && n.rand.Float64() < link.Loss {
+		n.mtx.Unlock()
+		return true
+	}
+	delay := link.Latency
+	if link.Jitter > 0 {
+		delay += time.Duration(n.rand.Int63n(int64(link.Jitter)))
+	}
+	q, ok := n.queues[r]
+	if !ok {
+		q = &queue{ch: make(chan delivery, routeQueueSize)}
+		n.queues[r] = q
+		n.wg.Add(1)
+		go n.deliverRoutine(q)
+	}
+	d := delivery{
+		conn:      from.conn,
+		to:        to,
+		chID:      chID,
+		msgBytes:  msgBytes,
+		deliverAt: time.Now().Add(delay),
+	}
+	if d.deliverAt.Before(q.last) {
+		d.deliverAt = q.last
+	}
+	q.last = d.deliverAt
+	n.mtx.Unlock()
+
+	if try {
+		select {
+		case q.ch <- d:
+			return true
+		default:
+			return false
+		}
+	}
+	select {
+	case q.ch <- d:
+		return true
+	case <-from.Quit():
+		return false
+	case <-n.quit:
+		return false
+	}
+}
+
+// deliverRoutine delivers the messages of a route when they are due.
+func (n *Network) deliverRoutine(q *queue) {
+	defer n.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	<-timer.C
+
+	for {
+		var d delivery
+		select {
+		case d = <-q.ch:
+		case <-n.quit:
+			return
+		}
+
+		if wait := time.Until(d.deliverAt); wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-timer.C:
+			case <-n.quit:
+				return
+			}
+		}
+
+		select {
+		case <-d.conn.ready:
+		case <-d.conn.closed:
+			continue
+		case <-n.quit:
+			return
+		}
+		select {
+		case <-d.conn.closed:
+			// the connection was closed while the message was in flight
+			continue
+		default:
+		}
+		d.to.sw.receive(d.to, d.chID, d.msgBytes)
+	}
+}
+
+// CONTRACT: n.mtx is held.
+func (n *Network) isShutdown() bool {
+	select {
+	case <-n.quit:
+		return true
+	default:
+		return false
+	}
+}
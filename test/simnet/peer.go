@@ -0,0 +1,162 @@
+package simnet
+
+import (
+	"fmt"
+	"net"
+	"sync/atomic"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cometbft/cometbft/libs/cmap"
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p"
+	cmtconn "github.com/cometbft/cometbft/p2p/conn"
+)
+
+// Peer is a peer connected through a simulated Network.
+type Peer struct {
+	service.BaseService
+
+	network  *Network
+	conn     *conn
+	sw       *Switch      // the switch the peer was added to
+	nodeInfo p2p.NodeInfo // of the remote node
+	outbound bool
+
+	data          *cmap.CMap
+	removalFailed atomic.Bool
+}
+
+var _ p2p.Peer = (*Peer)(nil)
+
+func newPeer(network *Network, c *conn, sw *Switch, nodeInfo p2p.NodeInfo, outbound bool) *Peer {
+	p := &Peer{
+		network:  network,
+		conn:     c,
+		sw:       sw,
+		nodeInfo: nodeInfo,
+		outbound: outbound,
+		data:     cmap.NewCMap(),
+	}
+	p.BaseService = *service.NewBaseService(nil, "Peer", p)
+	return p
+}
+
+// String implements fmt.Stringer.
+func (p *Peer) String() string {
+	if p.outbound {
+		return fmt.Sprintf("Peer{%v out}", p.ID())
+	}
+	return fmt.Sprintf("Peer{%v in}", p.ID())
+}
+
+// FlushStop implements p2p.Peer.
+func (p *Peer) FlushStop() {
+	_ = p.Stop()
+}
+
+// ID implements p2p.Peer.
+func (p *Peer) ID() p2p.ID {
+	return p.nodeInfo.ID()
+}
+
+// RemoteIP implements p2p.Peer.
+func (p *Peer) RemoteIP() net.IP {
+	if addr := p.SocketAddr(); addr != nil {
+		return addr.IP
+	}
+	return nil
+}
+
+// RemoteAddr implements p2p.Peer.
+func (p *Peer) RemoteAddr() net.Addr {
+	if addr := p.SocketAddr(); addr != nil {
+		return &net.TCPAddr{IP: addr.IP, Port: int(addr.Port)}
+	}
+	return nil
+}
+
+// IsOutbound implements p2p.Peer.
+func (p *Peer) IsOutbound() bool {
+	return p.outbound
+}
+
+// IsPersistent implements p2p.Peer.
+func (p *Peer) IsPersistent() bool {
+	return p.sw.isPersistent(p.ID())
+}
+
+// CloseConn implements p2p.Peer.
+func (*Peer) CloseConn() error {
+	return nil
+}
+
+// NodeInfo implements p2p.Peer.
+func (p *Peer) NodeInfo() p2p.NodeInfo {
+	return p.nodeInfo
+}
+
+// Status implements p2p.Peer.
+func (*Peer) Status() cmtconn.ConnectionStatus {
+	return cmtconn.ConnectionStatus{}
+}
+
+// SocketAddr implements p2p.Peer.
+func (p *Peer) SocketAddr() *p2p.NetAddress {
+	addr, err := p.nodeInfo.NetAddress()
+	if err != nil {
+		return nil
+	}
+	return addr
+}
+
+// Send implements p2p.Peer.
+func (p *Peer) Send(e p2p.Envelope) bool {
+	return p.send(e, false)
+}
+
+// TrySend implements p2p.Peer.
+func (p *Peer) TrySend(e p2p.Envelope) bool {
+	return p.send(e, true)
+}
+
+func (p *Peer) send(e p2p.Envelope, try bool) bool {
+	if !p.IsRunning() || !p.hasChannel(e.ChannelID) {
+		return false
+	}
+	msg := e.Message
+	if w, ok := msg.(p2p.Wrapper); ok {
+		msg = w.Wrap()
+	}
+	msgBytes, err := proto.Marshal(msg)
+	if err != nil {
+		p.Logger.Error("marshaling message to send", "error", err)
+		return false
+	}
+	return p.network.send(p, e.ChannelID, msgBytes, try)
+}
+
+func (p *Peer) hasChannel(chID byte) bool {
+	ni, ok := p.nodeInfo.(p2p.DefaultNodeInfo)
+	return !ok || ni.HasChannel(chID)
+}
+
+// Get implements p2p.Peer.
+func (p *Peer) Get(key string) any {
+	return p.data.Get(key)
+}
+
+// Set implements p2p.Peer.
+func (p *Peer) Set(key string, data any) {
+	p.data.Set(key, data)
+}
+
+// SetRemovalFailed implements p2p.Peer.
+func (p *Peer) SetRemovalFailed() {
+	p.removalFailed.Store(true)
+}
+
+// GetRemovalFailed implements p2p.Peer.
+func (p *Peer) GetRemovalFailed() bool {
+	return p.removalFailed.Load()
+}
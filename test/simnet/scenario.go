@@ -0,0 +1,208 @@
+package simnet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// pollInterval is how often steps waiting for the nodes check their heights.
+const pollInterval = 10 * time.Millisecond
+
+// Step is a step of a scenario run on a Testnet.
+type Step struct {
+	// Name describes the step in errors.
+	Name string
+	// Run runs the step, returning once it is done.
+	Run func(tn *Testnet) error
+}
+
+// Run runs the steps of a scenario in order, failing the test at the first
+// step which fails.
+func (tn *Testnet) Run(steps ...Step) {
+	tn.t.Helper()
+	for i, step := range steps {
+		if err := step.Run(tn); err != nil {
+			tn.t.Fatalf("step %d (%s) failed: %v, heights %v", i, step.Name, err, tn.Heights())
+		}
+	}
+}
+
+// WaitForHeight waits until the given nodes, or all of them if none is
+// given, committed the block at the height.
+func WaitForHeight(height int64, timeout time.Duration, nodes ...int) Step {
+	return Step{
+		Name: fmt.Sprintf("wait for height %d", height),
+		Run: func(tn *Testnet) error {
+			return tn.waitForHeight(func(int64) int64 { return height }, timeout, nodes)
+		},
+	}
+}
+
+// WaitForBlocks waits until the given nodes, or all of them if none is
+// given, committed the given number of blocks on top of the highest block
+// committed by one of them when the step starts.
+func WaitForBlocks(blocks int64, timeout time.Duration, nodes ...int) Step {
+	return Step{
+		Name: fmt.Sprintf("wait for %d blocks", blocks),
+		Run: func(tn *Testnet) error {
+			return tn.waitForHeight(func(maxHeight int64) int64 { return maxHeight + blocks }, timeout, nodes)
+		},
+	}
+}
+
+// ExpectNoProgress expects the given nodes, or all of them if none is given,
+// to commit no block for the given duration.
+func ExpectNoProgress(d time.Duration, nodes ...int) Step {
+	return Step{
+		Name: fmt.Sprintf("expect no progress for %v", d),
+		Run: func(tn *Testnet) error {
+			nodes := tn.nodesOrAll(nodes)
+			if _, err := tn.nodeIDs(nodes); err != nil {
+				return err
+			}
+			heights := tn.Heights()
+			time.Sleep(d)
+			for _, i := range nodes {
+				if h := tn.Nodes[i].Height(); h != heights[i] {
+					return fmt.Errorf("%s committed blocks %d to %d", tn.Nodes[i].Name, heights[i]+1, h)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// Partition partitions the network into the given groups of nodes, see
+// Network.Partition.
+func Partition(groups ...[]int) Step {
+	return Step{
+		Name: fmt.Sprintf("partition %v", groups),
+		Run: func(tn *Testnet) error {
+			idGroups := make([][]p2p.ID, len(groups))
+			for i, group := range groups {
+				ids, err := tn.nodeIDs(group)
+				if err != nil {
+					return err
+				}
+				idGroups[i] = ids
+			}
+			tn.Network.Partition(idGroups...)
+			return nil
+		},
+	}
+}
+
+// Heal removes the partition of the network, see Network.Heal.
+func Heal() Step {
+	return Step{
+		Name: "heal",
+		Run: func(tn *Testnet) error {
+			tn.Network.Heal()
+			return nil
+		},
+	}
+}
+
+// SetDefaultLink sets the properties of the links without specific ones.
+func SetDefaultLink(link Link) Step {
+	return Step{
+		Name: fmt.Sprintf("set default link %+v", link),
+		Run: func(tn *Testnet) error {
+			tn.Network.SetDefaultLink(link)
+			return nil
+		},
+	}
+}
+
+// SetLink sets the properties of the links between two nodes, in both
+// directions.
+func SetLink(a, b int, link Link) Step {
+	return Step{
+		Name: fmt.Sprintf("set link %d-%d %+v", a, b, link),
+		Run: func(tn *Testnet) error {
+			ids, err := tn.nodeIDs([]int{a, b})
+			if err != nil {
+				return err
+			}
+			tn.Network.SetLink(ids[0], ids[1], link)
+			tn.Network.SetLink(ids[1], ids[0], link)
+			return nil
+		},
+	}
+}
+
+// SetClockSkew sets the offset of the clock of a node from the wall clock.
+func SetClockSkew(node int, skew time.Duration) Step {
+	return Step{
+		Name: fmt.Sprintf("set clock skew of node %d to %v", node, skew),
+		Run: func(tn *Testnet) error {
+			if _, err := tn.nodeIDs([]int{node}); err != nil {
+				return err
+			}
+			tn.Nodes[node].Clock.SetSkew(skew)
+			return nil
+		},
+	}
+}
+
+// Sleep waits for the given duration.
+func Sleep(d time.Duration) Step {
+	return Step{
+		Name: fmt.Sprintf("sleep %v", d),
+		Run: func(*Testnet) error {
+			time.Sleep(d)
+			return nil
+		},
+	}
+}
+
+// waitForHeight waits until the given nodes reach the height returned by
+// target given their maximum height.
+func (tn *Testnet) waitForHeight(target func(maxHeight int64) int64, timeout time.Duration, nodes []int) error {
+	nodes = tn.nodesOrAll(nodes)
+	if _, err := tn.nodeIDs(nodes); err != nil {
+		return err
+	}
+	var maxHeight int64
+	for _, i := range nodes {
+		if h := tn.Nodes[i].Height(); h > maxHeight {
+			maxHeight = h
+		}
+	}
+	height := target(maxHeight)
+
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		behind := ""
+		for _, i := range nodes {
+			if h := tn.Nodes[i].Height(); h < height {
+				behind = fmt.Sprintf("%s at height %d", tn.Nodes[i].Name, h)
+				break
+			}
+		}
+		if behind == "" {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for height %d: %s", height, behind)
+		}
+		<-ticker.C
+	}
+}
+
+// nodesOrAll returns the given node indexes, or the indexes of all the nodes
+// if none is given.
+func (tn *Testnet) nodesOrAll(nodes []int) []int {
+	if len(nodes) > 0 {
+		return nodes
+	}
+	all := make([]int, len(tn.Nodes))
+	for i := range all {
+		all[i] = i
+	}
+	return all
+}
@@ -0,0 +1,120 @@
+package simnet
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+)
+
+const waitTimeout = 30 * time.Second
+
+func TestTestnetLiveness(t *testing.T) {
+	tn := NewTestnet(t, TestnetConfig{Validators: 4, Seed: 1})
+	tn.Start()
+	tn.Run(WaitForHeight(3, waitTimeout))
+}
+
+func TestTestnetPartition(t *testing.T) {
+	t.Run("minority", func(t *testing.T) {
+		tn := NewTestnet(t, TestnetConfig{Validators: 4, Seed: 2})
+		tn.Start()
+		tn.Run(
+			WaitForHeight(2, waitTimeout),
+			Partition([]int{0}, []int{1, 2, 3}),
+			Sleep(100*time.Millisecond),
+			// the majority keeps committing blocks, the minority can't
+			WaitForBlocks(3, waitTimeout, 1, 2, 3),
+			ExpectNoProgress(time.Second, 0),
+			Heal(),
+			// the minority catches up
+			WaitForBlocks(1, waitTimeout),
+		)
+	})
+
+	t.Run("halves", func(t *testing.T) {
+		tn := NewTestnet(t, TestnetConfig{Validators: 4, Seed: 3})
+		tn.Start()
+		tn.Run(
+			WaitForHeight(2, waitTimeout),
+			Partition([]int{0, 1}, []int{2, 3}),
+			expectConnected(0, 2, false),
+			// let a commit in progress complete
+			Sleep(500*time.Millisecond),
+			// no half has 2/3 of the voting power
+			ExpectNoProgress(2*time.Second),
+			Heal(),
+			WaitForBlocks(2, waitTimeout),
+			expectConnected(0, 2, true),
+		)
+	})
+}
+
+func expectConnected(a, b int, connected bool) Step {
+	return Step{
+		Name: "expect connected",
+		Run: func(tn *Testnet) error {
+			ids, err := tn.nodeIDs([]int{a, b})
+			if err != nil {
+				return err
+			}
+			if tn.Network.Connected(ids[0], ids[1]) != connected {
+				return fmt.Errorf("expected nodes %d and %d connected: %t", a, b, connected)
+			}
+			return nil
+		},
+	}
+}
+
+func TestTestnetLinks(t *testing.T) {
+	t.Run("latency", func(t *testing.T) {
+		tn := NewTestnet(t, TestnetConfig{
+			Validators: 4,
+			Seed:       4,
+			Link:       Link{Latency: 20 * time.Millisecond, Jitter: 20 * time.Millisecond},
+			ConfigureNode: func(_ int, config *cfg.Config) {
+				// rounds would time out before the messages are delivered
+				config.Consensus.TimeoutPropose = 500 * time.Millisecond
+				config.Consensus.TimeoutPrevote = 200 * time.Millisecond
+				config.Consensus.TimeoutPrecommit = 200 * time.Millisecond
+			},
+		})
+		tn.Start()
+		tn.Run(
+			WaitForHeight(2, waitTimeout),
+			SetLink(0, 1, Link{Latency: 200 * time.Millisecond}),
+			WaitForBlocks(2, waitTimeout),
+		)
+	})
+
+	t.Run("loss", func(t *testing.T) {
+		tn := NewTestnet(t, TestnetConfig{Validators: 4, Seed: 5})
+		tn.Start()
+		tn.Run(
+			WaitForHeight(2, waitTimeout),
+			// the node can't receive anything from node 1, but the other
+			// nodes relay its messages
+			SetLink(1, 2, Link{Loss: 1}),
+			WaitForBlocks(2, waitTimeout),
+		)
+	})
+}
+
+func TestTestnetClockSkew(t *testing.T) {
+	tn := NewTestnet(t, TestnetConfig{Validators: 4, Seed: 6})
+	tn.Start()
+	tn.Run(
+		WaitForHeight(2, waitTimeout),
+		SetClockSkew(0, time.Minute),
+		SetClockSkew(1, -time.Minute),
+		WaitForBlocks(3, waitTimeout),
+	)
+
+	// the block time is the median of the precommit times, which the nodes
+	// with a correct clock drive
+	block := tn.Nodes[2].BlockStore().LoadBlock(tn.Nodes[2].Height())
+	require.WithinDuration(t, time.Now(), block.Time, 30*time.Second)
+}
@@ -0,0 +1,393 @@
+package simnet
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// Switch is a p2p.Switcher connecting to peers through a simulated Network.
+//
+// It dials its persistent peers it is not connected to every reconnect
+// interval of the network, so that nodes reconnect once a partition is healed.
+type Switch struct {
+	service.BaseService
+
+	network  *Network
+	nodeInfo p2p.NodeInfo
+
+	reactors      map[string]p2p.Reactor
+	reactorNames  []string // sorted, to start reactors and add peers in order
+	reactorsByCh  map[byte]p2p.Reactor
+	msgTypeByChID map[byte]proto.Message
+	peers         *p2p.PeerSet
+
+	mtx                  sync.Mutex
+	persistentPeers      map[p2p.ID]*p2p.NetAddress
+	unconditionalPeerIDs map[p2p.ID]struct{}
+}
+
+var _ p2p.Switcher = (*Switch)(nil)
+
+// NewSwitch returns a new Switch of the node with the given node info on the
+// network, with the given reactors by name.
+func NewSwitch(network *Network, nodeInfo p2p.NodeInfo, reactors map[string]p2p.Reactor) *Switch {
+	sw := &Switch{
+		network:              network,
+		nodeInfo:             nodeInfo,
+		reactors:             make(map[string]p2p.Reactor),
+		reactorsByCh:         make(map[byte]p2p.Reactor),
+		msgTypeByChID:        make(map[byte]proto.Message),
+		peers:                p2p.NewPeerSet(),
+		persistentPeers:      make(map[p2p.ID]*p2p.NetAddress),
+		unconditionalPeerIDs: make(map[p2p.ID]struct{}),
+	}
+	sw.BaseService = *service.NewBaseService(nil, "SimSwitch", sw)
+
+	names := make([]string, 0, len(reactors))
+	for name := range reactors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sw.AddReactor(name, reactors[name])
+	}
+
+	network.addSwitch(sw)
+	return sw
+}
+
+// OnStart implements service.Service.
+func (sw *Switch) OnStart() error {
+	for _, name := range sw.reactorNames {
+		if err := sw.reactors[name].Start(); err != nil {
+			return fmt.Errorf("failed to start %v: %w", name, err)
+		}
+	}
+	go sw.reconnectRoutine()
+	return nil
+}
+
+// OnStop implements service.Service.
+func (sw *Switch) OnStop() {
+	for _, p := range sw.peers.Copy() {
+		sw.network.disconnect(p.(*Peer).conn, ErrSwitchNotRunning)
+	}
+	for _, name := range sw.reactorNames {
+		if err := sw.reactors[name].Stop(); err != nil {
+			sw.Logger.Error("error while stopping reactor", "reactor", name, "error", err)
+		}
+	}
+}
+
+// NodeInfo implements p2p.Switcher.
+func (sw *Switch) NodeInfo() p2p.NodeInfo {
+	return sw.nodeInfo
+}
+
+// Log implements p2p.Switcher.
+func (sw *Switch) Log() log.Logger {
+	return sw.Logger
+}
+
+// Reactor implements p2p.Switcher.
+func (sw *Switch) Reactor(name string) (p2p.Reactor, bool) {
+	reactor, ok := sw.reactors[name]
+	return reactor, ok
+}
+
+// AddReactor implements p2p.Switcher.
+// NOTE: Not goroutine safe.
+func (sw *Switch) AddReactor(name string, reactor p2p.Reactor) p2p.Reactor {
+	for _, chDesc := range reactor.GetChannels() {
+		if sw.reactorsByCh[chDesc.ID] != nil {
+			panic(fmt.Sprintf("Channel %X has multiple reactors %v & %v", chDesc.ID, sw.reactorsByCh[chDesc.ID], reactor))
+		}
+		sw.reactorsByCh[chDesc.ID] = reactor
+		sw.msgTypeByChID[chDesc.ID] = chDesc.MessageType
+	}
+	if _, ok := sw.reactors[name]; !ok {
+		sw.reactorNames = append(sw.reactorNames, name)
+		sort.Strings(sw.reactorNames)
+	}
+	sw.reactors[name] = reactor
+	reactor.SetSwitch(sw)
+	return reactor
+}
+
+// RemoveReactor implements p2p.Switcher.
+// NOTE: Not goroutine safe.
+func (sw *Switch) RemoveReactor(name string, reactor p2p.Reactor) {
+	for _, chDesc := range reactor.GetChannels() {
+		delete(sw.reactorsByCh, chDesc.ID)
+		delete(sw.msgTypeByChID, chDesc.ID)
+	}
+	delete(sw.reactors, name)
+	for i, n := range sw.reactorNames {
+		if n == name {
+			sw.reactorNames = append(sw.reactorNames[:i], sw.reactorNames[i+1:]...)
+			break
+		}
+	}
+}
+
+// Peers implements p2p.Switcher.
+func (sw *Switch) Peers() p2p.IPeerSet {
+	return sw.peers
+}
+
+// NumPeers implements p2p.Switcher.
+func (sw *Switch) NumPeers() (outbound, inbound, dialing int) {
+	sw.peers.ForEach(func(peer p2p.Peer) {
+		switch {
+		case sw.IsPeerUnconditional(peer.ID()):
+		case peer.IsOutbound():
+			outbound++
+		default:
+			inbound++
+		}
+	})
+	return outbound, inbound, 0
+}
+
+// MaxNumOutboundPeers implements p2p.Switcher. It returns the number of
+// persistent peers, which are the only peers the switch dials on its own.
+func (sw *Switch) MaxNumOutboundPeers() int {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	return len(sw.persistentPeers)
+}
+
+// AddPersistentPeers implements p2p.Switcher.
+func (sw *Switch) AddPersistentPeers(addrs []string) error {
+	netAddrs, errs := p2p.NewNetAddressStrings(addrs)
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	for _, addr := range netAddrs {
+		sw.persistentPeers[addr.ID] = addr
+	}
+	return nil
+}
+
+// AddPrivatePeerIDs implements p2p.Switcher. Private peers are only relevant
+// to peer exchange, which is not supported on a simulated network.
+func (*Switch) AddPrivatePeerIDs([]string) error {
+	return nil
+}
+
+// AddUnconditionalPeerIDs implements p2p.Switcher.
+func (sw *Switch) AddUnconditionalPeerIDs(ids []string) error {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	for _, id := range ids {
+		sw.unconditionalPeerIDs[p2p.ID(id)] = struct{}{}
+	}
+	return nil
+}
+
+// DialPeerWithAddress implements p2p.Switcher.
+func (sw *Switch) DialPeerWithAddress(addr *p2p.NetAddress) error {
+	return sw.network.connect(sw, addr.ID)
+}
+
+// DialPeersAsync implements p2p.Switcher.
+func (sw *Switch) DialPeersAsync(peers []string) error {
+	netAddrs, errs := p2p.NewNetAddressStrings(peers)
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	for _, addr := range netAddrs {
+		go func(addr *p2p.NetAddress) {
+			err := sw.DialPeerWithAddress(addr)
+			if err != nil && !errors.Is(err, ErrAlreadyConnected) {
+				sw.Logger.Debug("Error dialing peer", "peer", addr, "err", err)
+			}
+		}(addr)
+	}
+	return nil
+}
+
+// StopPeerForError implements p2p.Switcher. Persistent peers are dialed again
+// after the reconnect interval.
+func (sw *Switch) StopPeerForError(peer p2p.Peer, reason any) {
+	if !peer.IsRunning() {
+		return
+	}
+	sw.Logger.Error("Stopping peer for error", "peer", peer, "err", reason)
+	sw.network.disconnect(peer.(*Peer).conn, reason)
+}
+
+// StopPeerGracefully implements p2p.Switcher.
+func (sw *Switch) StopPeerGracefully(peer p2p.Peer) {
+	sw.Logger.Info("Stopping peer gracefully")
+	sw.network.disconnect(peer.(*Peer).conn, nil)
+}
+
+// IsDialingOrExistingAddress implements p2p.Switcher.
+func (sw *Switch) IsDialingOrExistingAddress(addr *p2p.NetAddress) bool {
+	return sw.peers.Has(addr.ID)
+}
+
+// IsPeerPersistent implements p2p.Switcher.
+func (sw *Switch) IsPeerPersistent(addr *p2p.NetAddress) bool {
+	return sw.isPersistent(addr.ID)
+}
+
+func (sw *Switch) isPersistent(id p2p.ID) bool {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	_, ok := sw.persistentPeers[id]
+	return ok
+}
+
+// IsPeerUnconditional implements p2p.Switcher.
+func (sw *Switch) IsPeerUnconditional(id p2p.ID) bool {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	_, ok := sw.unconditionalPeerIDs[id]
+	return ok
+}
+
+// MarkPeerAsGood implements p2p.Switcher. It is a no-op without peer exchange.
+func (*Switch) MarkPeerAsGood(p2p.Peer) {}
+
+// BroadcastAsync implements p2p.Switcher.
+func (sw *Switch) BroadcastAsync(e p2p.Envelope) {
+	sw.peers.ForEach(func(p p2p.Peer) {
+		go p.Send(e)
+	})
+}
+
+// TryBroadcast implements p2p.Switcher.
+func (sw *Switch) TryBroadcast(e p2p.Envelope) {
+	sw.peers.ForEach(func(p p2p.Peer) {
+		go p.TrySend(e)
+	})
+}
+
+// reconnectRoutine dials the persistent peers the switch is not connected to,
+// in order, every reconnect interval.
+func (sw *Switch) reconnectRoutine() {
+	ticker := time.NewTicker(sw.network.reconnectInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-sw.Quit():
+			return
+		}
+
+		sw.mtx.Lock()
+		ids := make([]string, 0, len(sw.persistentPeers))
+		for id := range sw.persistentPeers {
+			if !sw.peers.Has(id) {
+				ids = append(ids, string(id))
+			}
+		}
+		sw.mtx.Unlock()
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			err := sw.network.connect(sw, p2p.ID(id))
+			if err != nil && !errors.Is(err, ErrAlreadyConnected) {
+				sw.Logger.Debug("Error reconnecting to peer", "peer", id, "err", err)
+			}
+		}
+	}
+}
+
+// addPeer adds a peer connected through the network, see p2p.Switch.
+func (sw *Switch) addPeer(p *Peer) error {
+	if !sw.IsRunning() {
+		return ErrSwitchNotRunning
+	}
+	p.SetLogger(sw.Logger.With("peer", p.ID()))
+
+	var peer p2p.Peer = p
+	for _, name := range sw.reactorNames {
+		peer = sw.reactors[name].InitPeer(peer)
+	}
+	if err := p.Start(); err != nil {
+		return err
+	}
+	if err := sw.peers.Add(peer); err != nil {
+		return err
+	}
+	for _, name := range sw.reactorNames {
+		sw.reactors[name].AddPeer(peer)
+	}
+	sw.Logger.Debug("Added peer", "peer", p)
+	return nil
+}
+
+// removePeer removes a peer whose connection was closed, see p2p.Switch.
+func (sw *Switch) removePeer(p *Peer, reason any) {
+	if err := p.Stop(); err != nil {
+		// never added, or already removed
+		return
+	}
+	for _, name := range sw.reactorNames {
+		sw.reactors[name].RemovePeer(p, reason)
+	}
+	if !sw.peers.Remove(p) {
+		sw.Logger.Debug("error on peer removal", "peer", p.ID())
+	}
+}
+
+// receive passes a message received from a peer to the reactor of its
+// channel, see p2p.Peer. Errors stop the peer, as they would stop the
+// connection.
+func (sw *Switch) receive(p *Peer, chID byte, msgBytes []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			sw.StopPeerForError(p, fmt.Errorf("recovered from panic: %v", r))
+		}
+	}()
+
+	reactor := sw.reactorsByCh[chID]
+	if reactor == nil {
+		sw.StopPeerForError(p, fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+
+	// give reactors a chance to reject the raw bytes before unmarshalling
+	if f, ok := reactor.(p2p.MsgBytesFilter); ok {
+		if err := f.FilterMsgBytes(chID, p, msgBytes); err != nil {
+			sw.StopPeerForError(p, fmt.Errorf("rejected msg on chID %#x: %w", chID, err))
+			return
+		}
+	}
+
+	mt := sw.msgTypeByChID[chID]
+	msg := proto.Clone(mt)
+	if err := proto.Unmarshal(msgBytes, msg); err != nil {
+		sw.StopPeerForError(p, fmt.Errorf("unmarshaling message: %s into type: %s", err, reflect.TypeOf(mt)))
+		return
+	}
+	if w, ok := msg.(p2p.Unwrapper); ok {
+		var err error
+		msg, err = w.Unwrap()
+		if err != nil {
+			sw.StopPeerForError(p, fmt.Errorf("unwrapping message: %s", err))
+			return
+		}
+	}
+	reactor.Receive(p2p.Envelope{
+		ChannelID: chID,
+		Src:       p,
+		Message:   msg,
+	})
+}
@@ -0,0 +1,269 @@
+package simnet
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+const (
+	// DefaultValidatorPower is the voting power of the validators of a
+	// Testnet.
+	DefaultValidatorPower = 10
+
+	// testnetChainID is the chain ID of a Testnet.
+	testnetChainID = "simnet"
+)
+
+// TestnetConfig is the configuration of a Testnet.
+type TestnetConfig struct {
+	// Validators is the number of validators, which are all the nodes.
+	Validators int
+	// Seed is the seed of the keys of the nodes and of the network, see
+	// Network.
+	Seed int64
+	// Link is the default link between nodes.
+	Link Link
+	// Logger is the logger of the nodes, which log nothing if nil.
+	Logger log.Logger
+	// ConfigureNode, if set, is called to adjust the configuration of every
+	// node, after the testnet set it up.
+	ConfigureNode func(i int, config *cfg.Config)
+	// NewApplication, if set, returns the application of every node. Nodes
+	// run an in-memory kvstore otherwise.
+	NewApplication func(i int) abci.Application
+}
+
+// Testnet is a network of full nodes running in-process on a simulated
+// Network. All nodes are validators with the same voting power and every
+// node is a persistent peer of every other node.
+type Testnet struct {
+	Network *Network
+	Nodes   []*Node
+
+	t       testing.TB
+	genesis *types.GenesisDoc
+}
+
+// Node is a node of a Testnet.
+type Node struct {
+	*node.Node
+
+	// Name is the moniker of the node.
+	Name string
+	// Clock is the clock of the node, which consensus uses.
+	Clock *Clock
+}
+
+// Height returns the height of the last block the node committed.
+func (n *Node) Height() int64 {
+	return n.BlockStore().Height()
+}
+
+// Clock is a clock skewed from the wall clock by an adjustable offset.
+type Clock struct {
+	skew atomic.Int64
+}
+
+// Now returns the current time of the clock.
+func (c *Clock) Now() time.Time {
+	return cmttime.Now().Add(c.Skew())
+}
+
+// Skew returns the offset of the clock from the wall clock.
+func (c *Clock) Skew() time.Duration {
+	return time.Duration(c.skew.Load())
+}
+
+// SetSkew sets the offset of the clock from the wall clock.
+func (c *Clock) SetSkew(skew time.Duration) {
+	c.skew.Store(int64(skew))
+}
+
+// NewTestnet returns a new Testnet of config.Validators nodes, which are
+// stopped with the test. Start must be called to start them.
+func NewTestnet(t testing.TB, config TestnetConfig) *Testnet {
+	t.Helper()
+	if config.Validators < 1 || config.Validators > 254 {
+		t.Fatalf("number of validators must be between 1 and 254, got %d", config.Validators)
+	}
+	logger := config.Logger
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+
+	tn := &Testnet{
+		Network: NewNetwork(
+			WithSeed(config.Seed),
+			WithDefaultLink(config.Link),
+			WithLogger(logger.With("module", "simnet")),
+		),
+		t: t,
+		genesis: &types.GenesisDoc{
+			GenesisTime:     cmttime.Now(),
+			ChainID:         testnetChainID,
+			InitialHeight:   1,
+			ConsensusParams: types.DefaultConsensusParams(),
+		},
+	}
+
+	var (
+		privVals = make([]types.PrivValidator, config.Validators)
+		nodeKeys = make([]*p2p.NodeKey, config.Validators)
+		addrs    = make([]string, config.Validators)
+	)
+	for i := range privVals {
+		name := nodeName(i)
+		privKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("%d/%s/validator", config.Seed, name)))
+		privVals[i] = types.NewMockPVWithParams(privKey, false, false)
+		nodeKeys[i] = &p2p.NodeKey{
+			PrivKey: ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("%d/%s/node", config.Seed, name))),
+		}
+		addrs[i] = p2p.IDAddressString(nodeKeys[i].ID(), nodeAddress(i))
+		tn.genesis.Validators = append(tn.genesis.Validators, types.GenesisValidator{
+			Address: privKey.PubKey().Address(),
+			PubKey:  privKey.PubKey(),
+			Power:   DefaultValidatorPower,
+			Name:    name,
+		})
+	}
+
+	for i := range privVals {
+		tn.Nodes = append(tn.Nodes, tn.newNode(i, config, logger, privVals[i], nodeKeys[i], addrs))
+	}
+	t.Cleanup(tn.Stop)
+	return tn
+}
+
+func (tn *Testnet) newNode(
+	i int,
+	config TestnetConfig,
+	logger log.Logger,
+	privVal types.PrivValidator,
+	nodeKey *p2p.NodeKey,
+	addrs []string,
+) *Node {
+	t := tn.t
+	t.Helper()
+
+	name := nodeName(i)
+	root := filepath.Join(t.TempDir(), name)
+	cfg.EnsureRoot(root)
+
+	nodeConfig := cfg.TestConfig().SetRoot(root)
+	nodeConfig.Moniker = name
+	nodeConfig.RPC.ListenAddress = ""
+	nodeConfig.RPC.GRPCListenAddress = ""
+	nodeConfig.P2P.ListenAddress = "tcp://" + nodeAddress(i)
+	nodeConfig.P2P.PexReactor = false
+	peers := make([]string, 0, len(addrs)-1)
+	for j, addr := range addrs {
+		if j != i {
+			peers = append(peers, addr)
+		}
+	}
+	nodeConfig.P2P.PersistentPeers = strings.Join(peers, ",")
+	if config.ConfigureNode != nil {
+		config.ConfigureNode(i, nodeConfig)
+	}
+
+	var app abci.Application = kvstore.NewInMemoryApplication()
+	if config.NewApplication != nil {
+		app = config.NewApplication(i)
+	}
+
+	clock := &Clock{}
+	n, err := node.NewNode(
+		nodeConfig,
+		privVal,
+		nodeKey,
+		proxy.NewLocalClientCreator(app),
+		func() (*types.GenesisDoc, error) { return tn.genesis, nil },
+		cfg.DefaultDBProvider,
+		node.DefaultMetricsProvider(nodeConfig.Instrumentation),
+		logger.With("node", name),
+		node.CustomSwitch(func(nodeInfo p2p.NodeInfo, reactors map[string]p2p.Reactor) p2p.Switcher {
+			sw := NewSwitch(tn.Network, nodeInfo, reactors)
+			sw.SetLogger(logger.With("node", name, "module", "p2p"))
+			if err := sw.AddPersistentPeers(peers); err != nil {
+				t.Fatalf("adding persistent peers of %s: %v", name, err)
+			}
+			return sw
+		}),
+		node.Clock(clock.Now),
+	)
+	if err != nil {
+		t.Fatalf("creating %s: %v", name, err)
+	}
+	return &Node{Node: n, Name: name, Clock: clock}
+}
+
+// Start starts the nodes.
+func (tn *Testnet) Start() {
+	tn.t.Helper()
+	for _, n := range tn.Nodes {
+		if err := n.Start(); err != nil {
+			tn.t.Fatalf("starting %s: %v", n.Name, err)
+		}
+	}
+}
+
+// Stop stops the nodes which are running and shuts the network down. It is
+// called when the test ends.
+func (tn *Testnet) Stop() {
+	for _, n := range tn.Nodes {
+		if !n.IsRunning() {
+			continue
+		}
+		if err := n.Stop(); err != nil {
+			tn.t.Errorf("stopping %s: %v", n.Name, err)
+		}
+		n.Wait()
+	}
+	tn.Network.Shutdown()
+}
+
+// Heights returns the height of the last block committed by every node.
+func (tn *Testnet) Heights() []int64 {
+	heights := make([]int64, len(tn.Nodes))
+	for i, n := range tn.Nodes {
+		heights[i] = n.Height()
+	}
+	return heights
+}
+
+// nodeIDs returns the IDs of the nodes with the given indexes.
+func (tn *Testnet) nodeIDs(nodes []int) ([]p2p.ID, error) {
+	ids := make([]p2p.ID, len(nodes))
+	for i, node := range nodes {
+		if node < 0 || node >= len(tn.Nodes) {
+			return nil, fmt.Errorf("no node %d in a testnet of %d nodes", node, len(tn.Nodes))
+		}
+		ids[i] = tn.Nodes[node].NodeInfo().ID()
+	}
+	return ids, nil
+}
+
+func nodeName(i int) string {
+	return fmt.Sprintf("node%02d", i)
+}
+
+// nodeAddress returns the address nodes listen on, which is never dialed.
+func nodeAddress(i int) string {
+	return net.JoinHostPort(net.IPv4(10, 0, 0, byte(i+1)).String(), "26656")
+}
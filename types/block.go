@@ -147,18 +147,67 @@ func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
 	if b == nil {
 		return nil, errors.New("nil block")
 	}
-	b.mtx.Lock()
-	defer b.mtx.Unlock()
+	bz, err := b.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return NewPartSetFromData(bz, partSize), nil
+}
 
-	pbb, err := b.ToProto()
+// MakeErasureCodedPartSet returns an erasure coded PartSet of a serialized
+// block, with the given number of parity parts. Any ErasureCodedDataParts of
+// its parts reconstruct the block.
+// See NewErasureCodedPartSetFromData.
+func (b *Block) MakeErasureCodedPartSet(partSize, parityParts uint32) (*PartSet, error) {
+	if b == nil {
+		return nil, errors.New("nil block")
+	}
+	bz, err := b.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return NewErasureCodedPartSetFromData(bz, partSize, parityParts)
+}
+
+// MakePartSetForHeader returns the PartSet of a serialized block encoded like
+// the given header: erasure coded if the header has more parts than a plain
+// PartSet would, with the remaining parts (at most as many as the data parts)
+// as parity parts. Otherwise, it returns the plain PartSet of the block: the
+// caller must check the header of the returned PartSet.
+func (b *Block) MakePartSetForHeader(partSize uint32, header PartSetHeader) (*PartSet, error) {
+	if b == nil {
+		return nil, errors.New("nil block")
+	}
+	bz, err := b.marshal()
 	if err != nil {
 		return nil, err
 	}
-	bz, err := proto.Marshal(pbb)
+	partSet := NewPartSetFromData(bz, partSize)
+	if partSet.HasHeader(header) {
+		return partSet, nil
+	}
+	if partSize <= ErasureCodedPartHeaderSize {
+		return partSet, nil
+	}
+	dataParts := ErasureCodedDataParts(len(bz), partSize)
+	if header.Total <= dataParts || header.Total-dataParts > dataParts {
+		return partSet, nil
+	}
+	if codedPartSet, err := NewErasureCodedPartSetFromData(bz, partSize, header.Total-dataParts); err == nil {
+		return codedPartSet, nil
+	}
+	return partSet, nil
+}
+
+func (b *Block) marshal() ([]byte, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	pbb, err := b.ToProto()
 	if err != nil {
 		return nil, err
 	}
-	return NewPartSetFromData(bz, partSize), nil
+	return proto.Marshal(pbb)
 }
 
 // HashesTo is a convenience function that checks if a block hashes to the given argument.
@@ -730,9 +779,9 @@ func (cs *CommitSig) FromProto(csp cmtproto.CommitSig) error {
 // ExtendedCommitSig contains a commit signature along with its corresponding
 // vote extension and vote extension signature.
 type ExtendedCommitSig struct {
-	CommitSig                 // Commit signature
-	Extension          []byte // Vote extension
-	ExtensionSignature []byte // Vote extension signature
+	CommitSig          `json:"commit_sig"` // Commit signature
+	Extension          []byte              `json:"extension"`           // Vote extension
+	ExtensionSignature []byte              `json:"extension_signature"` // Vote extension signature
 }
 
 // NewExtendedCommitSigAbsent returns new ExtendedCommitSig with
@@ -1061,10 +1110,10 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 // ExtendedCommit is similar to Commit, except that its signatures also retain
 // their corresponding vote extensions and vote extension signatures.
 type ExtendedCommit struct {
-	Height             int64
-	Round              int32
-	BlockID            BlockID
-	ExtendedSignatures []ExtendedCommitSig
+	Height             int64               `json:"height"`
+	Round              int32               `json:"round"`
+	BlockID            BlockID             `json:"block_id"`
+	ExtendedSignatures []ExtendedCommitSig `json:"extended_signatures"`
 
 	bitArray *bits.BitArray
 }
@@ -145,6 +145,41 @@ func TestBlockMakePartSetWithEvidence(t *testing.T) {
 	assert.EqualValues(t, 4, partSet.Total())
 }
 
+func TestBlockMakeErasureCodedPartSet(t *testing.T) {
+	bps, err := (*Block)(nil).MakeErasureCodedPartSet(1024, 1)
+	assert.Error(t, err)
+	assert.Nil(t, bps)
+
+	block := MakeBlock(int64(3), []Tx{Tx("Hello World"), cmtrand.Bytes(3000)}, nil, nil)
+
+	partSet, err := block.MakeErasureCodedPartSet(1024, 2)
+	require.NoError(t, err)
+	assert.True(t, partSet.IsErasureCoded())
+	assert.EqualValues(t, 4, partSet.DataParts())
+	assert.EqualValues(t, 6, partSet.Total())
+
+	plainPartSet, err := block.MakePartSet(1024)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		header PartSetHeader
+		expect *PartSet
+	}{
+		{"plain", plainPartSet.Header(), plainPartSet},
+		{"erasure coded", partSet.Header(), partSet},
+		{"too many parity parts", PartSetHeader{Total: 9, Hash: partSet.Hash()}, plainPartSet},
+		{"max parts", PartSetHeader{Total: math.MaxUint32, Hash: partSet.Hash()}, plainPartSet},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ps, err := block.MakePartSetForHeader(1024, tc.header)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect.Header(), ps.Header())
+		})
+	}
+}
+
 func TestBlockHashesTo(t *testing.T) {
 	assert.False(t, (*Block)(nil).HashesTo(nil))
 
@@ -164,6 +164,18 @@ func (b *EventBus) PublishEventNewEvidence(evidence EventDataNewEvidence) error
 	return b.Publish(EventNewEvidence, evidence)
 }
 
+func (b *EventBus) PublishEventEvidenceAdded(evidence EventDataNewEvidence) error {
+	return b.Publish(EventEvidenceAdded, evidence)
+}
+
+func (b *EventBus) PublishEventEvidenceProposed(evidence EventDataNewEvidence) error {
+	return b.Publish(EventEvidenceProposed, evidence)
+}
+
+func (b *EventBus) PublishEventExtendedCommit(data EventDataExtendedCommit) error {
+	return b.Publish(EventExtendedCommit, data)
+}
+
 func (b *EventBus) PublishEventVote(data EventDataVote) error {
 	return b.Publish(EventVote, data)
 }
@@ -265,6 +277,18 @@ func (NopEventBus) PublishEventNewEvidence(EventDataNewEvidence) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventEvidenceAdded(EventDataNewEvidence) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventEvidenceProposed(EventDataNewEvidence) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventExtendedCommit(EventDataExtendedCommit) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventVote(EventDataVote) error {
 	return nil
 }
@@ -369,7 +369,7 @@ func TestEventBusPublish(t *testing.T) {
 		}
 	})
 
-	const numEventsExpected = 15
+	const numEventsExpected = 17
 
 	sub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.All, numEventsExpected)
 	require.NoError(t, err)
@@ -394,6 +394,10 @@ func TestEventBusPublish(t *testing.T) {
 	require.NoError(t, err)
 	err = eventBus.PublishEventNewBlockEvents(EventDataNewBlockEvents{Height: 1})
 	require.NoError(t, err)
+	err = eventBus.PublishEventEvidenceAdded(EventDataNewEvidence{})
+	require.NoError(t, err)
+	err = eventBus.PublishEventEvidenceProposed(EventDataNewEvidence{})
+	require.NoError(t, err)
 	err = eventBus.PublishEventVote(EventDataVote{})
 	require.NoError(t, err)
 	err = eventBus.PublishEventNewRoundStep(EventDataRoundState{})
@@ -23,6 +23,20 @@ const (
 	EventTx                  = "Tx"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
+	// EventExtendedCommit is triggered by the consensus state once the
+	// extended commit of a height, with the vote extensions of its
+	// precommits, is saved, if vote extensions are enabled.
+	EventExtendedCommit = "ExtendedCommit"
+
+	// Evidence lifecycle events.
+	// EventEvidenceAdded is triggered by the evidence pool once a piece of
+	// evidence has been verified and added to the pending pool.
+	// EventEvidenceProposed is triggered by the state package for every piece
+	// of evidence included in a proposal passed to ProcessProposal.
+	// Committed evidence is reported via EventNewEvidence.
+	EventEvidenceAdded    = "EvidenceAdded"
+	EventEvidenceProposed = "EvidenceProposed"
+
 	// Internal consensus events.
 	// These are used for testing the consensus state machine.
 	// They can also be used to build real-time consensus visualizers.
@@ -58,6 +72,7 @@ func init() {
 	cmtjson.RegisterType(EventDataCompleteProposal{}, "tendermint/event/CompleteProposal")
 	cmtjson.RegisterType(EventDataVote{}, "tendermint/event/Vote")
 	cmtjson.RegisterType(EventDataValidatorSetUpdates{}, "tendermint/event/ValidatorSetUpdates")
+	cmtjson.RegisterType(EventDataExtendedCommit{}, "tendermint/event/ExtendedCommit")
 	cmtjson.RegisterType(EventDataString(""), "tendermint/event/ProposalString")
 }
 
@@ -80,11 +95,19 @@ type EventDataNewBlockEvents struct {
 	NumTxs int64        `json:"num_txs,string"` // Number of txs in a block
 }
 
+// EventDataNewEvidence is used by all evidence events. Height is the height of
+// the block the evidence was committed in (NewEvidence) or proposed in
+// (EvidenceProposed), and the height of the misbehavior for EvidenceAdded.
 type EventDataNewEvidence struct {
 	Height   int64    `json:"height"`
 	Evidence Evidence `json:"evidence"`
 }
 
+type EventDataExtendedCommit struct {
+	Height         int64           `json:"height"`
+	ExtendedCommit *ExtendedCommit `json:"extended_commit"`
+}
+
 // All txs fire EventDataTx
 type EventDataTx struct {
 	abci.TxResult
@@ -153,6 +176,9 @@ var (
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
 	EventQueryNewBlockEvents      = QueryForEvent(EventNewBlockEvents)
 	EventQueryNewEvidence         = QueryForEvent(EventNewEvidence)
+	EventQueryEvidenceAdded       = QueryForEvent(EventEvidenceAdded)
+	EventQueryEvidenceProposed    = QueryForEvent(EventEvidenceProposed)
+	EventQueryExtendedCommit      = QueryForEvent(EventExtendedCommit)
 	EventQueryNewRound            = QueryForEvent(EventNewRound)
 	EventQueryNewRoundStep        = QueryForEvent(EventNewRoundStep)
 	EventQueryPolka               = QueryForEvent(EventPolka)
@@ -180,10 +206,16 @@ type BlockEventPublisher interface {
 	PublishEventNewBlockHeader(header EventDataNewBlockHeader) error
 	PublishEventNewBlockEvents(events EventDataNewBlockEvents) error
 	PublishEventNewEvidence(evidence EventDataNewEvidence) error
+	PublishEventEvidenceProposed(evidence EventDataNewEvidence) error
 	PublishEventTx(EventDataTx) error
 	PublishEventValidatorSetUpdates(EventDataValidatorSetUpdates) error
 }
 
+// EvidenceEventPublisher publishes evidence pool related events.
+type EvidenceEventPublisher interface {
+	PublishEventEvidenceAdded(evidence EventDataNewEvidence) error
+}
+
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
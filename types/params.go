@@ -53,6 +53,9 @@ type ConsensusParams struct {
 	Version   VersionParams   `json:"version"`
 	ABCI      ABCIParams      `json:"abci"`
 	Authority AuthorityParams `json:"authority"`
+	Timeout   TimeoutParams   `json:"timeout"`
+	Feature   FeatureParams   `json:"feature"`
+	Proposer  ProposerParams  `json:"proposer"`
 }
 
 // BlockParams define limits on the block size and gas plus minimum time
@@ -115,6 +118,69 @@ type AuthorityParams struct {
 	Authority string `json:"authority"`
 }
 
+// TimeoutParams configure the timeouts of the consensus rounds. They apply to
+// the validators from FeatureParams.TimeoutParamsEnableHeight, so that the
+// block time doesn't depend on the configuration of each validator; until
+// then, and on the other nodes, the timeouts of the local configuration apply.
+type TimeoutParams struct {
+	Propose             time.Duration `json:"propose"`
+	ProposeDelta        time.Duration `json:"propose_delta"`
+	Prevote             time.Duration `json:"prevote"`
+	PrevoteDelta        time.Duration `json:"prevote_delta"`
+	Precommit           time.Duration `json:"precommit"`
+	PrecommitDelta      time.Duration `json:"precommit_delta"`
+	Commit              time.Duration `json:"commit"`
+	BypassCommitTimeout bool          `json:"bypass_commit_timeout"`
+}
+
+// ProposeTimeout returns the amount of time to wait for a proposal in the
+// given round.
+func (t TimeoutParams) ProposeTimeout(round int32) time.Duration {
+	return t.Propose + t.ProposeDelta*time.Duration(round)
+}
+
+// PrevoteTimeout returns the amount of time to wait for straggler votes after
+// receiving any +2/3 prevotes in the given round.
+func (t TimeoutParams) PrevoteTimeout(round int32) time.Duration {
+	return t.Prevote + t.PrevoteDelta*time.Duration(round)
+}
+
+// PrecommitTimeout returns the amount of time to wait for straggler votes
+// after receiving any +2/3 precommits in the given round.
+func (t TimeoutParams) PrecommitTimeout(round int32) time.Duration {
+	return t.Precommit + t.PrecommitDelta*time.Duration(round)
+}
+
+// CommitTime returns the time at which to start the next height after
+// committing a block at time t, waiting for straggler precommits.
+func (t TimeoutParams) CommitTime(commitTime time.Time) time.Time {
+	return commitTime.Add(t.Commit)
+}
+
+// FeatureParams configure the heights from which consensus features are
+// enabled.
+type FeatureParams struct {
+	TimeoutParamsEnableHeight int64 `json:"timeout_params_enable_height"`
+}
+
+// TimeoutParamsEnabled returns true if the validators use the TimeoutParams
+// at height h and false otherwise.
+func (f FeatureParams) TimeoutParamsEnabled(h int64) bool {
+	if h < 1 {
+		panic(fmt.Errorf("cannot check if timeout params enabled for height %d (< 1)", h))
+	}
+	if f.TimeoutParamsEnableHeight == 0 {
+		return false
+	}
+	return f.TimeoutParamsEnableHeight <= h
+}
+
+// ProposerParams configure how the proposer of each round is selected, see
+// ProposerSelector.
+type ProposerParams struct {
+	Selection cmtproto.ProposerSelection `json:"selection"`
+}
+
 // DefaultConsensusParams returns a default ConsensusParams.
 func DefaultConsensusParams() *ConsensusParams {
 	return &ConsensusParams{
@@ -124,6 +190,9 @@ func DefaultConsensusParams() *ConsensusParams {
 		Version:   DefaultVersionParams(),
 		ABCI:      DefaultABCIParams(),
 		Authority: DefaultAuthorityParams(),
+		Timeout:   DefaultTimeoutParams(),
+		Feature:   DefaultFeatureParams(),
+		Proposer:  DefaultProposerParams(),
 	}
 }
 
@@ -177,6 +246,36 @@ func DefaultAuthorityParams() AuthorityParams {
 	}
 }
 
+// DefaultTimeoutParams returns a default TimeoutParams, matching the default
+// timeouts of the consensus configuration.
+func DefaultTimeoutParams() TimeoutParams {
+	return TimeoutParams{
+		Propose:             3000 * time.Millisecond,
+		ProposeDelta:        500 * time.Millisecond,
+		Prevote:             1000 * time.Millisecond,
+		PrevoteDelta:        500 * time.Millisecond,
+		Precommit:           1000 * time.Millisecond,
+		PrecommitDelta:      500 * time.Millisecond,
+		Commit:              1000 * time.Millisecond,
+		BypassCommitTimeout: false,
+	}
+}
+
+func DefaultFeatureParams() FeatureParams {
+	return FeatureParams{
+		// When set to 0, the timeouts of the local configuration apply.
+		TimeoutParamsEnableHeight: 0,
+	}
+}
+
+// DefaultProposerParams returns a default ProposerParams, which selects the
+// proposers by weighted round-robin.
+func DefaultProposerParams() ProposerParams {
+	return ProposerParams{
+		Selection: cmtproto.ProposerSelectionRoundRobin,
+	}
+}
+
 func IsValidPubkeyType(params ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -248,6 +347,22 @@ func (params ConsensusParams) ValidateBasic() error {
 		}
 	}
 
+	if err := params.Timeout.validateBasic(); err != nil {
+		return err
+	}
+
+	if params.Feature.TimeoutParamsEnableHeight < 0 {
+		return fmt.Errorf("feature.TimeoutParamsEnableHeight cannot be negative. Got: %d",
+			params.Feature.TimeoutParamsEnableHeight)
+	}
+	if params.Feature.TimeoutParamsEnableHeight > 0 && params.Timeout.Propose == 0 {
+		return errors.New("timeout.Propose must be greater than 0 when timeout params are enabled")
+	}
+
+	if _, ok := cmtproto.ProposerSelection_name[int32(params.Proposer.Selection)]; !ok {
+		return fmt.Errorf("unknown proposer.Selection %d", params.Proposer.Selection)
+	}
+
 	// Validate Authority params
 	const maxAuthorityLength = 256
 	if len(params.Authority.Authority) > maxAuthorityLength {
@@ -258,7 +373,30 @@ func (params ConsensusParams) ValidateBasic() error {
 	return nil
 }
 
-// ValidateUpdate validates the updated VoteExtensionsEnableHeight.
+func (t TimeoutParams) validateBasic() error {
+	for _, timeout := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"Propose", t.Propose},
+		{"ProposeDelta", t.ProposeDelta},
+		{"Prevote", t.Prevote},
+		{"PrevoteDelta", t.PrevoteDelta},
+		{"Precommit", t.Precommit},
+		{"PrecommitDelta", t.PrecommitDelta},
+		{"Commit", t.Commit},
+	} {
+		if timeout.value < 0 {
+			return fmt.Errorf("timeout.%s cannot be negative. Got: %v", timeout.name, timeout.value)
+		}
+	}
+	return nil
+}
+
+// ValidateUpdate validates the updated VoteExtensionsEnableHeight and
+// TimeoutParamsEnableHeight, see validateTimeoutParamsUpdate.
+// The updated VoteExtensionsEnableHeight is validated as follows:
+//
 // | r | params...EnableHeight | updated...EnableHeight | result (nil == pass)
 // |  1 | *                    | (nil)                  | nil
 // |  2 | *                    | < 0                    | VoteExtensionsEnableHeight must be positive
@@ -271,6 +409,12 @@ func (params ConsensusParams) ValidateBasic() error {
 // |  9 | (> 0) <=height       | > height (*)           | vote extensions cannot be modified once enabled
 // | 10 | (> 0) > height       | > height (*)           | nil
 func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams, h int64) error {
+	if updated != nil && updated.Feature != nil {
+		err := params.Feature.validateTimeoutParamsUpdate(updated.Feature.TimeoutParamsEnableHeight, h)
+		if err != nil {
+			return err
+		}
+	}
 	// 1
 	if updated == nil || updated.Abci == nil {
 		return nil
@@ -318,6 +462,27 @@ func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams,
 	return nil
 }
 
+// validateTimeoutParamsUpdate validates the updated TimeoutParamsEnableHeight
+// at height h: once enabled, the timeout params can't be disabled, and they
+// can only be enabled from a future height.
+func (f FeatureParams) validateTimeoutParamsUpdate(updated int64, h int64) error {
+	switch {
+	case updated < 0:
+		return errors.New("TimeoutParamsEnableHeight must be positive")
+	case updated == f.TimeoutParamsEnableHeight:
+		return nil
+	case f.TimeoutParamsEnableHeight > 0 && f.TimeoutParamsEnableHeight <= h:
+		return fmt.Errorf("timeout params cannot be modified once enabled, "+
+			"enable height: %d, current height %d",
+			f.TimeoutParamsEnableHeight, h)
+	case updated > 0 && updated <= h:
+		return fmt.Errorf("timeout params cannot be enabled at a past or current height, "+
+			"enable height: %d, current height %d",
+			updated, h)
+	}
+	return nil
+}
+
 // Hash returns a hash of a subset of the parameters to store in the block header.
 // Only the Block.MaxBytes and Block.MaxGas are included in the hash.
 // This allows the ConsensusParams to evolve more without breaking the block
@@ -375,6 +540,15 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 	if params2.Authority != nil {
 		res.Authority.Authority = params2.Authority.Authority
 	}
+	if params2.Timeout != nil {
+		res.Timeout = TimeoutParamsFromProto(params2.Timeout)
+	}
+	if params2.Feature != nil {
+		res.Feature.TimeoutParamsEnableHeight = params2.Feature.GetTimeoutParamsEnableHeight()
+	}
+	if params2.Proposer != nil {
+		res.Proposer.Selection = params2.Proposer.GetSelection()
+	}
 	return res
 }
 
@@ -401,6 +575,39 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 		Authority: &cmtproto.AuthorityParams{
 			Authority: params.Authority.Authority,
 		},
+		Timeout: params.Timeout.ToProto(),
+		Feature: &cmtproto.FeatureParams{
+			TimeoutParamsEnableHeight: params.Feature.TimeoutParamsEnableHeight,
+		},
+		Proposer: &cmtproto.ProposerParams{
+			Selection: params.Proposer.Selection,
+		},
+	}
+}
+
+func (t TimeoutParams) ToProto() *cmtproto.TimeoutParams {
+	return &cmtproto.TimeoutParams{
+		Propose:             t.Propose,
+		ProposeDelta:        t.ProposeDelta,
+		Prevote:             t.Prevote,
+		PrevoteDelta:        t.PrevoteDelta,
+		Precommit:           t.Precommit,
+		PrecommitDelta:      t.PrecommitDelta,
+		Commit:              t.Commit,
+		BypassCommitTimeout: t.BypassCommitTimeout,
+	}
+}
+
+func TimeoutParamsFromProto(pbParams *cmtproto.TimeoutParams) TimeoutParams {
+	return TimeoutParams{
+		Propose:             pbParams.Propose,
+		ProposeDelta:        pbParams.ProposeDelta,
+		Prevote:             pbParams.Prevote,
+		PrevoteDelta:        pbParams.PrevoteDelta,
+		Precommit:           pbParams.Precommit,
+		PrecommitDelta:      pbParams.PrecommitDelta,
+		Commit:              pbParams.Commit,
+		BypassCommitTimeout: pbParams.BypassCommitTimeout,
 	}
 }
 
@@ -428,5 +635,14 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 	if pbParams.Authority != nil {
 		c.Authority.Authority = pbParams.Authority.Authority
 	}
+	if pbParams.Timeout != nil {
+		c.Timeout = TimeoutParamsFromProto(pbParams.Timeout)
+	}
+	if pbParams.Feature != nil {
+		c.Feature.TimeoutParamsEnableHeight = pbParams.Feature.GetTimeoutParamsEnableHeight()
+	}
+	if pbParams.Proposer != nil {
+		c.Proposer.Selection = pbParams.Proposer.GetSelection()
+	}
 	return c
 }
@@ -172,6 +172,115 @@ func TestConsensusParamsUpdate_Authority(t *testing.T) {
 	assert.Equal(t, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", updated.Authority.Authority)
 }
 
+func TestConsensusParamsUpdate_Timeout(t *testing.T) {
+	params := makeParams(1, 2, 3, 0, valEd25519, 0, "")
+
+	timeout := DefaultTimeoutParams()
+	timeout.Commit = 5 * time.Second
+	timeout.BypassCommitTimeout = true
+	updated := params.Update(&cmtproto.ConsensusParams{
+		Timeout: timeout.ToProto(),
+		Feature: &cmtproto.FeatureParams{TimeoutParamsEnableHeight: 10},
+	})
+	require.NoError(t, updated.ValidateBasic())
+	assert.Equal(t, timeout, updated.Timeout)
+	assert.EqualValues(t, 10, updated.Feature.TimeoutParamsEnableHeight)
+	assert.Equal(t, updated, ConsensusParamsFromProto(updated.ToProto()))
+
+	assert.False(t, updated.Feature.TimeoutParamsEnabled(9))
+	assert.True(t, updated.Feature.TimeoutParamsEnabled(10))
+	assert.False(t, params.Feature.TimeoutParamsEnabled(10))
+
+	// the timeouts must be set when enabled
+	updated = params.Update(&cmtproto.ConsensusParams{
+		Feature: &cmtproto.FeatureParams{TimeoutParamsEnableHeight: 10},
+	})
+	require.Error(t, updated.ValidateBasic())
+
+	timeout.PrevoteDelta = -time.Second
+	updated = params.Update(&cmtproto.ConsensusParams{Timeout: timeout.ToProto()})
+	require.Error(t, updated.ValidateBasic())
+}
+
+func TestConsensusParamsUpdate_Proposer(t *testing.T) {
+	params := makeParams(1, 2, 3, 0, valEd25519, 0, "")
+	assert.Equal(t, cmtproto.ProposerSelectionRoundRobin, params.Proposer.Selection)
+
+	updated := params.Update(&cmtproto.ConsensusParams{
+		Proposer: &cmtproto.ProposerParams{Selection: cmtproto.ProposerSelectionHash},
+	})
+	require.NoError(t, updated.ValidateBasic())
+	assert.Equal(t, cmtproto.ProposerSelectionHash, updated.Proposer.Selection)
+	assert.Equal(t, updated, ConsensusParamsFromProto(updated.ToProto()))
+
+	updated = params.Update(&cmtproto.ConsensusParams{
+		Proposer: &cmtproto.ProposerParams{Selection: 10},
+	})
+	require.Error(t, updated.ValidateBasic())
+}
+
+func TestTimeoutParams(t *testing.T) {
+	timeout := TimeoutParams{
+		Propose:        3 * time.Second,
+		ProposeDelta:   500 * time.Millisecond,
+		Prevote:        time.Second,
+		PrevoteDelta:   100 * time.Millisecond,
+		Precommit:      2 * time.Second,
+		PrecommitDelta: 200 * time.Millisecond,
+		Commit:         time.Second,
+	}
+	assert.Equal(t, 3*time.Second, timeout.ProposeTimeout(0))
+	assert.Equal(t, 4*time.Second, timeout.ProposeTimeout(2))
+	assert.Equal(t, 1300*time.Millisecond, timeout.PrevoteTimeout(3))
+	assert.Equal(t, 2200*time.Millisecond, timeout.PrecommitTimeout(1))
+
+	now := time.Now()
+	assert.Equal(t, now.Add(time.Second), timeout.CommitTime(now))
+}
+
+func TestConsensusParamsUpdate_TimeoutParamsEnableHeight(t *testing.T) {
+	testCases := []struct {
+		name        string
+		current     int64
+		from        int64
+		to          int64
+		expectedErr bool
+	}{
+		// no change
+		{"current: 3, 0 -> 0", 3, 0, 0, false},
+		{"current: 300, 100 -> 100", 300, 100, 100, false},
+		// set for the first time
+		{"current: 4, 0 -> 5", 4, 0, 5, false},
+		{"current: 5, 0 -> 5", 5, 0, 5, true},
+		{"current: 6, 0 -> 5", 6, 0, 5, true},
+		// reset to 0
+		{"current: 4, 5 -> 0", 4, 5, 0, false},
+		{"current: 5, 5 -> 0", 5, 5, 0, true},
+		// modify
+		{"current: 4, 10 -> 5", 4, 10, 5, false},
+		{"current: 9, 10 -> 15", 9, 10, 15, false},
+		{"current: 10, 10 -> 15", 10, 10, 15, true},
+		{"current: 11, 10 -> 5", 11, 10, 5, true},
+		// negative values
+		{"current: 3, 0 -> -5", 3, 0, -5, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(*testing.T) {
+			initialParams := makeParams(1, 0, 2, 0, valEd25519, 0, "")
+			initialParams.Feature.TimeoutParamsEnableHeight = tc.from
+			update := &cmtproto.ConsensusParams{
+				Feature: &cmtproto.FeatureParams{TimeoutParamsEnableHeight: tc.to},
+			}
+			if tc.expectedErr {
+				require.Error(t, initialParams.ValidateUpdate(update, tc.current))
+			} else {
+				require.NoError(t, initialParams.ValidateUpdate(update, tc.current))
+			}
+		})
+	}
+}
+
 func TestConsensusParamsUpdate_VoteExtensionsEnableHeight(t *testing.T) {
 	const nilTest = -10000000
 	testCases := []struct {
@@ -186,6 +186,12 @@ type PartSet struct {
 	// a count of the total size (in bytes). Used to ensure that the
 	// part set doesn't exceed the maximum block bytes
 	byteSize int64
+
+	// coding of the parts if they're erasure coded, see
+	// NewErasureCodedPartSetFromData
+	erasure *erasureCoding
+	// set once the parts being added turned out not to be erasure coded
+	notErasureCoded bool
 }
 
 // NewPartSetFromData returns an immutable, full PartSet from the data bytes.
@@ -194,15 +200,20 @@ type PartSet struct {
 func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 	// divide data into parts of size `partSize`
 	total := (uint32(len(data)) + partSize - 1) / partSize
-	parts := make([]*Part, total)
 	partsBytes := make([][]byte, total)
 	for i := uint32(0); i < total; i++ {
-		part := &Part{
-			Index: i,
-			Bytes: data[i*partSize : cmtmath.MinInt(len(data), int((i+1)*partSize))],
-		}
-		parts[i] = part
-		partsBytes[i] = part.Bytes
+		partsBytes[i] = data[i*partSize : cmtmath.MinInt(len(data), int((i+1)*partSize))]
+	}
+	return newPartSetFromPartsBytes(partsBytes, int64(len(data)))
+}
+
+// newPartSetFromPartsBytes returns a full PartSet of the given parts, carrying
+// byteSize bytes of data.
+func newPartSetFromPartsBytes(partsBytes [][]byte, byteSize int64) *PartSet {
+	total := uint32(len(partsBytes))
+	parts := make([]*Part, total)
+	for i := uint32(0); i < total; i++ {
+		parts[i] = &Part{Index: i, Bytes: partsBytes[i]}
 	}
 	// Compute merkle proofs
 	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
@@ -216,7 +227,7 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 		parts:         parts,
 		partsBitArray: partsBitArray,
 		count:         total,
-		byteSize:      int64(len(data)),
+		byteSize:      byteSize,
 	}
 }
 
@@ -290,6 +301,25 @@ func (ps *PartSet) Total() uint32 {
 	return ps.total
 }
 
+// IsErasureCoded returns true if the PartSet is complete and erasure coded.
+func (ps *PartSet) IsErasureCoded() bool {
+	if ps == nil {
+		return false
+	}
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	return ps.erasure != nil && ps.count == ps.total
+}
+
+// DataParts returns the number of parts needed to reconstruct the data: the
+// number of data parts of an erasure coded PartSet, or else all the parts.
+func (ps *PartSet) DataParts() uint32 {
+	if !ps.IsErasureCoded() {
+		return ps.Total()
+	}
+	return ps.erasure.dataParts
+}
+
 // CONTRACT: part is validated using ValidateBasic.
 func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	// TODO: remove this? would be preferable if this only returned (false, nil)
@@ -325,7 +355,14 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.parts[part.Index] = part
 	ps.partsBitArray.SetIndex(int(part.Index), true)
 	ps.count++
-	ps.byteSize += int64(len(part.Bytes))
+	if _, ok := erasureCodingFromPart(part); ok {
+		ps.byteSize += int64(len(part.Bytes) - ErasureCodedPartHeaderSize)
+	} else {
+		ps.byteSize += int64(len(part.Bytes))
+	}
+
+	// Complete the set if we can reconstruct its data
+	ps.decodeErasureCoded(part)
 	return true, nil
 }
 
@@ -343,9 +380,22 @@ func (ps *PartSet) GetReader() io.Reader {
 	if !ps.IsComplete() {
 		panic("Cannot GetReader() on incomplete PartSet")
 	}
+	if ps.erasure != nil {
+		return ps.erasure.reader(ps.parts)
+	}
 	return NewPartSetReader(ps.parts)
 }
 
+// NewBlockPartsReader returns a reader of the serialized block carried by the
+// parts of a complete PartSet: the concatenation of the parts or, if they're
+// erasure coded, the data they encode. The parts are not verified.
+func NewBlockPartsReader(parts []*Part) io.Reader {
+	if ec, ok := erasureCodingFromPart(parts[0]); ok {
+		return ec.reader(parts)
+	}
+	return NewPartSetReader(parts)
+}
+
 type PartSetReader struct {
 	i      int
 	parts  []*Part
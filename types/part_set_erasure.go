@@ -0,0 +1,202 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/reedsolomon"
+
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+)
+
+const (
+	// ErasureCodedPartHeaderSize is the size of the header prefixed to every
+	// part of an erasure coded PartSet. It is padded so that the coded shards
+	// stay a multiple of 64 bytes, as required by the GF(2^16) codec used for
+	// sets of more than 256 parts.
+	ErasureCodedPartHeaderSize = 64
+
+	erasureCodedShardAlignment = 64
+)
+
+// erasureCodedPartMagic starts the header of an erasure coded part. Its first
+// byte is never the first byte of a serialized block, so the parts of a block
+// can't be mistaken for erasure coded ones (see NewBlockPartsReader).
+var erasureCodedPartMagic = []byte{0x00, 'r', 's', 0x01}
+
+// erasureCoding is the header of every part of an erasure coded PartSet.
+type erasureCoding struct {
+	// number of data parts, any dataParts of the parts reconstruct the data
+	dataParts uint32
+	// size of the data, without the padding of the last data part
+	dataSize uint64
+}
+
+func (ec erasureCoding) bytes() []byte {
+	bz := make([]byte, ErasureCodedPartHeaderSize)
+	copy(bz, erasureCodedPartMagic)
+	binary.BigEndian.PutUint32(bz[4:], ec.dataParts)
+	binary.BigEndian.PutUint64(bz[8:], ec.dataSize)
+	return bz
+}
+
+// erasureCodingFromPart parses the header of an erasure coded part. It returns
+// false if the part is not erasure coded.
+func erasureCodingFromPart(part *Part) (erasureCoding, bool) {
+	bz := part.Bytes
+	if len(bz) <= ErasureCodedPartHeaderSize || !bytes.HasPrefix(bz, erasureCodedPartMagic) {
+		return erasureCoding{}, false
+	}
+	ec := erasureCoding{
+		dataParts: binary.BigEndian.Uint32(bz[4:]),
+		dataSize:  binary.BigEndian.Uint64(bz[8:]),
+	}
+	shardSize := uint64(len(bz) - ErasureCodedPartHeaderSize)
+	if ec.dataParts == 0 || ec.dataSize > uint64(ec.dataParts)*shardSize {
+		return erasureCoding{}, false
+	}
+	return ec, true
+}
+
+// reader returns a reader of the data encoded by the given parts, whose data
+// parts must all be present.
+func (ec erasureCoding) reader(parts []*Part) io.Reader {
+	readers := make([]io.Reader, ec.dataParts)
+	for i, part := range parts[:ec.dataParts] {
+		readers[i] = bytes.NewReader(part.Bytes[ErasureCodedPartHeaderSize:])
+	}
+	return io.LimitReader(io.MultiReader(readers...), int64(ec.dataSize))
+}
+
+// ErasureCodedDataParts returns the number of data parts of an erasure coded
+// PartSet of dataSize bytes with parts of partSize bytes.
+// CONTRACT: partSize is greater than ErasureCodedPartHeaderSize.
+func ErasureCodedDataParts(dataSize int, partSize uint32) uint32 {
+	shardSize := int(partSize - ErasureCodedPartHeaderSize)
+	return uint32(cmtmath.MaxInt((dataSize+shardSize-1)/shardSize, 1))
+}
+
+// NewErasureCodedPartSetFromData returns an immutable, full PartSet from the
+// data bytes, Reed-Solomon coded so that any ErasureCodedDataParts of its
+// parts reconstruct the data.
+//
+// The data is split into data parts, followed by parityParts parity parts.
+// Every part is partSize bytes long and starts with a header describing the
+// coding, so that the Merkle root of the set commits to the coding as well.
+func NewErasureCodedPartSetFromData(data []byte, partSize, parityParts uint32) (*PartSet, error) {
+	if partSize <= ErasureCodedPartHeaderSize || partSize%erasureCodedShardAlignment != 0 {
+		return nil, fmt.Errorf("part size must be a multiple of %d greater than %d, got %d",
+			erasureCodedShardAlignment, ErasureCodedPartHeaderSize, partSize)
+	}
+	if parityParts == 0 {
+		return nil, errors.New("erasure coded part set must have parity parts")
+	}
+
+	ec := erasureCoding{
+		dataParts: ErasureCodedDataParts(len(data), partSize),
+		dataSize:  uint64(len(data)),
+	}
+	total := ec.dataParts + parityParts
+	enc, err := reedsolomon.New(int(ec.dataParts), int(parityParts))
+	if err != nil {
+		return nil, fmt.Errorf("creating erasure coder: %w", err)
+	}
+
+	header := ec.bytes()
+	buf := make([]byte, int(total)*int(partSize))
+	partsBytes := make([][]byte, total)
+	shards := make([][]byte, total)
+	for i := range partsBytes {
+		partsBytes[i] = buf[i*int(partSize) : (i+1)*int(partSize)]
+		copy(partsBytes[i], header)
+		shards[i] = partsBytes[i][ErasureCodedPartHeaderSize:]
+	}
+	for i, shardSize := 0, len(shards[0]); i < int(ec.dataParts); i++ {
+		copy(shards[i], data[cmtmath.MinInt(len(data), i*shardSize):])
+	}
+	if err := enc.Encode(shards); err != nil {
+		return nil, fmt.Errorf("erasure coding data: %w", err)
+	}
+
+	ps := newPartSetFromPartsBytes(partsBytes, int64(len(data)))
+	ps.erasure = &ec
+	return ps, nil
+}
+
+// decodeErasureCoded completes a PartSet being populated with erasure coded
+// parts as soon as it has enough of them to reconstruct the data. The data is
+// erasure coded again and the set is only completed if that yields the same
+// Merkle root, which ensures all nodes decode the same data whichever parts
+// they received. Otherwise, the set is not considered erasure coded anymore
+// and needs all of its parts.
+//
+// CONTRACT: ps.mtx is held and part was just added to ps.
+func (ps *PartSet) decodeErasureCoded(part *Part) {
+	if ps.notErasureCoded || ps.count == ps.total {
+		return
+	}
+
+	ec, ok := erasureCodingFromPart(part)
+	if !ok || ec.dataParts >= ps.total || (ps.erasure != nil && *ps.erasure != ec) {
+		// every part of an erasure coded set has the same header
+		ps.erasure = nil
+		ps.notErasureCoded = true
+		return
+	}
+	ps.erasure = &ec
+	if ps.count < ec.dataParts {
+		return
+	}
+
+	full, err := ps.reconstructErasureCoded()
+	if err != nil || !bytes.Equal(full.hash, ps.hash) {
+		ps.erasure = nil
+		ps.notErasureCoded = true
+		return
+	}
+
+	ps.parts = full.parts
+	ps.partsBitArray = full.partsBitArray
+	ps.count = full.count
+	ps.byteSize = full.byteSize
+}
+
+// reconstructErasureCoded decodes the data of the parts added so far and
+// returns the full erasure coded PartSet of that data.
+//
+// CONTRACT: ps.mtx is held and ps has at least ps.erasure.dataParts parts.
+func (ps *PartSet) reconstructErasureCoded() (*PartSet, error) {
+	var (
+		ec       = *ps.erasure
+		partSize int
+		shards   = make([][]byte, ps.total)
+	)
+	for i, part := range ps.parts {
+		if part == nil {
+			continue
+		}
+		if partSize == 0 {
+			partSize = len(part.Bytes)
+		} else if len(part.Bytes) != partSize {
+			return nil, ErrPartInvalidSize
+		}
+		shards[i] = part.Bytes[ErasureCodedPartHeaderSize:]
+	}
+
+	enc, err := reedsolomon.New(int(ec.dataParts), int(ps.total-ec.dataParts))
+	if err != nil {
+		return nil, err
+	}
+	if err := enc.ReconstructData(shards); err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, int(ec.dataParts)*(partSize-ErasureCodedPartHeaderSize))
+	for _, shard := range shards[:ec.dataParts] {
+		data = append(data, shard...)
+	}
+	return NewErasureCodedPartSetFromData(data[:ec.dataSize], uint32(partSize), ps.total-ec.dataParts)
+}
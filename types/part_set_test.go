@@ -105,6 +105,97 @@ func TestWrongProof(t *testing.T) {
 	}
 }
 
+func TestErasureCodedPartSet(t *testing.T) {
+	const partSize = 1024
+
+	testCases := []struct {
+		name                 string
+		dataSize             int
+		dataParts, totalSize uint32
+	}{
+		{"one data part", 100, 1, 2},
+		{"partial last data part", 10*(partSize-ErasureCodedPartHeaderSize) + 1, 11, 22},
+		// more than 256 parts use the GF(2^16) codec
+		{"many parts", 200 * (partSize - ErasureCodedPartHeaderSize), 200, 400},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := cmtrand.Bytes(tc.dataSize)
+
+			partSet, err := NewErasureCodedPartSetFromData(data, partSize, tc.dataParts)
+			require.NoError(t, err)
+			require.True(t, partSet.IsErasureCoded())
+			require.True(t, partSet.IsComplete())
+			require.Equal(t, tc.dataParts, partSet.DataParts())
+			require.Equal(t, tc.totalSize, partSet.Total())
+			require.EqualValues(t, tc.dataSize, partSet.ByteSize())
+			for i := 0; i < int(partSet.Total()); i++ {
+				require.Len(t, partSet.GetPart(i).Bytes, partSize)
+			}
+
+			// any data parts of the parts reconstruct the data
+			partSet2 := NewPartSetFromHeader(partSet.Header())
+			for _, index := range cmtrand.Perm(int(partSet.Total()))[:tc.dataParts] {
+				require.False(t, partSet2.IsComplete())
+
+				added, err := partSet2.AddPart(partSet.GetPart(index))
+				require.NoError(t, err)
+				require.True(t, added)
+			}
+			require.True(t, partSet2.IsComplete())
+			require.True(t, partSet2.IsErasureCoded())
+			require.Equal(t, partSet.Total(), partSet2.Count())
+			require.Equal(t, partSet.ByteSize(), partSet2.ByteSize())
+			require.True(t, partSet2.BitArray().IsFull())
+			for i := 0; i < int(partSet.Total()); i++ {
+				require.Equal(t, partSet.GetPart(i).Bytes, partSet2.GetPart(i).Bytes)
+			}
+
+			data2, err := io.ReadAll(partSet2.GetReader())
+			require.NoError(t, err)
+			require.Equal(t, data, data2)
+
+			data3, err := io.ReadAll(NewBlockPartsReader(partSet2.parts))
+			require.NoError(t, err)
+			require.Equal(t, data, data3)
+		})
+	}
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		_, err := NewErasureCodedPartSetFromData(cmtrand.Bytes(100), 1000, 1)
+		require.Error(t, err)
+		_, err = NewErasureCodedPartSetFromData(cmtrand.Bytes(100), ErasureCodedPartHeaderSize, 1)
+		require.Error(t, err)
+		_, err = NewErasureCodedPartSetFromData(cmtrand.Bytes(100), partSize, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("plain parts looking erasure coded", func(t *testing.T) {
+		// a plain part set whose second part has a header claiming a single
+		// data part
+		header := erasureCoding{dataParts: 1, dataSize: 10}.bytes()
+		data := append(append(cmtrand.Bytes(partSize), header...), cmtrand.Bytes(2*partSize)...)
+		partSet := NewPartSetFromData(data, partSize)
+		require.False(t, partSet.IsErasureCoded())
+		require.Equal(t, partSet.Total(), partSet.DataParts())
+
+		partSet2 := NewPartSetFromHeader(partSet.Header())
+		for _, index := range []int{1, 3, 0, 2} {
+			require.False(t, partSet2.IsComplete())
+
+			added, err := partSet2.AddPart(partSet.GetPart(index))
+			require.NoError(t, err)
+			require.True(t, added)
+		}
+		require.True(t, partSet2.IsComplete())
+		require.False(t, partSet2.IsErasureCoded())
+
+		data2, err := io.ReadAll(partSet2.GetReader())
+		require.NoError(t, err)
+		require.Equal(t, data, data2)
+	})
+}
+
 func TestPartSetHeaderValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName              string
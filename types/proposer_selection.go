@@ -0,0 +1,96 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// ProposerSelector selects the proposer of each round of a height among its
+// validators. It is chosen by the ProposerParams of the height, see
+// NewProposerSelector.
+type ProposerSelector interface {
+	// Proposer returns the proposer of the round, given the validator set of
+	// the height whose proposer priorities were incremented for the round.
+	Proposer(vals *ValidatorSet, round int32) *Validator
+}
+
+// NewProposerSelector returns the ProposerSelector of the params for the
+// height whose previous block has the hash lastBlockHash, which is empty at
+// the initial height.
+func NewProposerSelector(params ProposerParams, lastBlockHash []byte) ProposerSelector {
+	switch params.Selection {
+	case cmtproto.ProposerSelectionHash:
+		return hashProposerSelector{seed: lastBlockHash}
+	default:
+		return roundRobinProposerSelector{}
+	}
+}
+
+// roundRobinProposerSelector selects the validator with the highest proposer
+// priority, see ValidatorSet.IncrementProposerPriority.
+type roundRobinProposerSelector struct{}
+
+func (roundRobinProposerSelector) Proposer(vals *ValidatorSet, _ int32) *Validator {
+	return vals.GetProposer()
+}
+
+// hashProposerSelector selects a validator with a probability proportional to
+// its voting power, drawn from the hash of the seed and the round. Unlike the
+// round-robin, it only depends on the validators and their voting power, so
+// anyone with a header, its validator set and its commit can verify the
+// proposer.
+type hashProposerSelector struct {
+	seed []byte
+}
+
+func (s hashProposerSelector) Proposer(vals *ValidatorSet, round int32) *Validator {
+	if vals.IsNilOrEmpty() {
+		return nil
+	}
+
+	bz := make([]byte, len(s.seed)+4)
+	copy(bz, s.seed)
+	binary.BigEndian.PutUint32(bz[len(s.seed):], uint32(round))
+	draw := binary.BigEndian.Uint64(tmhash.Sum(bz)) % uint64(vals.TotalVotingPower())
+
+	for _, val := range vals.Validators {
+		if draw < uint64(val.VotingPower) {
+			return val.Copy()
+		}
+		draw -= uint64(val.VotingPower)
+	}
+	panic("draw exceeds the total voting power")
+}
+
+// SetProposer sets the proposer of the set to the proposer of the round
+// selected by selector. The proposer priorities must have been incremented for
+// the round.
+func (vals *ValidatorSet) SetProposer(selector ProposerSelector, round int32) {
+	vals.Proposer = selector.Proposer(vals, round)
+}
+
+// VerifyProposer returns an error if address is not the proposer of the round
+// selected by selector, given the validator set of the height with the
+// proposer priorities of its first round.
+//
+// NOTE: the proposers selected by weighted round-robin depend on the proposer
+// priorities, which aren't part of the headers, so they can only be verified
+// with a validator set from the state.
+func (vals *ValidatorSet) VerifyProposer(selector ProposerSelector, round int32, address Address) error {
+	if vals.IsNilOrEmpty() {
+		return ErrProposerNotInVals
+	}
+	roundVals := vals
+	if round > 0 {
+		roundVals = vals.CopyIncrementProposerPriority(round)
+	}
+	proposer := selector.Proposer(roundVals, round)
+	if !bytes.Equal(proposer.Address, address) {
+		return fmt.Errorf("wrong proposer of round %d: expected %v, got %v", round, proposer.Address, address)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+func TestRoundRobinProposerSelector(t *testing.T) {
+	vset := NewValidatorSet([]*Validator{
+		newValidator([]byte("foo"), 1000),
+		newValidator([]byte("bar"), 300),
+		newValidator([]byte("baz"), 330),
+	})
+	selector := NewProposerSelector(DefaultProposerParams(), []byte("seed"))
+
+	for round := int32(0); round < 10; round++ {
+		roundVals := vset
+		if round > 0 {
+			roundVals = vset.CopyIncrementProposerPriority(round)
+		}
+		expected := roundVals.GetProposer()
+		assert.Equal(t, expected, selector.Proposer(roundVals, round))
+		require.NoError(t, vset.VerifyProposer(selector, round, expected.Address))
+	}
+}
+
+func TestHashProposerSelector(t *testing.T) {
+	vset := NewValidatorSet([]*Validator{
+		newValidator([]byte("foo"), 3000),
+		newValidator([]byte("bar"), 1000),
+	})
+	params := ProposerParams{Selection: cmtproto.ProposerSelectionHash}
+	selector := NewProposerSelector(params, []byte("seed"))
+
+	proposed := make(map[string]int)
+	for round := int32(0); round < 4000; round++ {
+		proposer := selector.Proposer(vset, round)
+		proposed[string(proposer.Address)]++
+
+		// the proposer only depends on the seed and the round
+		assert.Equal(t, proposer, NewProposerSelector(params, []byte("seed")).Proposer(vset.Copy(), round))
+		require.NoError(t, vset.VerifyProposer(selector, round, proposer.Address))
+	}
+	// the proposers are drawn proportionally to their voting power
+	assert.InDelta(t, 3000, proposed["foo"], 150)
+	assert.InDelta(t, 1000, proposed["bar"], 150)
+
+	// the seed changes the proposers
+	other := NewProposerSelector(params, []byte("other seed"))
+	differ := false
+	for round := int32(0); round < 10 && !differ; round++ {
+		differ = !bytes.Equal(selector.Proposer(vset, round).Address, other.Proposer(vset, round).Address)
+	}
+	assert.True(t, differ)
+}
+
+func TestVerifyProposer(t *testing.T) {
+	vset := NewValidatorSet([]*Validator{
+		newValidator([]byte("foo"), 1000),
+		newValidator([]byte("bar"), 1000),
+	})
+	selector := NewProposerSelector(ProposerParams{Selection: cmtproto.ProposerSelectionHash}, nil)
+	proposer := selector.Proposer(vset, 1)
+
+	other := []byte("foo")
+	if string(proposer.Address) == "foo" {
+		other = []byte("bar")
+	}
+	require.NoError(t, vset.VerifyProposer(selector, 1, proposer.Address))
+	require.Error(t, vset.VerifyProposer(selector, 1, other))
+	require.ErrorIs(t, NewValidatorSet(nil).VerifyProposer(selector, 1, proposer.Address), ErrProposerNotInVals)
+
+	// SetProposer sets the proposer of GetProposer
+	vset.SetProposer(selector, 1)
+	assert.Equal(t, proposer, vset.GetProposer())
+}
@@ -1,30 +1,75 @@
 package types
 
+import (
+	"bytes"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// DefaultSignatureCacheSize is the number of signatures kept by a cache
+// created with NewSignatureCache: the prevotes and precommits of two rounds of
+// the largest validator set.
+const DefaultSignatureCacheSize = 4 * MaxVotesCount
+
 // The value type for the verified signature cache.
 type SignatureCacheValue struct {
 	ValidatorAddress []byte
 	VoteSignBytes    []byte
 }
 
+// SignatureCache keeps the most recently used verified signatures, keyed by
+// signature, up to its size. It is safe for concurrent use, so that a node can
+// share one across consensus, blocksync and evidence verification and verify
+// each vote signature once.
 type SignatureCache struct {
-	cache map[string]SignatureCacheValue
+	cache *lru.Cache[string, SignatureCacheValue]
 }
 
+// NewSignatureCache returns a cache of DefaultSignatureCacheSize signatures.
 func NewSignatureCache() *SignatureCache {
-	return &SignatureCache{
-		cache: make(map[string]SignatureCacheValue),
+	return NewSignatureCacheWithSize(DefaultSignatureCacheSize)
+}
+
+// NewSignatureCacheWithSize returns a cache of size signatures. It panics if
+// size is not positive.
+func NewSignatureCacheWithSize(size int) *SignatureCache {
+	cache, err := lru.New[string, SignatureCacheValue](size)
+	if err != nil {
+		panic(err)
 	}
+	return &SignatureCache{cache: cache}
 }
 
 func (sc *SignatureCache) Add(key string, value SignatureCacheValue) {
-	sc.cache[key] = value
+	sc.cache.Add(key, value)
 }
 
 func (sc *SignatureCache) Get(key string) (SignatureCacheValue, bool) {
-	value, ok := sc.cache[key]
-	return value, ok
+	return sc.cache.Get(key)
 }
 
 func (sc *SignatureCache) Len() int {
-	return len(sc.cache)
+	return sc.cache.Len()
+}
+
+// verified returns true if the signature is in the cache for the given
+// validator address and sign bytes. It returns false on a nil cache.
+func (sc *SignatureCache) verified(signature, valAddr, signBytes []byte) bool {
+	if sc == nil {
+		return false
+	}
+	value, ok := sc.Get(string(signature))
+	return ok && bytes.Equal(value.ValidatorAddress, valAddr) && bytes.Equal(value.VoteSignBytes, signBytes)
+}
+
+// addVerified adds a verified signature to the cache. It does nothing on a
+// nil cache.
+func (sc *SignatureCache) addVerified(signature, valAddr, signBytes []byte) {
+	if sc == nil {
+		return
+	}
+	sc.Add(string(signature), SignatureCacheValue{
+		ValidatorAddress: valAddr,
+		VoteSignBytes:    signBytes,
+	})
 }
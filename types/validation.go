@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"runtime"
+	"sync"
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/batch"
@@ -29,6 +31,29 @@ func shouldBatchVerify(vals *ValidatorSet, commit *Commit) bool {
 // with a bonus for including more than +2/3 of the signatures.
 func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	height int64, commit *Commit,
+) error {
+	return verifyCommitInternal(chainID, vals, blockID, height, commit, nil)
+}
+
+// VerifyCommitWithCache verifies +2/3 of the set had signed the given commit.
+//
+// It checks all the signatures, like VerifyCommit.
+// The cache provided will be used to skip signature verification for entries where the
+// key (signature), validator pubkey, and vote sign bytes all match.
+// Additionally, any verified signatures will be added to the cache.
+func VerifyCommitWithCache(chainID string, vals *ValidatorSet, blockID BlockID,
+	height int64, commit *Commit, verifiedSignatureCache *SignatureCache,
+) error {
+	return verifyCommitInternal(chainID, vals, blockID, height, commit, verifiedSignatureCache)
+}
+
+func verifyCommitInternal(
+	chainID string,
+	vals *ValidatorSet,
+	blockID BlockID,
+	height int64,
+	commit *Commit,
+	verifiedSignatureCache *SignatureCache,
 ) error {
 	// run a basic validation of the arguments
 	if err := verifyBasicValsAndCommit(vals, commit, height, blockID); err != nil {
@@ -48,12 +73,12 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
-			votingPowerNeeded, ignore, count, true, true, nil, nil)
+			votingPowerNeeded, ignore, count, true, true, nil, verifiedSignatureCache)
 	}
 
 	// if verification failed or is not supported then fallback to single verification
 	return verifyCommitSingle(chainID, vals, commit, votingPowerNeeded,
-		ignore, count, true, true, nil)
+		ignore, count, true, true, verifiedSignatureCache)
 }
 
 // LIGHT CLIENT VERIFICATION METHODS
@@ -103,6 +128,24 @@ func VerifyCommitLightAllSignatures(
 	return verifyCommitLightInternal(chainID, vals, blockID, height, commit, true, nil)
 }
 
+// VerifyCommitLightAllSignaturesWithCache verifies +2/3 of the set had signed
+// the given commit.
+//
+// This method DOES check all the signatures.
+// The cache provided will be used to skip signature verification for entries where the
+// key (signature), validator pubkey, and vote sign bytes all match.
+// Additionally, any verified signatures will be added to the cache.
+func VerifyCommitLightAllSignaturesWithCache(
+	chainID string,
+	vals *ValidatorSet,
+	blockID BlockID,
+	height int64,
+	commit *Commit,
+	verifiedSignatureCache *SignatureCache,
+) error {
+	return verifyCommitLightInternal(chainID, vals, blockID, height, commit, true, verifiedSignatureCache)
+}
+
 func verifyCommitLightInternal(
 	chainID string,
 	vals *ValidatorSet,
@@ -193,6 +236,26 @@ func VerifyCommitLightTrustingAllSignatures(
 	return verifyCommitLightTrustingInternal(chainID, vals, commit, trustLevel, true, nil)
 }
 
+// VerifyCommitLightTrustingAllSignaturesWithCache verifies that trustLevel of
+// the validator set signed this commit.
+//
+// NOTE the given validators do not necessarily correspond to the validator set
+// for this commit, but there may be some intersection.
+//
+// This method DOES check all the signatures.
+// The cache provided will be used to skip signature verification for entries where the
+// key (signature), validator pubkey, and vote sign bytes all match.
+// Additionally, any verified signatures will be added to the cache.
+func VerifyCommitLightTrustingAllSignaturesWithCache(
+	chainID string,
+	vals *ValidatorSet,
+	commit *Commit,
+	trustLevel cmtmath.Fraction,
+	verifiedSignatureCache *SignatureCache,
+) error {
+	return verifyCommitLightTrustingInternal(chainID, vals, commit, trustLevel, true, verifiedSignatureCache)
+}
+
 func verifyCommitLightTrustingInternal(
 	chainID string,
 	vals *ValidatorSet,
@@ -256,6 +319,10 @@ func ValidateHash(h []byte) error {
 // to verifyCommitSingle in behavior, just faster iff every signature in the
 // batch is valid.
 //
+// Unless a batchVerifier is given, commits with at least
+// parallelVerifyThreshold signatures are split into several batches verified
+// concurrently.
+//
 // Note: The caller is responsible for checking to see if this routine is
 // usable via `shouldVerifyBatch(vals, commit)`.
 func verifyCommitBatch(
@@ -270,22 +337,132 @@ func verifyCommitBatch(
 	batchVerifier crypto.BatchVerifier,
 	verifiedSignatureCache *SignatureCache,
 ) error {
+	// re-check if batch verification is supported
+	supported := batchVerifier != nil || batch.SupportsBatchVerifier(vals.GetProposer().PubKey)
+	if !supported || len(commit.Signatures) < batchVerifyThreshold {
+		// This should *NEVER* happen.
+		return fmt.Errorf("unsupported signature algorithm or insufficient signatures for batch verification")
+	}
+
+	entries, talliedVotingPower, err := collectCommitSigs(chainID, vals, commit, votingPowerNeeded,
+		ignoreSig, countSig, countAllSignatures, lookUpByIndex, verifiedSignatureCache)
+	if err != nil {
+		return err
+	}
+
+	// ensure that we have batched together enough signatures to exceed the
+	// voting power needed else there is no need to even verify
+	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+	}
+
+	// if every signature was in the cache, there is nothing to verify
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var valid []bool
+	if batchVerifier != nil {
+		valid = make([]bool, len(entries))
+		err = batchVerifyCommitSigs(batchVerifier, chainID, commit, entries, valid)
+	} else {
+		valid, err = verifyCommitSigsConcurrently(chainID, commit, entries, true)
+	}
+	if err != nil {
+		return err
+	}
+
+	return checkVerifiedCommitSigs(commit, entries, valid, verifiedSignatureCache)
+}
+
+// batchVerifyCommitSigs verifies the signatures of entries with the given
+// batch verifier, setting valid[i] for each valid signature.
+func batchVerifyCommitSigs(
+	bv crypto.BatchVerifier,
+	chainID string,
+	commit *Commit,
+	entries []commitSigEntry,
+	valid []bool,
+) error {
+	for i := range entries {
+		e := &entries[i]
+		if err := bv.Add(e.pubKey, e.signBytes(chainID, commit), commit.Signatures[e.idx].Signature); err != nil {
+			return err
+		}
+	}
+
+	ok, validSigs := bv.Verify()
+	if ok {
+		for i := range valid {
+			valid[i] = true
+		}
+		return nil
+	}
+
+	copy(valid, validSigs)
+	for _, ok := range validSigs {
+		if !ok {
+			return nil
+		}
+	}
+
+	// execution reaching here is a bug, and one of the following has
+	// happened:
+	//  * non-zero tallied voting power, empty batch (impossible?)
+	//  * bv.Verify() returned `false, []bool{true, ..., true}` (BUG)
+	return fmt.Errorf("BUG: batch verification failed with no invalid signatures")
+}
+
+// Streaming verification
+
+// parallelVerifyThreshold is the minimum number of signatures verified by
+// each goroutine when the signatures of a commit are verified concurrently.
+const parallelVerifyThreshold = 64
+
+// commitSigEntry is a signature of a commit that needs to be verified.
+type commitSigEntry struct {
+	idx           int
+	pubKey        crypto.PubKey
+	voteSignBytes []byte
+}
+
+// signBytes returns the bytes signed by the validator, computing them on
+// first use.
+func (e *commitSigEntry) signBytes(chainID string, commit *Commit) []byte {
+	if e.voteSignBytes == nil {
+		e.voteSignBytes = commit.VoteSignBytes(chainID, int32(e.idx))
+	}
+	return e.voteSignBytes
+}
+
+// collectCommitSigs goes through the signatures of the commit, tallying the
+// voting power of those that count, and returns the ones that need to be
+// verified, i.e. are not in the cache, along with the tally. Unless
+// countAllSignatures is true, it stops as soon as the tally exceeds
+// votingPowerNeeded, so only the signatures needed to reach +2/3 are verified.
+//
+// If a signature is malformed or doesn't match the validator set, it returns
+// an error along with the signatures collected before it.
+func collectCommitSigs(
+	chainID string,
+	vals *ValidatorSet,
+	commit *Commit,
+	votingPowerNeeded int64,
+	ignoreSig func(CommitSig) bool,
+	countSig func(CommitSig) bool,
+	countAllSignatures bool,
+	lookUpByIndex bool,
+	verifiedSignatureCache *SignatureCache,
+) ([]commitSigEntry, int64, error) {
 	var (
 		val                *Validator
 		valIdx             int32
-		seenVals           = make(map[int32]int, len(commit.Signatures))
-		batchSigIdxs       = make([]int, 0, len(commit.Signatures))
+		seenVals           map[int32]int
+		entries            = make([]commitSigEntry, 0, len(commit.Signatures))
 		talliedVotingPower int64
 	)
-	// attempt to create a batch verifier
-	bv, ok := batchVerifier, true
-	if batchVerifier == nil {
-		bv, ok = batch.CreateBatchVerifier(vals.GetProposer().PubKey)
-	}
-	// re-check if batch verification is supported
-	if !ok || len(commit.Signatures) < batchVerifyThreshold {
-		// This should *NEVER* happen.
-		return fmt.Errorf("unsupported signature algorithm or insufficient signatures for batch verification")
+	if !lookUpByIndex {
+		seenVals = make(map[int32]int, len(commit.Signatures))
 	}
 
 	for idx, commitSig := range commit.Signatures {
@@ -294,12 +471,17 @@ func verifyCommitBatch(
 			continue
 		}
 
+		if err := commitSig.ValidateBasic(); err != nil {
+			return entries, talliedVotingPower, fmt.Errorf("invalid signature from %X at index %d: %w",
+				commitSig.ValidatorAddress, idx, err)
+		}
+
 		// If the vals and commit have a 1-to-1 correspondence we can retrieve
 		// them by index else we need to retrieve them by address
 		if lookUpByIndex {
 			val = vals.Validators[idx]
 			if !bytes.Equal(val.Address, commitSig.ValidatorAddress) {
-				return fmt.Errorf("validator address mismatch at index %d: expected %X, got %X",
+				return entries, talliedVotingPower, fmt.Errorf("validator address mismatch at index %d: expected %X, got %X",
 					idx, val.Address, commitSig.ValidatorAddress)
 			}
 		} else {
@@ -315,26 +497,19 @@ func verifyCommitBatch(
 			// that the same validator doesn't commit twice
 			if firstIndex, ok := seenVals[valIdx]; ok {
 				secondIndex := idx
-				return fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
+				return entries, talliedVotingPower, fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
 			}
 			seenVals[valIdx] = idx
 		}
 
-		// Validate signature.
-		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
-
-		cacheHit := false
-		if verifiedSignatureCache != nil {
-			cacheVal, sigIsInCache := verifiedSignatureCache.Get(string(commitSig.Signature))
-			cacheHit = sigIsInCache && bytes.Equal(cacheVal.ValidatorAddress, val.PubKey.Address()) && bytes.Equal(cacheVal.VoteSignBytes, voteSignBytes)
+		if val.PubKey == nil {
+			return entries, talliedVotingPower, fmt.Errorf("validator %v has a nil PubKey at index %d", val, idx)
 		}
 
-		if !cacheHit {
-			// add the key, sig and message to the verifier
-			if err := bv.Add(val.PubKey, voteSignBytes, commitSig.Signature); err != nil {
-				return err
-			}
-			batchSigIdxs = append(batchSigIdxs, idx)
+		entry := commitSigEntry{idx: idx, pubKey: val.PubKey}
+		if verifiedSignatureCache == nil ||
+			!verifiedSignatureCache.verified(commitSig.Signature, val.PubKey.Address(), entry.signBytes(chainID, commit)) {
+			entries = append(entries, entry)
 		}
 
 		// If this signature counts then add the voting power of the validator
@@ -344,63 +519,97 @@ func verifyCommitBatch(
 		}
 
 		// if we don't need to verify all signatures and already have sufficient
-		// voting power we can break from batching and verify all the signatures
+		// voting power we can stop collecting signatures
 		if !countAllSignatures && talliedVotingPower > votingPowerNeeded {
 			break
 		}
 	}
 
-	// ensure that we have batched together enough signatures to exceed the
-	// voting power needed else there is no need to even verify
-	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
-		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
-	}
+	return entries, talliedVotingPower, nil
+}
 
-	// if every signature was in the cache, the batch verifier is empty and we shouldn't call verify
-	if len(batchSigIdxs) == 0 {
-		return nil
-	}
+// verifyCommitSigsConcurrently splits entries into contiguous chunks of at
+// least parallelVerifyThreshold signatures and verifies them in parallel,
+// either with a batch verifier per chunk or one signature at a time. It
+// reports whether each signature is valid. Within a chunk, the signatures
+// after an invalid one might not be verified, so the first invalid entry is
+// always the first false one.
+func verifyCommitSigsConcurrently(
+	chainID string,
+	commit *Commit,
+	entries []commitSigEntry,
+	batched bool,
+) ([]bool, error) {
+	var (
+		valid  = make([]bool, len(entries))
+		chunks = cmtmath.MaxInt(1, cmtmath.MinInt(runtime.GOMAXPROCS(0), len(entries)/parallelVerifyThreshold))
+		size   = (len(entries) + chunks - 1) / chunks
+		errs   = make([]error, chunks)
+	)
 
-	// attempt to verify the batch.
-	ok, validSigs := bv.Verify()
-	if ok {
-		// success
-		if verifiedSignatureCache != nil {
-			for i := range validSigs {
-				idx := batchSigIdxs[i]
-				sig := commit.Signatures[idx]
-				verifiedSignatureCache.Add(string(sig.Signature), SignatureCacheValue{
-					ValidatorAddress: sig.ValidatorAddress,
-					VoteSignBytes:    commit.VoteSignBytes(chainID, int32(idx)),
-				})
+	verifyChunk := func(c int) {
+		start, end := c*size, cmtmath.MinInt((c+1)*size, len(entries))
+		if start >= end {
+			return
+		}
+		if batched {
+			bv, ok := batch.CreateBatchVerifier(entries[start].pubKey)
+			if !ok {
+				errs[c] = fmt.Errorf("unsupported signature algorithm for batch verification")
+				return
+			}
+			errs[c] = batchVerifyCommitSigs(bv, chainID, commit, entries[start:end], valid[start:end])
+			return
+		}
+		for i := start; i < end; i++ {
+			e := &entries[i]
+			if !e.pubKey.VerifySignature(e.signBytes(chainID, commit), commit.Signatures[e.idx].Signature) {
+				return
 			}
+			valid[i] = true
 		}
+	}
 
-		return nil
+	if chunks == 1 {
+		verifyChunk(0)
+	} else {
+		var wg sync.WaitGroup
+		for c := 0; c < chunks; c++ {
+			wg.Add(1)
+			go func(c int) {
+				defer wg.Done()
+				verifyChunk(c)
+			}(c)
+		}
+		wg.Wait()
 	}
 
-	// one or more of the signatures is invalid, find and return the first
-	// invalid signature.
-	for i, ok := range validSigs {
-		// go back from the batch index to the commit.Signatures index
-		idx := batchSigIdxs[i]
-		sig := commit.Signatures[idx]
-		if !ok {
-			return fmt.Errorf("wrong signature (#%d): %X", idx, sig)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return valid, nil
+}
+
+// checkVerifiedCommitSigs returns an error for the first invalid signature,
+// adding the valid signatures before it to the cache.
+func checkVerifiedCommitSigs(
+	commit *Commit,
+	entries []commitSigEntry,
+	valid []bool,
+	verifiedSignatureCache *SignatureCache,
+) error {
+	for i, e := range entries {
+		sig := commit.Signatures[e.idx]
+		if !valid[i] {
+			return fmt.Errorf("wrong signature (#%d): %X", e.idx, sig.Signature)
 		}
 		if verifiedSignatureCache != nil {
-			verifiedSignatureCache.Add(string(sig.Signature), SignatureCacheValue{
-				ValidatorAddress: sig.ValidatorAddress,
-				VoteSignBytes:    commit.VoteSignBytes(chainID, int32(idx)),
-			})
+			verifiedSignatureCache.addVerified(sig.Signature, e.pubKey.Address(), e.voteSignBytes)
 		}
 	}
-
-	// execution reaching here is a bug, and one of the following has
-	// happened:
-	//  * non-zero tallied voting power, empty batch (impossible?)
-	//  * bv.Verify() returned `false, []bool{true, ..., true}` (BUG)
-	return fmt.Errorf("BUG: batch verification failed with no invalid signatures")
+	return nil
 }
 
 // Single Verification
@@ -409,6 +618,8 @@ func verifyCommitBatch(
 // If a key does not support batch verification, or batch verification fails this will be used
 // This method is used to check all the signatures included in a commit.
 // It is used in consensus for validating a block LastCommit.
+// Commits with at least parallelVerifyThreshold signatures are verified
+// concurrently, once the voting power of the signatures is known to suffice.
 // CONTRACT: both commit and validator set should have passed validate basic
 func verifyCommitSingle(
 	chainID string,
@@ -421,6 +632,27 @@ func verifyCommitSingle(
 	lookUpByIndex bool,
 	verifiedSignatureCache *SignatureCache,
 ) error {
+	if len(commit.Signatures) >= parallelVerifyThreshold {
+		// Report the errors in the order of the signatures, as below: an
+		// invalid signature before a malformed one, and both before the tally.
+		entries, talliedVotingPower, collectErr := collectCommitSigs(chainID, vals, commit, votingPowerNeeded,
+			ignoreSig, countSig, countAllSignatures, lookUpByIndex, verifiedSignatureCache)
+		valid, err := verifyCommitSigsConcurrently(chainID, commit, entries, false)
+		if err != nil {
+			return err
+		}
+		if err := checkVerifiedCommitSigs(commit, entries, valid, verifiedSignatureCache); err != nil {
+			return err
+		}
+		if collectErr != nil {
+			return collectErr
+		}
+		if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+			return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+		}
+		return nil
+	}
+
 	var (
 		val                *Validator
 		valIdx             int32
@@ -433,8 +665,8 @@ func verifyCommitSingle(
 			continue
 		}
 
-		if commitSig.ValidateBasic() != nil {
-			return fmt.Errorf("invalid signatures from %v at index %d", val, idx)
+		if err := commitSig.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid signature from %X at index %d: %w", commitSig.ValidatorAddress, idx, err)
 		}
 
 		// If the vals and commit have a 1-to-1 correspondence we can retrieve
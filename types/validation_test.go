@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"strconv"
 	"testing"
 	"time"
@@ -567,3 +568,155 @@ func TestValidation_verifyCommitSingle_UsesCache(t *testing.T) {
 	mockValPubkeys[3].AssertNotCalled(t, "VerifySignature")
 	mockValPubkeys[4].AssertNotCalled(t, "VerifySignature")
 }
+
+func TestValidation_VerifyLargeCommitConcurrently(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+		n       = 4 * parallelVerifyThreshold
+	)
+
+	voteSet, valSet, vals := randVoteSet(h, 0, cmtproto.PrecommitType, n, 10, false)
+	extCommit, err := MakeExtCommit(blockID, h, 0, voteSet, vals, time.Now(), false)
+	require.NoError(t, err)
+	commit := extCommit.ToCommit()
+	require.NoError(t, valSet.VerifyCommit(chainID, blockID, h, commit))
+
+	malleate := func(idx int32) {
+		vote := voteSet.GetByIndex(idx)
+		v := vote.ToProto()
+		require.NoError(t, vals[idx].SignVote("CentaurusA", v))
+		commit.Signatures[idx].Signature = v.Signature
+	}
+
+	ignore := func(c CommitSig) bool { return c.BlockIDFlag != BlockIDFlagCommit }
+	count := func(_ CommitSig) bool { return true }
+	// +2/3 is reached with the first 2n/3+1 signatures
+	needed := valSet.TotalVotingPower() * 2 / 3
+
+	// a signature past +2/3 is only detected when counting all signatures
+	malleate(int32(n - 1))
+	require.NoError(t, valSet.VerifyCommitLight(chainID, blockID, h, commit))
+	require.NoError(t, verifyCommitSingle(chainID, valSet, commit, needed, ignore, count, false, true, nil))
+	err = valSet.VerifyCommit(chainID, blockID, h, commit)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), fmt.Sprintf("wrong signature (#%d)", n-1))
+	}
+	err = verifyCommitSingle(chainID, valSet, commit, needed, ignore, count, true, true, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), fmt.Sprintf("wrong signature (#%d)", n-1))
+	}
+
+	// the first invalid signature is reported, whichever chunk it is in
+	malleate(int32(n / 2))
+	malleate(int32(n / 3))
+	err = valSet.VerifyCommitLight(chainID, blockID, h, commit)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), fmt.Sprintf("wrong signature (#%d)", n/3))
+	}
+	err = verifyCommitSingle(chainID, valSet, commit, needed, ignore, count, false, true, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), fmt.Sprintf("wrong signature (#%d)", n/3))
+	}
+
+	// the valid signatures before the invalid one are cached
+	cache := NewSignatureCache()
+	require.Error(t, verifyCommitSingle(chainID, valSet, commit, needed, ignore, count, false, false, cache))
+	assert.Equal(t, n/3, cache.Len())
+}
+
+func TestValidation_VerifyLargeCommitErrorOrder(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+		n       = parallelVerifyThreshold
+	)
+
+	voteSet, valSet, vals := randVoteSet(h, 0, cmtproto.PrecommitType, n, 10, false)
+	extCommit, err := MakeExtCommit(blockID, h, 0, voteSet, vals, time.Now(), false)
+	require.NoError(t, err)
+	commit := extCommit.ToCommit()
+
+	ignore := func(c CommitSig) bool { return c.BlockIDFlag != BlockIDFlagCommit }
+	count := func(_ CommitSig) bool { return true }
+	needed := valSet.TotalVotingPower() * 2 / 3
+
+	// not enough voting power signed
+	for i := n / 2; i < n; i++ {
+		commit.Signatures[i] = NewCommitSigAbsent()
+	}
+	err = verifyCommitSingle(chainID, valSet, commit, needed, ignore, count, false, true, nil)
+	require.ErrorAs(t, err, &ErrNotEnoughVotingPowerSigned{})
+
+	// a wrong signature is reported before the tally
+	v := voteSet.GetByIndex(1).ToProto()
+	require.NoError(t, vals[1].SignVote("CentaurusA", v))
+	commit.Signatures[1].Signature = v.Signature
+	err = verifyCommitSingle(chainID, valSet, commit, needed, ignore, count, false, true, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "wrong signature (#1)")
+	}
+
+	// and before a malformed signature that follows it, which is reported by
+	// index and address
+	commit.Signatures[2].Signature = nil
+	err = verifyCommitSingle(chainID, valSet, commit, needed, ignore, count, false, true, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "wrong signature (#1)")
+	}
+	commit.Signatures[1].Signature = extCommit.ToCommit().Signatures[1].Signature
+	err = verifyCommitSingle(chainID, valSet, commit, needed, ignore, count, false, true, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(),
+			fmt.Sprintf("invalid signature from %X at index 2", commit.Signatures[2].ValidatorAddress))
+	}
+}
+
+func benchmarkVerifyCommit(b *testing.B, n int, verify func(*ValidatorSet, BlockID, int64, *Commit) error) {
+	b.Helper()
+	const h = int64(3)
+	blockID := makeBlockIDRandom()
+	voteSet, valSet, vals := randVoteSet(h, 0, cmtproto.PrecommitType, n, 10, false)
+	extCommit, err := MakeExtCommit(blockID, h, 0, voteSet, vals, time.Now(), false)
+	require.NoError(b, err)
+	commit := extCommit.ToCommit()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := verify(valSet, blockID, h, commit); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyCommit(b *testing.B) {
+	for _, n := range []int{1000, 5000, 10000} {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			benchmarkVerifyCommit(b, n, func(vals *ValidatorSet, blockID BlockID, h int64, commit *Commit) error {
+				return vals.VerifyCommit("test_chain_id", blockID, h, commit)
+			})
+		})
+	}
+}
+
+func BenchmarkVerifyCommitLight(b *testing.B) {
+	for _, n := range []int{1000, 5000, 10000} {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			benchmarkVerifyCommit(b, n, func(vals *ValidatorSet, blockID BlockID, h int64, commit *Commit) error {
+				return vals.VerifyCommitLight("test_chain_id", blockID, h, commit)
+			})
+		})
+	}
+}
+
+func BenchmarkVerifyCommitLightTrusting(b *testing.B) {
+	for _, n := range []int{1000, 5000, 10000} {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			benchmarkVerifyCommit(b, n, func(vals *ValidatorSet, _ BlockID, _ int64, commit *Commit) error {
+				return vals.VerifyCommitLightTrusting("test_chain_id", commit, cmtmath.Fraction{Numerator: 1, Denominator: 3})
+			})
+		})
+	}
+}
@@ -60,6 +60,9 @@ type ValidatorSet struct {
 
 	// cached (unexported)
 	totalVotingPower int64
+	// index of each validator by address; rebuilt whenever the set changes
+	// and never mutated afterwards, so copies may share it.
+	addressIndex map[string]int32
 	// true if all validators have the same type of public key or if the set is empty.
 	allKeysHaveSameType bool
 }
@@ -179,13 +182,15 @@ func (vals *ValidatorSet) RescalePriorities(diffMax int64) {
 }
 
 func (vals *ValidatorSet) incrementProposerPriority() *Validator {
+	// Increment the priorities and find the validator with the most
+	// ProposerPriority in a single pass.
+	var mostest *Validator
 	for _, val := range vals.Validators {
 		// Check for overflow for sum.
-		newPrio := safeAddClip(val.ProposerPriority, val.VotingPower)
-		val.ProposerPriority = newPrio
+		val.ProposerPriority = safeAddClip(val.ProposerPriority, val.VotingPower)
+		mostest = mostest.CompareProposerPriority(val)
 	}
 	// Decrement the validator with most ProposerPriority.
-	mostest := vals.getValWithMostPriority()
 	// Mind the underflow.
 	mostest.ProposerPriority = safeSubClip(mostest.ProposerPriority, vals.TotalVotingPower())
 
@@ -195,11 +200,32 @@ func (vals *ValidatorSet) incrementProposerPriority() *Validator {
 // computeAvgProposerPriority should not be called on an empty validator set.
 func (vals *ValidatorSet) computeAvgProposerPriority() int64 {
 	n := int64(len(vals.Validators))
-	sum := big.NewInt(0)
+
+	// Sum in int64 unless it overflows, which is rare as the priorities are
+	// centered around zero.
+	var (
+		sum      int64
+		overflow bool
+	)
 	for _, val := range vals.Validators {
-		sum.Add(sum, big.NewInt(val.ProposerPriority))
+		if sum, overflow = safeAdd(sum, val.ProposerPriority); overflow {
+			break
+		}
 	}
-	avg := sum.Div(sum, big.NewInt(n))
+	if !overflow {
+		// round towards negative infinity, like big.Int.Div
+		avg := sum / n
+		if sum%n != 0 && sum < 0 {
+			avg--
+		}
+		return avg
+	}
+
+	bigSum, prio := big.NewInt(0), new(big.Int)
+	for _, val := range vals.Validators {
+		bigSum.Add(bigSum, prio.SetInt64(val.ProposerPriority))
+	}
+	avg := bigSum.Div(bigSum, big.NewInt(n))
 	if avg.IsInt64() {
 		return avg.Int64()
 	}
@@ -231,14 +257,6 @@ func computeMaxMinPriorityDiff(vals *ValidatorSet) int64 {
 	return diff
 }
 
-func (vals *ValidatorSet) getValWithMostPriority() *Validator {
-	var res *Validator
-	for _, val := range vals.Validators {
-		res = res.CompareProposerPriority(val)
-	}
-	return res
-}
-
 func (vals *ValidatorSet) shiftByAvgProposerPriority() {
 	if vals.IsNilOrEmpty() {
 		panic("empty validator set")
@@ -267,6 +285,7 @@ func (vals *ValidatorSet) Copy() *ValidatorSet {
 		Validators:          validatorListCopy(vals.Validators),
 		Proposer:            vals.Proposer,
 		totalVotingPower:    vals.totalVotingPower,
+		addressIndex:        vals.addressIndex,
 		allKeysHaveSameType: vals.allKeysHaveSameType,
 	}
 }
@@ -274,12 +293,8 @@ func (vals *ValidatorSet) Copy() *ValidatorSet {
 // HasAddress returns true if address given is in the validator set, false -
 // otherwise.
 func (vals *ValidatorSet) HasAddress(address []byte) bool {
-	for _, val := range vals.Validators {
-		if bytes.Equal(val.Address, address) {
-			return true
-		}
-	}
-	return false
+	idx, _ := vals.GetByAddressMut(address)
+	return idx != -1
 }
 
 // GetByAddress returns an index of the validator with address and validator
@@ -297,6 +312,17 @@ func (vals *ValidatorSet) GetByAddress(address []byte) (index int32, val *Valida
 // This method should be used by callers who will not mutate Val.
 // Otherwise, -1 and nil are returned.
 func (vals *ValidatorSet) GetByAddressMut(address []byte) (index int32, val *Validator) {
+	// The index is only used while it covers the current validators; sets
+	// that were built field by field fall back to a linear search.
+	if vals.addressIndex != nil && len(vals.addressIndex) == len(vals.Validators) {
+		idx, ok := vals.addressIndex[string(address)]
+		if !ok {
+			return -1, nil
+		}
+		if val := vals.Validators[idx]; bytes.Equal(val.Address, address) {
+			return idx, val
+		}
+	}
 	for idx, val := range vals.Validators {
 		if bytes.Equal(val.Address, address) {
 			return int32(idx), val
@@ -317,6 +343,19 @@ func (vals *ValidatorSet) GetByIndex(index int32) (address []byte, val *Validato
 	return val.Address, val.Copy()
 }
 
+// updateAddressIndex rebuilds the index of the validators by address. Sets
+// with duplicate addresses (invalid anyway) are left without an index.
+func (vals *ValidatorSet) updateAddressIndex() {
+	index := make(map[string]int32, len(vals.Validators))
+	for idx, val := range vals.Validators {
+		index[string(val.Address)] = int32(idx)
+	}
+	if len(index) != len(vals.Validators) {
+		index = nil
+	}
+	vals.addressIndex = index
+}
+
 // Size returns the length of the validator set.
 func (vals *ValidatorSet) Size() int {
 	return len(vals.Validators)
@@ -702,6 +741,7 @@ func (vals *ValidatorSet) updateWithChangeSet(changes []*Validator, allowDeletes
 	vals.shiftByAvgProposerPriority()
 
 	sort.Sort(ValidatorsByVotingPower(vals.Validators))
+	vals.updateAddressIndex()
 
 	return nil
 }
@@ -731,6 +771,19 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 	return VerifyCommit(chainID, vals, blockID, height, commit)
 }
 
+// VerifyCommitWithCache verifies +2/3 of the set had signed the given commit
+// and all other signatures are valid.
+//
+// The cache provided will be used to skip signature verification for entries where the
+// key (signature), validator pubkey, and vote sign bytes all match.
+// Additionally, any verified signatures will be added to the cache.
+func (vals *ValidatorSet) VerifyCommitWithCache(chainID string, blockID BlockID,
+	height int64, commit *Commit,
+	verifiedSignatureCache *SignatureCache,
+) error {
+	return VerifyCommitWithCache(chainID, vals, blockID, height, commit, verifiedSignatureCache)
+}
+
 // VerifyCommitExtended similar to VerifyCommit but for extended commits.
 // extCommit must already be validated by ValidateBasic.
 func (vals *ValidatorSet) VerifyCommitExtended(
@@ -738,6 +791,19 @@ func (vals *ValidatorSet) VerifyCommitExtended(
 	blockID BlockID,
 	height int64,
 	extCommit *ExtendedCommit,
+) error {
+	return vals.VerifyCommitExtendedWithCache(chainID, blockID, height, extCommit, nil)
+}
+
+// VerifyCommitExtendedWithCache is like VerifyCommitExtended, with the cache
+// used to verify the commit signatures. Vote extension signatures are always
+// verified.
+func (vals *ValidatorSet) VerifyCommitExtendedWithCache(
+	chainID string,
+	blockID BlockID,
+	height int64,
+	extCommit *ExtendedCommit,
+	verifiedSignatureCache *SignatureCache,
 ) error {
 	if extCommit == nil {
 		return errors.New("nil extended commit")
@@ -750,7 +816,7 @@ func (vals *ValidatorSet) VerifyCommitExtended(
 	}
 
 	// 2. verify regular commit
-	err = vals.VerifyCommit(chainID, blockID, height, extCommit.ToCommit())
+	err = vals.VerifyCommitWithCache(chainID, blockID, height, extCommit.ToCommit(), verifiedSignatureCache)
 	if err != nil {
 		return err
 	}
@@ -804,6 +870,20 @@ func (vals *ValidatorSet) VerifyCommitLightAllSignatures(chainID string, blockID
 	return VerifyCommitLightAllSignatures(chainID, vals, blockID, height, commit)
 }
 
+// VerifyCommitLightAllSignaturesWithCache verifies +2/3 of the set had signed
+// the given commit.
+// It DOES count all signatures.
+//
+// The cache provided will be used to skip signature verification for entries where the
+// key (signature), validator pubkey, and vote sign bytes all match.
+// Additionally, any verified signatures will be added to the cache.
+func (vals *ValidatorSet) VerifyCommitLightAllSignaturesWithCache(chainID string, blockID BlockID,
+	height int64, commit *Commit,
+	verifiedSignatureCache *SignatureCache,
+) error {
+	return VerifyCommitLightAllSignaturesWithCache(chainID, vals, blockID, height, commit, verifiedSignatureCache)
+}
+
 // VerifyCommitLightTrusting verifies that trustLevel of the validator set signed
 // this commit.
 // It does NOT count all signatures.
@@ -843,6 +923,22 @@ func (vals *ValidatorSet) VerifyCommitLightTrustingAllSignatures(
 	return VerifyCommitLightTrustingAllSignatures(chainID, vals, commit, trustLevel)
 }
 
+// VerifyCommitLightTrustingAllSignaturesWithCache verifies that trustLevel of
+// the validator set signed this commit.
+// It DOES count all signatures.
+//
+// The cache provided will be used to skip signature verification for entries where the
+// key (signature), validator pubkey, and vote sign bytes all match.
+// Additionally, any verified signatures will be added to the cache.
+func (vals *ValidatorSet) VerifyCommitLightTrustingAllSignaturesWithCache(
+	chainID string,
+	commit *Commit,
+	trustLevel cmtmath.Fraction,
+	verifiedSignatureCache *SignatureCache,
+) error {
+	return VerifyCommitLightTrustingAllSignaturesWithCache(chainID, vals, commit, trustLevel, verifiedSignatureCache)
+}
+
 // findPreviousProposer reverses the compare proposer priority function to find the validator
 // with the lowest proposer priority which would have been the previous proposer.
 //
@@ -1024,6 +1120,7 @@ func ValidatorSetFromProto(vp *cmtproto.ValidatorSet) (*ValidatorSet, error) {
 	}
 	vals.Validators = valsProto
 	vals.checkAllKeysHaveSameType()
+	vals.updateAddressIndex()
 
 	p, err := ValidatorFromProto(vp.GetProposer())
 	if err != nil {
@@ -1069,6 +1166,7 @@ func ValidatorSetFromExistingValidators(valz []*Validator) (*ValidatorSet, error
 		return nil, err
 	}
 	sort.Sort(ValidatorsByVotingPower(vals.Validators))
+	vals.updateAddressIndex()
 	return vals, nil
 }
 
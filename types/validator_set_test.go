@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"math/big"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"testing/quick"
@@ -626,6 +628,79 @@ func TestAveragingInIncrementProposerPriority(t *testing.T) {
 	}
 }
 
+func TestComputeAvgProposerPriority(t *testing.T) {
+	bigAvg := func(vals *ValidatorSet) int64 {
+		sum := big.NewInt(0)
+		for _, val := range vals.Validators {
+			sum.Add(sum, big.NewInt(val.ProposerPriority))
+		}
+		return sum.Div(sum, big.NewInt(int64(len(vals.Validators)))).Int64()
+	}
+
+	tcs := [][]int64{
+		{1, 2, 3},
+		{-1, -2, -4},
+		{-7, 0, 1},
+		{math.MaxInt64, math.MaxInt64, math.MaxInt64},
+		{math.MinInt64, math.MinInt64, 1},
+		{math.MaxInt64, 1, math.MinInt64},
+	}
+	for i := 0; i < 100; i++ {
+		prios := make([]int64, 1+cmtrand.Intn(10))
+		for j := range prios {
+			prios[j] = cmtrand.Int64() - math.MaxInt64/2
+		}
+		tcs = append(tcs, prios)
+	}
+
+	for i, prios := range tcs {
+		vals := &ValidatorSet{}
+		for j, prio := range prios {
+			vals.Validators = append(vals.Validators, &Validator{Address: []byte{byte(j)}, ProposerPriority: prio})
+		}
+		assert.Equal(t, bigAvg(vals), vals.computeAvgProposerPriority(), "test case: %v", i)
+	}
+}
+
+func TestValidatorSetGetByAddress(t *testing.T) {
+	vset, _ := RandValidatorSet(10, 1)
+	require.NotNil(t, vset.addressIndex)
+
+	check := func(vset *ValidatorSet) {
+		t.Helper()
+		for i, val := range vset.Validators {
+			idx, got := vset.GetByAddress(val.Address)
+			assert.EqualValues(t, i, idx)
+			assert.Equal(t, val, got)
+			assert.True(t, vset.HasAddress(val.Address))
+		}
+		idx, got := vset.GetByAddress([]byte("unknown"))
+		assert.EqualValues(t, -1, idx)
+		assert.Nil(t, got)
+		assert.False(t, vset.HasAddress([]byte("unknown")))
+	}
+	check(vset)
+	check(vset.Copy())
+
+	// the index follows the changes to the set
+	added := NewValidator(randPubKey(), 5)
+	require.NoError(t, vset.UpdateWithChangeSet([]*Validator{
+		added,
+		{Address: vset.Validators[3].Address, PubKey: vset.Validators[3].PubKey, VotingPower: 0},
+	}))
+	assert.True(t, vset.HasAddress(added.Address))
+	check(vset)
+
+	pb, err := vset.ToProto()
+	require.NoError(t, err)
+	fromProto, err := ValidatorSetFromProto(pb)
+	require.NoError(t, err)
+	check(fromProto)
+
+	// sets built field by field are searched linearly
+	check(&ValidatorSet{Validators: validatorListCopy(vset.Validators)})
+}
+
 func TestAveragingInIncrementProposerPriorityWithVotingPower(t *testing.T) {
 	// Other than TestAveragingInIncrementProposerPriority this is a more complete test showing
 	// how each ProposerPriority changes in relation to the validator's voting power respectively.
@@ -1979,3 +2054,28 @@ func TestValidatorSet_TotalVotingPowerSafe(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkValidatorSetGetByAddress(b *testing.B) {
+	for _, n := range []int{1000, 5000, 10000} {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			vset, _ := RandValidatorSet(n, 10)
+			i := 0
+			for b.Loop() {
+				vset.GetByAddressMut(vset.Validators[i%n].Address)
+				i++
+			}
+		})
+	}
+}
+
+func BenchmarkIncrementProposerPriority(b *testing.B) {
+	for _, n := range []int{1000, 5000, 10000} {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			vset, _ := RandValidatorSet(n, 10)
+			b.ReportAllocs()
+			for b.Loop() {
+				vset.IncrementProposerPriority(1)
+			}
+		})
+	}
+}
@@ -220,14 +220,23 @@ func (vote *Vote) String() string {
 	)
 }
 
-func (vote *Vote) verifyAndReturnProto(chainID string, pubKey crypto.PubKey) (*cmtproto.Vote, error) {
+func (vote *Vote) verifyAndReturnProto(
+	chainID string,
+	pubKey crypto.PubKey,
+	verifiedSignatureCache *SignatureCache,
+) (*cmtproto.Vote, error) {
 	if !bytes.Equal(pubKey.Address(), vote.ValidatorAddress) {
 		return nil, ErrVoteInvalidValidatorAddress
 	}
 	v := vote.ToProto()
-	if !pubKey.VerifySignature(VoteSignBytes(chainID, v), vote.Signature) {
+	signBytes := VoteSignBytes(chainID, v)
+	if verifiedSignatureCache.verified(vote.Signature, vote.ValidatorAddress, signBytes) {
+		return v, nil
+	}
+	if !pubKey.VerifySignature(signBytes, vote.Signature) {
 		return nil, ErrVoteInvalidSignature
 	}
+	verifiedSignatureCache.addVerified(vote.Signature, vote.ValidatorAddress, signBytes)
 	return v, nil
 }
 
@@ -235,7 +244,14 @@ func (vote *Vote) verifyAndReturnProto(chainID string, pubKey crypto.PubKey) (*c
 // the given chain ID and public key. This function does not validate vote
 // extension signatures - to do so, use VerifyWithExtension instead.
 func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
-	_, err := vote.verifyAndReturnProto(chainID, pubKey)
+	_, err := vote.verifyAndReturnProto(chainID, pubKey, nil)
+	return err
+}
+
+// VerifyWithCache is like Verify, but skips verifying the signature if it is
+// in the cache and adds it to the cache once verified.
+func (vote *Vote) VerifyWithCache(chainID string, pubKey crypto.PubKey, verifiedSignatureCache *SignatureCache) error {
+	_, err := vote.verifyAndReturnProto(chainID, pubKey, verifiedSignatureCache)
 	return err
 }
 
@@ -244,7 +260,17 @@ func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
 // given chain ID and public key. We only verify vote extension signatures for
 // precommits.
 func (vote *Vote) VerifyVoteAndExtension(chainID string, pubKey crypto.PubKey) error {
-	v, err := vote.verifyAndReturnProto(chainID, pubKey)
+	return vote.verifyVoteAndExtension(chainID, pubKey, nil)
+}
+
+// verifyVoteAndExtension is VerifyVoteAndExtension, with the vote signature
+// looked up in and added to the cache. Extension signatures aren't cached.
+func (vote *Vote) verifyVoteAndExtension(
+	chainID string,
+	pubKey crypto.PubKey,
+	verifiedSignatureCache *SignatureCache,
+) error {
+	v, err := vote.verifyAndReturnProto(chainID, pubKey, verifiedSignatureCache)
 	if err != nil {
 		return err
 	}
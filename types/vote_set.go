@@ -73,6 +73,9 @@ type VoteSet struct {
 	maj23         *BlockID               // First 2/3 majority seen
 	votesByBlock  map[string]*blockVotes // string(blockHash|blockParts) -> blockVotes
 	peerMaj23s    map[P2PID]BlockID      // Maj23 for each peer
+
+	// verified signatures, shared with the rest of the node; may be nil
+	signatureCache *SignatureCache
 }
 
 // NewVoteSet instantiates all fields of a new vote set. This constructor requires
@@ -109,6 +112,15 @@ func NewExtendedVoteSet(chainID string, height int64, round int32,
 	return vs
 }
 
+// SetSignatureCache sets the cache of verified signatures: the signatures of
+// the votes found in it aren't verified again, and the verified ones are
+// added to it.
+func (voteSet *VoteSet) SetSignatureCache(cache *SignatureCache) {
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+	voteSet.signatureCache = cache
+}
+
 func (voteSet *VoteSet) ChainID() string {
 	return voteSet.chainID
 }
@@ -217,11 +229,11 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 
 	// Check signature.
 	if voteSet.extensionsEnabled {
-		if err := vote.VerifyVoteAndExtension(voteSet.chainID, val.PubKey); err != nil {
+		if err := vote.verifyVoteAndExtension(voteSet.chainID, val.PubKey, voteSet.signatureCache); err != nil {
 			return false, fmt.Errorf("failed to verify extended vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
 		}
 	} else {
-		if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
+		if err := vote.VerifyWithCache(voteSet.chainID, val.PubKey, voteSet.signatureCache); err != nil {
 			return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
 		}
 		if len(vote.ExtensionSignature) > 0 || len(vote.Extension) > 0 {
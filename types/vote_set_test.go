@@ -525,6 +525,50 @@ func TestVoteSet_MakeCommit(t *testing.T) {
 	}
 }
 
+func TestVoteSet_SignatureCache(t *testing.T) {
+	height, round := int64(1), int32(0)
+	voteSet, valSet, privValidators := randVoteSet(height, round, cmtproto.PrecommitType, 10, 1, false)
+	cache := NewSignatureCache()
+	voteSet.SetSignatureCache(cache)
+
+	blockID := makeBlockIDRandom()
+	extCommit, err := MakeExtCommit(blockID, height, round, voteSet, privValidators, cmttime.Now(), false)
+	require.NoError(t, err)
+	assert.Equal(t, 10, cache.Len())
+
+	// the signatures verified when adding the votes are found when verifying
+	// the commit made of them
+	commit := extCommit.ToCommit()
+	for idx, sig := range commit.Signatures {
+		assert.True(t, cache.verified(sig.Signature, sig.ValidatorAddress, commit.VoteSignBytes(voteSet.ChainID(), int32(idx))))
+	}
+	require.NoError(t, valSet.VerifyCommitWithCache(voteSet.ChainID(), blockID, height, commit, cache))
+	assert.Equal(t, 10, cache.Len())
+
+	// but not for another chain
+	assert.False(t, cache.verified(commit.Signatures[0].Signature, commit.Signatures[0].ValidatorAddress,
+		commit.VoteSignBytes("other_chain_id", 0)))
+}
+
+func TestSignatureCacheIsBounded(t *testing.T) {
+	cache := NewSignatureCacheWithSize(2)
+	for _, key := range []string{"a", "b", "c"} {
+		cache.Add(key, SignatureCacheValue{ValidatorAddress: []byte(key)})
+	}
+	assert.Equal(t, 2, cache.Len())
+	_, ok := cache.Get("a")
+	assert.False(t, ok, "least recently used signature should be evicted")
+	value, ok := cache.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("c"), value.ValidatorAddress)
+
+	assert.Panics(t, func() { NewSignatureCacheWithSize(0) })
+
+	var nilCache *SignatureCache
+	assert.False(t, nilCache.verified([]byte("a"), nil, nil))
+	nilCache.addVerified([]byte("a"), nil, nil)
+}
+
 // TestVoteSet_VoteExtensionsEnabled tests that the vote set correctly validates
 // vote extension data when either required or not required.
 func TestVoteSet_VoteExtensionsEnabled(t *testing.T) {